@@ -0,0 +1,201 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverpgxv5"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/sarathsp06/sparrow/internal/config"
+)
+
+const usage = `Usage: admin <command> [flags]
+
+Commands:
+  list [-queue name] [-state state] [-kind kind] [-limit n]   List jobs, newest first
+  show <job-id>                                               Show a job's args and errors
+  cancel <job-id>                                             Cancel a job
+  retry <job-id>                                              Retry a job now
+`
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+
+	client, closeDB, err := newRiverClient(ctx, cfg)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		os.Exit(1)
+	}
+	defer closeDB()
+
+	command, args := os.Args[1], os.Args[2:]
+	switch command {
+	case "list":
+		err = listJobs(ctx, client, args)
+	case "show":
+		err = withJobID(args, func(id int64) error { return showJob(ctx, client, id) })
+	case "cancel":
+		err = withJobID(args, func(id int64) error {
+			job, err := client.JobCancel(ctx, id)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Job %d is %s\n", job.ID, job.State)
+			return nil
+		})
+	case "retry":
+		err = withJobID(args, func(id int64) error {
+			job, err := client.JobRetry(ctx, id)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Job %d is %s, scheduled at %s\n", job.ID, job.State, job.ScheduledAt.Format(time.RFC3339))
+			return nil
+		})
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+
+	if err != nil {
+		if errors.Is(err, rivertype.ErrNotFound) {
+			err = fmt.Errorf("job not found")
+		}
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+		closeDB()
+		os.Exit(1)
+	}
+}
+
+// newRiverClient creates an insert-only River client, which is enough for job management
+func newRiverClient(ctx context.Context, cfg *config.Config) (*river.Client[pgx.Tx], func(), error) {
+	poolConfig, err := cfg.PoolConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid database pool configuration: %w", err)
+	}
+
+	dbPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create database pool: %w", err)
+	}
+
+	client, err := river.NewClient(riverpgxv5.New(dbPool), &river.Config{})
+	if err != nil {
+		dbPool.Close()
+		return nil, nil, fmt.Errorf("failed to create River client: %w", err)
+	}
+
+	return client, dbPool.Close, nil
+}
+
+func listJobs(ctx context.Context, client *river.Client[pgx.Tx], args []string) error {
+	flags := flag.NewFlagSet("list", flag.ExitOnError)
+	var (
+		queue = flags.String("queue", "", "Only jobs in this queue")
+		state = flags.String("state", "", "Only jobs in this state (available, running, retryable, scheduled, completed, discarded, cancelled, pending)")
+		kind  = flags.String("kind", "", "Only jobs of this kind, e.g. webhook_delivery")
+		limit = flags.Int("limit", 50, "Maximum number of jobs to list")
+	)
+	flags.Parse(args)
+
+	params := river.NewJobListParams().
+		OrderBy(river.JobListOrderByID, river.SortOrderDesc).
+		First(*limit)
+	if *queue != "" {
+		params = params.Queues(*queue)
+	}
+	if *kind != "" {
+		params = params.Kinds(*kind)
+	}
+	if *state != "" {
+		jobState, err := parseJobState(*state)
+		if err != nil {
+			return err
+		}
+		params = params.States(jobState)
+	}
+
+	res, err := client.JobList(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to list jobs: %w", err)
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tKIND\tQUEUE\tSTATE\tATTEMPT\tSCHEDULED AT")
+	for _, job := range res.Jobs {
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%d/%d\t%s\n",
+			job.ID, job.Kind, job.Queue, job.State, job.Attempt, job.MaxAttempts,
+			job.ScheduledAt.Format(time.RFC3339))
+	}
+	return w.Flush()
+}
+
+func showJob(ctx context.Context, client *river.Client[pgx.Tx], id int64) error {
+	job, err := client.JobGet(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("ID:           %d\n", job.ID)
+	fmt.Printf("Kind:         %s\n", job.Kind)
+	fmt.Printf("Queue:        %s\n", job.Queue)
+	fmt.Printf("State:        %s\n", job.State)
+	fmt.Printf("Priority:     %d\n", job.Priority)
+	fmt.Printf("Attempt:      %d/%d\n", job.Attempt, job.MaxAttempts)
+	fmt.Printf("Created at:   %s\n", job.CreatedAt.Format(time.RFC3339))
+	fmt.Printf("Scheduled at: %s\n", job.ScheduledAt.Format(time.RFC3339))
+	if job.FinalizedAt != nil {
+		fmt.Printf("Finalized at: %s\n", job.FinalizedAt.Format(time.RFC3339))
+	}
+	fmt.Printf("Args:         %s\n", job.EncodedArgs)
+
+	if len(job.Errors) > 0 {
+		fmt.Println("Errors:")
+		for _, attemptErr := range job.Errors {
+			fmt.Printf("  attempt %d at %s: %s\n", attemptErr.Attempt, attemptErr.At.Format(time.RFC3339), attemptErr.Error)
+		}
+	}
+	return nil
+}
+
+// withJobID parses the single job ID argument of a command and runs fn with it
+func withJobID(args []string, fn func(id int64) error) error {
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one job ID")
+	}
+	id, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid job ID %q", args[0])
+	}
+	return fn(id)
+}
+
+func parseJobState(value string) (rivertype.JobState, error) {
+	for _, state := range rivertype.JobStates() {
+		if strings.EqualFold(string(state), value) {
+			return state, nil
+		}
+	}
+	return "", fmt.Errorf("unknown job state %q", value)
+}
@@ -28,11 +28,20 @@ func main() {
 	)
 	flag.Parse()
 
+	// Load configuration
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Failed to load configuration: %v\n", err)
+		os.Exit(1)
+	}
+
 	// Initialize logger
+	if err := logger.Configure(cfg.LogLevel, cfg.LogFormat); err != nil {
+		fmt.Fprintf(os.Stderr, "Invalid logging configuration: %v\n", err)
+		os.Exit(1)
+	}
 	log := logger.NewLogger("migration")
 
-	// Load configuration
-	cfg := config.Load()
 	log.Info("Starting database migration",
 		"database_url", cfg.DatabaseURL,
 		"direction", *direction,
@@ -41,7 +50,7 @@ func main() {
 	ctx := context.Background()
 
 	// Run River migrations first
-	if err := runRiverMigrations(ctx, cfg.DatabaseURL, log); err != nil {
+	if err := runRiverMigrations(ctx, cfg, log); err != nil {
 		log.Error("Failed to run River migrations", "error", err)
 		os.Exit(1)
 	}
@@ -55,11 +64,22 @@ func main() {
 	log.Info("All migrations completed successfully")
 }
 
-func runRiverMigrations(ctx context.Context, databaseURL string, log *slog.Logger) error {
+func runRiverMigrations(ctx context.Context, cfg *config.Config, log *slog.Logger) error {
 	log.Info("Running River queue migrations...")
 
+	poolConfig, err := cfg.PoolConfig()
+	if err != nil {
+		return fmt.Errorf("invalid database pool configuration: %w", err)
+	}
+
+	log.Info("Database pool configured",
+		"max_conns", poolConfig.MaxConns,
+		"min_conns", poolConfig.MinConns,
+		"max_conn_lifetime", poolConfig.MaxConnLifetime,
+	)
+
 	// Connect to database
-	dbPool, err := pgxpool.New(ctx, databaseURL)
+	dbPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return fmt.Errorf("failed to create database pool: %w", err)
 	}
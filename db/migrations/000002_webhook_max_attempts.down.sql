@@ -0,0 +1,2 @@
+-- Rollback per-webhook retry limit
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS max_attempts;
@@ -0,0 +1,3 @@
+-- Per-webhook retry limit for deliveries
+ALTER TABLE webhook_registrations
+    ADD COLUMN max_attempts INTEGER NOT NULL DEFAULT 3;  -- Maximum delivery attempts (1-25)
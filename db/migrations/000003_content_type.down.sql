@@ -0,0 +1,3 @@
+-- Rollback payload content type
+ALTER TABLE event_records DROP COLUMN IF EXISTS content_type;
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS content_type;
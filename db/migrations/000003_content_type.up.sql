@@ -0,0 +1,6 @@
+-- Payload content type for events and webhook registrations
+ALTER TABLE webhook_registrations
+    ADD COLUMN content_type VARCHAR(255) NOT NULL DEFAULT '';  -- Default Content-Type for deliveries ('' = application/json)
+
+ALTER TABLE event_records
+    ADD COLUMN content_type VARCHAR(255) NOT NULL DEFAULT '';  -- Content-Type of the pushed payload
@@ -0,0 +1,3 @@
+-- Rollback configurable response body capture
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS response_content_type;
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS max_stored_response_bytes;
@@ -0,0 +1,6 @@
+-- Configurable response body capture
+ALTER TABLE webhook_registrations
+    ADD COLUMN max_stored_response_bytes INTEGER NOT NULL DEFAULT 0;  -- 0 = use global default
+
+ALTER TABLE webhook_deliveries
+    ADD COLUMN response_content_type VARCHAR(255) DEFAULT '';  -- Content-Type of the last response
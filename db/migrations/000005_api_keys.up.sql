@@ -0,0 +1,11 @@
+-- API keys for namespace-level authentication
+CREATE TABLE api_keys (
+    id VARCHAR(255) PRIMARY KEY,
+    key_hash VARCHAR(64) NOT NULL UNIQUE,  -- SHA-256 hex digest of the key; the key itself is never stored
+    namespaces JSONB NOT NULL,             -- Array of namespaces the key is authorized for
+    description TEXT,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    revoked_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE INDEX idx_api_keys_revoked_at ON api_keys(revoked_at);
@@ -0,0 +1,2 @@
+-- Rollback trace context propagation opt-out
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS disable_trace_propagation;
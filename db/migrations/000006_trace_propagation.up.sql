@@ -0,0 +1,3 @@
+-- Per-webhook opt-out of trace context propagation
+ALTER TABLE webhook_registrations
+    ADD COLUMN disable_trace_propagation BOOLEAN NOT NULL DEFAULT false;  -- Skip traceparent/baggage headers
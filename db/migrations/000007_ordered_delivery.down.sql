@@ -0,0 +1,3 @@
+-- Rollback strict FIFO delivery
+DROP INDEX IF EXISTS idx_webhook_deliveries_webhook_created_at;
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS ordered;
@@ -0,0 +1,6 @@
+-- Strict FIFO delivery per webhook
+ALTER TABLE webhook_registrations
+    ADD COLUMN ordered BOOLEAN NOT NULL DEFAULT false;  -- Deliver events to this webhook one at a time, in order
+
+-- Supports the "earlier undelivered delivery" lookup for ordered webhooks
+CREATE INDEX idx_webhook_deliveries_webhook_created_at ON webhook_deliveries(webhook_id, created_at);
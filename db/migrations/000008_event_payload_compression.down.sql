@@ -0,0 +1,4 @@
+-- Rollback event payload compression
+-- Compressed payloads would be unreadable without the flag, so remove those events first
+DELETE FROM event_records WHERE compressed = true;
+ALTER TABLE event_records DROP COLUMN IF EXISTS compressed;
@@ -0,0 +1,3 @@
+-- Optional gzip compression of large event payloads
+ALTER TABLE event_records
+    ADD COLUMN compressed BOOLEAN NOT NULL DEFAULT false;  -- payload holds base64-encoded gzip data
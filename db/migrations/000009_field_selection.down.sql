@@ -0,0 +1,4 @@
+-- Rollback per-webhook JSON field selection
+ALTER TABLE webhook_registrations
+    DROP COLUMN IF EXISTS include_fields,
+    DROP COLUMN IF EXISTS exclude_fields;
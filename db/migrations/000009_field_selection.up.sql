@@ -0,0 +1,4 @@
+-- Per-webhook JSON field selection
+ALTER TABLE webhook_registrations
+    ADD COLUMN include_fields JSONB NOT NULL DEFAULT '[]',  -- JSON paths to keep (empty = all)
+    ADD COLUMN exclude_fields JSONB NOT NULL DEFAULT '[]';  -- JSON paths to remove
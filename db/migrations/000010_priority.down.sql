@@ -0,0 +1,2 @@
+-- Rollback per-webhook delivery priority
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS priority;
@@ -0,0 +1,3 @@
+-- Per-webhook delivery priority (River priority 1-4; 0 = default)
+ALTER TABLE webhook_registrations
+    ADD COLUMN priority SMALLINT NOT NULL DEFAULT 0;
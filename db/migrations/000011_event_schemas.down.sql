@@ -0,0 +1,2 @@
+-- Rollback event schemas
+DROP TABLE IF EXISTS event_schemas;
@@ -0,0 +1,9 @@
+-- JSON Schemas that pushed event payloads must match, per namespace/event
+CREATE TABLE event_schemas (
+    namespace VARCHAR(255) NOT NULL,
+    event VARCHAR(255) NOT NULL,
+    schema JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW(),
+    PRIMARY KEY (namespace, event)
+);
@@ -0,0 +1,3 @@
+-- Rollback webhook fallback URLs
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS response_url;
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS fallback_urls;
@@ -0,0 +1,7 @@
+-- Backup URLs tried in order when a webhook's primary URL fails
+ALTER TABLE webhook_registrations
+    ADD COLUMN fallback_urls JSONB NOT NULL DEFAULT '[]';
+
+-- URL that produced a delivery's recorded response
+ALTER TABLE webhook_deliveries
+    ADD COLUMN response_url TEXT NOT NULL DEFAULT '';
@@ -0,0 +1,2 @@
+-- Rollback delivery deduplication
+ALTER TABLE webhook_deliveries DROP CONSTRAINT IF EXISTS webhook_deliveries_webhook_event_key;
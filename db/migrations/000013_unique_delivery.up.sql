@@ -0,0 +1,10 @@
+-- Remove duplicate deliveries left by re-processed events, keeping the earliest
+DELETE FROM webhook_deliveries a
+USING webhook_deliveries b
+WHERE a.webhook_id = b.webhook_id
+  AND a.event_id = b.event_id
+  AND (a.created_at, a.id) > (b.created_at, b.id);
+
+-- An event is delivered to each webhook at most once
+ALTER TABLE webhook_deliveries
+    ADD CONSTRAINT webhook_deliveries_webhook_event_key UNIQUE (webhook_id, event_id);
@@ -0,0 +1,2 @@
+-- Rollback success body matchers
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS success_body_matcher;
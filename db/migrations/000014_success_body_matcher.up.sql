@@ -0,0 +1,3 @@
+-- Optional rule a 2xx response body must satisfy for a delivery to succeed
+ALTER TABLE webhook_registrations
+    ADD COLUMN success_body_matcher JSONB;
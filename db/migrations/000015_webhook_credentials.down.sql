@@ -0,0 +1,3 @@
+-- Rollback webhook delivery credentials
+DROP TABLE IF EXISTS webhook_credentials;
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS auth_type;
@@ -0,0 +1,11 @@
+-- How deliveries authenticate to the receiver: '', 'basic' or 'bearer'
+ALTER TABLE webhook_registrations
+    ADD COLUMN auth_type VARCHAR(16) NOT NULL DEFAULT '';
+
+-- Delivery credentials, kept apart from registrations so they're never listed
+CREATE TABLE webhook_credentials (
+    webhook_id VARCHAR(255) PRIMARY KEY REFERENCES webhook_registrations(id) ON DELETE CASCADE,
+    username TEXT NOT NULL DEFAULT '',  -- Basic auth username
+    secret TEXT NOT NULL,               -- Basic auth password or bearer token
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
@@ -0,0 +1,2 @@
+-- Rollback namespaces
+DROP TABLE IF EXISTS namespaces;
@@ -0,0 +1,13 @@
+-- Known namespaces; STRICT_NAMESPACES requires them to be created before use
+CREATE TABLE namespaces (
+    name VARCHAR(255) PRIMARY KEY,
+    description TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+-- Namespaces already in use stay valid when strict mode is turned on
+INSERT INTO namespaces (name)
+SELECT namespace FROM webhook_registrations
+UNION
+SELECT namespace FROM event_records
+ON CONFLICT (name) DO NOTHING;
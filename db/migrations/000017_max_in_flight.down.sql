@@ -0,0 +1,3 @@
+-- Rollback per-webhook in-flight limit
+DROP INDEX IF EXISTS idx_webhook_deliveries_sending;
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS max_in_flight;
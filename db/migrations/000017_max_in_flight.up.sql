@@ -0,0 +1,6 @@
+-- Maximum deliveries to a webhook in flight at once (0 = unlimited)
+ALTER TABLE webhook_registrations
+    ADD COLUMN max_in_flight INTEGER NOT NULL DEFAULT 0;
+
+-- Supports counting a webhook's in-flight deliveries
+CREATE INDEX idx_webhook_deliveries_sending ON webhook_deliveries(webhook_id) WHERE status = 'sending';
@@ -0,0 +1,3 @@
+-- Rollback delivery failure reasons
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS failure_reason;
+DROP TYPE IF EXISTS delivery_failure_reason;
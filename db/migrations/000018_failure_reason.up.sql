@@ -0,0 +1,8 @@
+-- Why a delivery's last attempt failed, for filtering and analytics
+CREATE TYPE delivery_failure_reason AS ENUM (
+    'dns_error', 'connection_refused', 'tls_error', 'timeout',
+    'http_4xx', 'http_5xx', 'body_match_failed', 'other'
+);
+
+ALTER TABLE webhook_deliveries
+    ADD COLUMN failure_reason delivery_failure_reason;
@@ -0,0 +1,2 @@
+-- Rollback delivery durations
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS duration_ms;
@@ -0,0 +1,3 @@
+-- How long the last attempt's request took, for latency statistics
+ALTER TABLE webhook_deliveries
+    ADD COLUMN duration_ms INTEGER;
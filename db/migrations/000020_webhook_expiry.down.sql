@@ -0,0 +1,3 @@
+-- Rollback webhook expiry
+DROP INDEX IF EXISTS idx_webhook_registrations_expires_at;
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS expires_at;
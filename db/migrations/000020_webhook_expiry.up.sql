@@ -0,0 +1,7 @@
+-- Temporary webhooks stop receiving events once expires_at passes (NULL = never)
+ALTER TABLE webhook_registrations
+    ADD COLUMN expires_at TIMESTAMP WITH TIME ZONE;
+
+-- Supports the periodic sweep that deactivates expired webhooks
+CREATE INDEX idx_webhook_registrations_expires_at ON webhook_registrations(expires_at)
+    WHERE active = true AND expires_at IS NOT NULL;
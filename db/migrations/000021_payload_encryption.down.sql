@@ -0,0 +1,2 @@
+-- Rollback payload encryption
+ALTER TABLE event_records DROP COLUMN IF EXISTS key_version;
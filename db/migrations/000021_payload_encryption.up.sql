@@ -0,0 +1,3 @@
+-- Version of the key that encrypted the stored payload (NULL = stored in plaintext)
+ALTER TABLE event_records
+    ADD COLUMN key_version INTEGER;
@@ -0,0 +1,2 @@
+-- Rollback compressed delivery bodies
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS compress_body;
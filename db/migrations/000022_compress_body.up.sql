@@ -0,0 +1,3 @@
+-- Webhooks with compress_body receive gzip-encoded request bodies above a size threshold
+ALTER TABLE webhook_registrations
+    ADD COLUMN compress_body BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,4 @@
+-- Rollback delivery header capture
+ALTER TABLE webhook_deliveries
+    DROP COLUMN IF EXISTS request_headers,
+    DROP COLUMN IF EXISTS response_headers;
@@ -0,0 +1,4 @@
+-- Headers sent and received by each delivery's last attempt, with credentials redacted
+ALTER TABLE webhook_deliveries
+    ADD COLUMN request_headers JSONB,
+    ADD COLUMN response_headers JSONB;
@@ -0,0 +1,2 @@
+-- Postgres can't drop an enum value; report these deliveries as 'other' instead
+UPDATE webhook_deliveries SET failure_reason = 'other' WHERE failure_reason = 'header_template_error';
@@ -0,0 +1,2 @@
+-- Deliveries whose templated headers couldn't be rendered
+ALTER TYPE delivery_failure_reason ADD VALUE IF NOT EXISTS 'header_template_error';
@@ -0,0 +1,2 @@
+-- Rollback event processing failures
+DROP TABLE IF EXISTS event_processing_failures;
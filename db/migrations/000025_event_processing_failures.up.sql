@@ -0,0 +1,12 @@
+-- Event processing jobs that failed after exhausting their attempts
+CREATE TABLE event_processing_failures (
+    id VARCHAR(255) PRIMARY KEY,
+    event_id VARCHAR(255) NOT NULL,
+    namespace VARCHAR(255) NOT NULL,
+    event VARCHAR(255) NOT NULL,
+    error TEXT NOT NULL,
+    attempts INTEGER NOT NULL,
+    failed_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
+
+CREATE INDEX idx_event_processing_failures_namespace ON event_processing_failures (namespace, failed_at DESC, id DESC);
@@ -0,0 +1,6 @@
+-- Rollback delivery batching
+DROP INDEX IF EXISTS idx_webhook_deliveries_batched;
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS batched;
+ALTER TABLE webhook_registrations
+    DROP COLUMN IF EXISTS batch_window_ms,
+    DROP COLUMN IF EXISTS batch_max_size;
@@ -0,0 +1,10 @@
+-- Webhooks with a batch window receive their deliveries batched into NDJSON requests
+ALTER TABLE webhook_registrations
+    ADD COLUMN batch_window_ms INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN batch_max_size INTEGER NOT NULL DEFAULT 0;
+
+-- Batched deliveries have no job of their own; the webhook's batch job sends them
+ALTER TABLE webhook_deliveries
+    ADD COLUMN batched BOOLEAN NOT NULL DEFAULT false;
+
+CREATE INDEX idx_webhook_deliveries_batched ON webhook_deliveries (webhook_id, created_at, id) WHERE batched;
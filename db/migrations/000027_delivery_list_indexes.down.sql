@@ -0,0 +1,2 @@
+-- Rollback delivery list indexes
+DROP INDEX IF EXISTS idx_webhook_deliveries_webhook_status_created_at;
@@ -0,0 +1,4 @@
+-- Supports listing a namespace's deliveries by status, newest first. Listings without a
+-- status use idx_webhook_deliveries_webhook_created_at.
+CREATE INDEX idx_webhook_deliveries_webhook_status_created_at
+    ON webhook_deliveries(webhook_id, status, created_at DESC, id DESC);
@@ -0,0 +1,2 @@
+-- Rollback response truncation flag
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS response_truncated;
@@ -0,0 +1,3 @@
+-- Whether the last attempt's response body was cut at the capture limit
+ALTER TABLE webhook_deliveries
+    ADD COLUMN response_truncated BOOLEAN NOT NULL DEFAULT false;
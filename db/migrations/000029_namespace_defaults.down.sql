@@ -0,0 +1,4 @@
+-- Rollback namespace defaults
+ALTER TABLE namespaces
+    DROP COLUMN IF EXISTS default_timeout,
+    DROP COLUMN IF EXISTS default_headers;
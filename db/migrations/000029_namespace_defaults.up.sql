@@ -0,0 +1,5 @@
+-- Defaults inherited by a namespace's webhooks: headers merged into their deliveries and
+-- the timeout of webhooks registered without one
+ALTER TABLE namespaces
+    ADD COLUMN default_headers JSONB NOT NULL DEFAULT '{}',
+    ADD COLUMN default_timeout INTEGER NOT NULL DEFAULT 0;
@@ -0,0 +1,2 @@
+-- Rollback delivered marker
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS delivered_at;
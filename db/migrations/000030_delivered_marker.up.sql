@@ -0,0 +1,4 @@
+-- When a delivery was first acknowledged by its receiver; set with the success status so
+-- retried jobs can tell the delivery already happened
+ALTER TABLE webhook_deliveries
+    ADD COLUMN delivered_at TIMESTAMP WITH TIME ZONE;
@@ -0,0 +1,2 @@
+-- Rollback webhook health
+DROP TABLE IF EXISTS webhook_health;
@@ -0,0 +1,10 @@
+-- Rolling delivery outcomes per webhook: counts for the current window and the one before it
+CREATE TABLE webhook_health (
+    webhook_id VARCHAR(255) PRIMARY KEY REFERENCES webhook_registrations(id) ON DELETE CASCADE,
+    window_start TIMESTAMP WITH TIME ZONE NOT NULL,
+    successes INTEGER NOT NULL DEFAULT 0,
+    failures INTEGER NOT NULL DEFAULT 0,
+    prev_successes INTEGER NOT NULL DEFAULT 0,
+    prev_failures INTEGER NOT NULL DEFAULT 0,
+    disabled_at TIMESTAMP WITH TIME ZONE -- When the webhook was disabled for failing; NULL = never
+);
@@ -0,0 +1,2 @@
+-- Rollback retry schedules
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS retry_schedule;
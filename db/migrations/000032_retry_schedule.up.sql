@@ -0,0 +1,3 @@
+-- Delays in seconds before each retry of a webhook's deliveries; empty = default backoff
+ALTER TABLE webhook_registrations
+    ADD COLUMN retry_schedule JSONB NOT NULL DEFAULT '[]';
@@ -0,0 +1,2 @@
+-- Rollback event type listing index
+DROP INDEX IF EXISTS idx_event_records_namespace_event;
@@ -0,0 +1,2 @@
+-- Lets ListEventTypes group a namespace's events by name without scanning them all
+CREATE INDEX idx_event_records_namespace_event ON event_records(namespace, event, created_at);
@@ -0,0 +1,2 @@
+-- Rollback webhook query parameters
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS query_params;
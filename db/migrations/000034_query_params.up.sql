@@ -0,0 +1,3 @@
+-- Query parameters added to a webhook's delivery URLs; values may be templates
+ALTER TABLE webhook_registrations
+    ADD COLUMN query_params JSONB NOT NULL DEFAULT '{}';
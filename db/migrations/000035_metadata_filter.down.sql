@@ -0,0 +1,2 @@
+-- Rollback webhook metadata filters
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS metadata_filter;
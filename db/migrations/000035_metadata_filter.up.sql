@@ -0,0 +1,3 @@
+-- Event metadata a webhook requires; empty matches every event
+ALTER TABLE webhook_registrations
+    ADD COLUMN metadata_filter JSONB NOT NULL DEFAULT '{}';
@@ -0,0 +1,2 @@
+-- Rollback per-namespace webhook quotas
+ALTER TABLE namespaces DROP COLUMN IF EXISTS max_webhooks;
@@ -0,0 +1,4 @@
+-- Per-namespace override of MAX_WEBHOOKS_PER_NAMESPACE; NULL uses the server's limit and
+-- 0 allows any number of webhooks
+ALTER TABLE namespaces
+    ADD COLUMN max_webhooks INTEGER;
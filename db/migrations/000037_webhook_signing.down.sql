@@ -0,0 +1,3 @@
+-- Rollback webhook signing secrets
+DROP TABLE IF EXISTS webhook_signing_secrets;
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS signed;
@@ -0,0 +1,13 @@
+-- Whether deliveries carry X-Sparrow-Signature headers
+ALTER TABLE webhook_registrations
+    ADD COLUMN signed BOOLEAN NOT NULL DEFAULT false;
+
+-- Signing secrets, kept apart from registrations like credentials. After a rotation the
+-- previous secret keeps signing deliveries until previous_expires_at.
+CREATE TABLE webhook_signing_secrets (
+    webhook_id VARCHAR(255) PRIMARY KEY REFERENCES webhook_registrations(id) ON DELETE CASCADE,
+    secret TEXT NOT NULL,
+    previous_secret TEXT,
+    previous_expires_at TIMESTAMP WITH TIME ZONE,
+    updated_at TIMESTAMP WITH TIME ZONE DEFAULT NOW()
+);
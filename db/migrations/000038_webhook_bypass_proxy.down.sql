@@ -0,0 +1,2 @@
+-- Rollback webhook proxy bypass
+ALTER TABLE webhook_registrations DROP COLUMN IF EXISTS bypass_proxy;
@@ -0,0 +1,3 @@
+-- Whether deliveries connect to the receiver directly instead of through the outbound proxy
+ALTER TABLE webhook_registrations
+    ADD COLUMN bypass_proxy BOOLEAN NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- Deliveries to a port outside ALLOWED_WEBHOOK_PORTS
+ALTER TYPE delivery_failure_reason ADD VALUE IF NOT EXISTS 'port_not_allowed';
@@ -0,0 +1,8 @@
+-- Rollback event delivery summary
+DROP TRIGGER IF EXISTS update_event_delivery_summary ON webhook_deliveries;
+DROP FUNCTION IF EXISTS update_event_delivery_summary();
+ALTER TABLE event_records
+    DROP COLUMN IF EXISTS total_deliveries,
+    DROP COLUMN IF EXISTS succeeded_deliveries,
+    DROP COLUMN IF EXISTS failed_deliveries,
+    DROP COLUMN IF EXISTS pending_deliveries;
@@ -0,0 +1,69 @@
+-- Counts of each event's deliveries by outcome, kept current by a trigger on webhook_deliveries
+-- so an event's delivery health can be read without scanning its deliveries. Failed includes
+-- expired deliveries; pending covers deliveries that haven't finished (pending, sending, retrying).
+ALTER TABLE event_records
+    ADD COLUMN total_deliveries INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN succeeded_deliveries INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN failed_deliveries INTEGER NOT NULL DEFAULT 0,
+    ADD COLUMN pending_deliveries INTEGER NOT NULL DEFAULT 0;
+
+CREATE OR REPLACE FUNCTION update_event_delivery_summary()
+RETURNS TRIGGER AS $$
+DECLARE
+    old_outcome TEXT := '';
+    new_outcome TEXT := '';
+    summary_event_id VARCHAR(255);
+BEGIN
+    IF TG_OP <> 'INSERT' THEN
+        old_outcome := CASE
+            WHEN OLD.status = 'success' THEN 'succeeded'
+            WHEN OLD.status IN ('failed', 'expired') THEN 'failed'
+            ELSE 'pending'
+        END;
+        summary_event_id := OLD.event_id;
+    END IF;
+    IF TG_OP <> 'DELETE' THEN
+        new_outcome := CASE
+            WHEN NEW.status = 'success' THEN 'succeeded'
+            WHEN NEW.status IN ('failed', 'expired') THEN 'failed'
+            ELSE 'pending'
+        END;
+        summary_event_id := NEW.event_id;
+    END IF;
+    IF old_outcome = new_outcome THEN
+        RETURN NULL;
+    END IF;
+
+    UPDATE event_records SET
+        total_deliveries = total_deliveries
+            + (TG_OP = 'INSERT')::INTEGER - (TG_OP = 'DELETE')::INTEGER,
+        succeeded_deliveries = succeeded_deliveries
+            + (new_outcome = 'succeeded')::INTEGER - (old_outcome = 'succeeded')::INTEGER,
+        failed_deliveries = failed_deliveries
+            + (new_outcome = 'failed')::INTEGER - (old_outcome = 'failed')::INTEGER,
+        pending_deliveries = pending_deliveries
+            + (new_outcome = 'pending')::INTEGER - (old_outcome = 'pending')::INTEGER
+    WHERE id = summary_event_id;
+    RETURN NULL;
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER update_event_delivery_summary
+    AFTER INSERT OR UPDATE OF status OR DELETE ON webhook_deliveries
+    FOR EACH ROW EXECUTE FUNCTION update_event_delivery_summary();
+
+-- Summarize deliveries that already exist
+UPDATE event_records e SET
+    total_deliveries = s.total,
+    succeeded_deliveries = s.succeeded,
+    failed_deliveries = s.failed,
+    pending_deliveries = s.total - s.succeeded - s.failed
+FROM (
+    SELECT event_id,
+        COUNT(*) AS total,
+        COUNT(*) FILTER (WHERE status = 'success') AS succeeded,
+        COUNT(*) FILTER (WHERE status IN ('failed', 'expired')) AS failed
+    FROM webhook_deliveries
+    GROUP BY event_id
+) s
+WHERE e.id = s.event_id;
@@ -0,0 +1,5 @@
+-- Rollback delivery updated_at
+DROP INDEX IF EXISTS idx_webhook_deliveries_event_updated_at;
+DROP INDEX IF EXISTS idx_webhook_deliveries_webhook_updated_at;
+DROP TRIGGER IF EXISTS update_webhook_deliveries_updated_at ON webhook_deliveries;
+ALTER TABLE webhook_deliveries DROP COLUMN IF EXISTS updated_at;
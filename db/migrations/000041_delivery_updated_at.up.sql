@@ -0,0 +1,16 @@
+-- When each delivery's status or attempt count last changed, stamped by a trigger so that every
+-- change is seen by status watchers, including ones that aren't attempts (stuck delivery
+-- recovery, TTL extensions, batch expiry)
+ALTER TABLE webhook_deliveries
+    ADD COLUMN updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW();
+
+UPDATE webhook_deliveries SET updated_at = COALESCE(last_attempted_at, created_at);
+
+CREATE TRIGGER update_webhook_deliveries_updated_at
+    BEFORE UPDATE ON webhook_deliveries
+    FOR EACH ROW
+    WHEN (OLD.status IS DISTINCT FROM NEW.status OR OLD.attempt_count IS DISTINCT FROM NEW.attempt_count)
+    EXECUTE FUNCTION update_updated_at_column();
+
+CREATE INDEX idx_webhook_deliveries_webhook_updated_at ON webhook_deliveries(webhook_id, updated_at);
+CREATE INDEX idx_webhook_deliveries_event_updated_at ON webhook_deliveries(event_id, updated_at);
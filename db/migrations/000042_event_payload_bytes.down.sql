@@ -0,0 +1,2 @@
+-- Rollback event payload bytes (payloads stored there can't be kept in the TEXT column and are lost)
+ALTER TABLE event_records DROP COLUMN IF EXISTS payload_bytes;
@@ -0,0 +1,4 @@
+-- Payloads that aren't text, stored as pushed when neither compressed nor encrypted; payload is
+-- empty for those events. Compressed and encrypted payloads are base64 text and stay in payload.
+ALTER TABLE event_records
+    ADD COLUMN payload_bytes BYTEA;
@@ -10,19 +10,24 @@ import (
 
 	"connectrpc.com/connect"
 
+	sparrowclient "github.com/sarathsp06/sparrow/pkg/client"
 	pb "github.com/sarathsp06/sparrow/proto"
-	"github.com/sarathsp06/sparrow/proto/protoconnect"
 )
 
 func MainConnect() {
-	// Create Connect client
-	client := protoconnect.NewWebhookServiceClient(
-		http.DefaultClient,
-		"http://localhost:8080",
-	)
-
 	ctx := context.Background()
 
+	// Create Connect client, waiting for the server to be ready; calls that fail with a
+	// transient error are retried
+	dialCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+	client, err := sparrowclient.Dial(dialCtx, "http://localhost:8080", sparrowclient.Config{
+		RequestTimeout: 10 * time.Second,
+	})
+	if err != nil {
+		log.Fatalf("Failed to connect: %v", err)
+	}
+
 	fmt.Println("🌐 sparrow Connect-RPC Client Example")
 	fmt.Println("========================================")
 
@@ -30,8 +30,11 @@ func MainGRPC() {
 		Events:    []string{"signup", "login", "profile_update"},
 		Url:       "https://webhooks.sarathsadasivan.com/32c5c978-30ed-49d6-aafc-fda9e7fcdc33",
 		Headers: map[string]string{
-			"Authorization": "Bearer secret-token",
-			"X-App-Name":    "MyApp",
+			"X-App-Name": "MyApp",
+		},
+		Auth: &pb.WebhookAuth{
+			Type:  pb.WebhookAuthType_AUTH_BEARER,
+			Token: "secret-token",
 		},
 		Timeout:     30,
 		Active:      true,
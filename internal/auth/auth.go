@@ -0,0 +1,213 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+// apiKeyPrefix marks generated keys so they are easy to recognise in logs and secret scanners
+const apiKeyPrefix = "spk_"
+
+var (
+	// ErrUnauthenticated is returned when a request carries no valid credentials
+	ErrUnauthenticated = errors.New("missing or invalid API key")
+	// ErrPermissionDenied is returned when a key isn't authorized for the requested namespace
+	ErrPermissionDenied = errors.New("API key is not authorized for this request")
+)
+
+// KeyStore looks up API keys and the namespaces of stored resources
+type KeyStore interface {
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*webhooks.APIKey, error)
+	GetWebhookNamespace(ctx context.Context, webhookID string) (string, error)
+	GetEventNamespace(ctx context.Context, eventID string) (string, error)
+	GetDeliveryNamespace(ctx context.Context, deliveryID string) (string, error)
+}
+
+// Authenticator validates bearer tokens against stored API keys
+type Authenticator struct {
+	store           KeyStore
+	adminKey        string
+	adminProcedures map[string]bool
+	publicServices  map[string]bool
+}
+
+// NewAuthenticator creates an authenticator. adminKey grants access to every namespace
+// and to adminProcedures (fully-qualified procedure names such as "/webhook.WebhookService/CreateAPIKey").
+func NewAuthenticator(store KeyStore, adminKey string, adminProcedures ...string) *Authenticator {
+	procedures := make(map[string]bool, len(adminProcedures))
+	for _, p := range adminProcedures {
+		procedures[p] = true
+	}
+	return &Authenticator{
+		store:           store,
+		adminKey:        adminKey,
+		adminProcedures: procedures,
+	}
+}
+
+// AllowUnauthenticated exempts every method of the named gRPC services, such as
+// "grpc.health.v1.Health", from authentication
+func (a *Authenticator) AllowUnauthenticated(services ...string) {
+	if a.publicServices == nil {
+		a.publicServices = make(map[string]bool, len(services))
+	}
+	for _, service := range services {
+		a.publicServices[service] = true
+	}
+}
+
+// isPublic reports whether a fully-qualified procedure belongs to a public service
+func (a *Authenticator) isPublic(procedure string) bool {
+	service, _, ok := strings.Cut(strings.TrimPrefix(procedure, "/"), "/")
+	return ok && a.publicServices[service]
+}
+
+// GenerateAPIKey returns a new random API key and the hash to store for it
+func GenerateAPIKey() (key string, keyHash string, err error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", fmt.Errorf("failed to generate API key: %w", err)
+	}
+	key = apiKeyPrefix + hex.EncodeToString(buf)
+	return key, HashAPIKey(key), nil
+}
+
+// HashAPIKey returns the hex-encoded SHA-256 digest stored for a key
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Authorize checks that the bearer token may call procedure with the given request message
+func (a *Authenticator) Authorize(ctx context.Context, procedure, authorization string, msg any) error {
+	token, ok := bearerToken(authorization)
+	if !ok {
+		return ErrUnauthenticated
+	}
+
+	if a.adminKey != "" && subtle.ConstantTimeCompare([]byte(token), []byte(a.adminKey)) == 1 {
+		return nil
+	}
+
+	if a.adminProcedures[procedure] {
+		return ErrPermissionDenied
+	}
+
+	key, err := a.store.GetActiveAPIKeyByHash(ctx, HashAPIKey(token))
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return ErrUnauthenticated
+	}
+	if err != nil {
+		return fmt.Errorf("failed to look up API key: %w", err)
+	}
+
+	namespaces, err := a.requestNamespaces(ctx, msg)
+	if err != nil {
+		return err
+	}
+	// Requests that aren't scoped to a namespace are reserved for the admin key
+	if len(namespaces) == 0 {
+		return ErrPermissionDenied
+	}
+
+	for _, namespace := range namespaces {
+		if !keyAllows(key, namespace) {
+			return ErrPermissionDenied
+		}
+	}
+	return nil
+}
+
+// requestNamespaces determines which namespaces a request touches. Stored resources
+// referenced by ID are resolved from the database so a caller can't spoof the namespace.
+func (a *Authenticator) requestNamespaces(ctx context.Context, msg any) ([]string, error) {
+	if batch, ok := msg.(interface{ GetEvents() []*pb.PushEventRequest }); ok {
+		namespaces := make([]string, 0, len(batch.GetEvents()))
+		for _, event := range batch.GetEvents() {
+			namespaces = append(namespaces, event.GetNamespace())
+		}
+		return namespaces, nil
+	}
+
+	if m, ok := msg.(interface {
+		GetWebhookIds() []string
+		GetEventIds() []string
+	}); ok {
+		return a.resolveNamespaces(ctx, m.GetWebhookIds(), m.GetEventIds())
+	}
+
+	if m, ok := msg.(interface{ GetWebhookId() string }); ok && m.GetWebhookId() != "" {
+		return a.resolveNamespace(ctx, a.store.GetWebhookNamespace, m.GetWebhookId())
+	}
+	if m, ok := msg.(interface{ GetEventId() string }); ok && m.GetEventId() != "" {
+		return a.resolveNamespace(ctx, a.store.GetEventNamespace, m.GetEventId())
+	}
+	if m, ok := msg.(interface{ GetDeliveryId() string }); ok && m.GetDeliveryId() != "" {
+		return a.resolveNamespace(ctx, a.store.GetDeliveryNamespace, m.GetDeliveryId())
+	}
+	if m, ok := msg.(interface{ GetNamespace() string }); ok && m.GetNamespace() != "" {
+		return []string{m.GetNamespace()}, nil
+	}
+
+	return nil, nil
+}
+
+func (a *Authenticator) resolveNamespace(ctx context.Context, lookup func(context.Context, string) (string, error), id string) ([]string, error) {
+	namespace, err := lookup(ctx, id)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		// Don't reveal whether the resource exists in another namespace
+		return nil, ErrPermissionDenied
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve namespace: %w", err)
+	}
+	return []string{namespace}, nil
+}
+
+// resolveNamespaces resolves the namespace of every webhook and event ID
+func (a *Authenticator) resolveNamespaces(ctx context.Context, webhookIDs, eventIDs []string) ([]string, error) {
+	var namespaces []string
+	for _, id := range webhookIDs {
+		namespace, err := a.resolveNamespace(ctx, a.store.GetWebhookNamespace, id)
+		if err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, namespace...)
+	}
+	for _, id := range eventIDs {
+		namespace, err := a.resolveNamespace(ctx, a.store.GetEventNamespace, id)
+		if err != nil {
+			return nil, err
+		}
+		namespaces = append(namespaces, namespace...)
+	}
+	return namespaces, nil
+}
+
+func keyAllows(key *webhooks.APIKey, namespace string) bool {
+	for _, allowed := range key.Namespaces {
+		if allowed == namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// bearerToken extracts the token from an "Authorization: Bearer <token>" value
+func bearerToken(authorization string) (string, bool) {
+	const prefix = "bearer "
+	if len(authorization) <= len(prefix) || !strings.EqualFold(authorization[:len(prefix)], prefix) {
+		return "", false
+	}
+	token := strings.TrimSpace(authorization[len(prefix):])
+	return token, token != ""
+}
@@ -0,0 +1,92 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+type fakeKeyStore struct {
+	keys     map[string]*webhooks.APIKey
+	webhooks map[string]string
+}
+
+func (f *fakeKeyStore) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*webhooks.APIKey, error) {
+	if key, ok := f.keys[keyHash]; ok {
+		return key, nil
+	}
+	return nil, webhooks.ErrNotFound
+}
+
+func (f *fakeKeyStore) GetWebhookNamespace(ctx context.Context, webhookID string) (string, error) {
+	if namespace, ok := f.webhooks[webhookID]; ok {
+		return namespace, nil
+	}
+	return "", webhooks.ErrNotFound
+}
+
+func (f *fakeKeyStore) GetEventNamespace(ctx context.Context, eventID string) (string, error) {
+	return "", webhooks.ErrNotFound
+}
+
+func (f *fakeKeyStore) GetDeliveryNamespace(ctx context.Context, deliveryID string) (string, error) {
+	return "", webhooks.ErrNotFound
+}
+
+func TestAuthorize(t *testing.T) {
+	store := &fakeKeyStore{
+		keys: map[string]*webhooks.APIKey{
+			HashAPIKey("orders-key"): {ID: "k1", Namespaces: []string{"orders"}},
+		},
+		webhooks: map[string]string{"wh-orders": "orders", "wh-users": "users"},
+	}
+	a := NewAuthenticator(store, "admin-key", "/webhook.WebhookService/CreateAPIKey")
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		procedure     string
+		authorization string
+		msg           any
+		want          error
+	}{
+		{"missing token", "/x", "", &pb.PushEventRequest{Namespace: "orders"}, ErrUnauthenticated},
+		{"unknown key", "/x", "Bearer nope", &pb.PushEventRequest{Namespace: "orders"}, ErrUnauthenticated},
+		{"authorized namespace", "/x", "Bearer orders-key", &pb.PushEventRequest{Namespace: "orders"}, nil},
+		{"other namespace", "/x", "bearer orders-key", &pb.PushEventRequest{Namespace: "users"}, ErrPermissionDenied},
+		{"webhook in namespace", "/x", "Bearer orders-key", &pb.UnregisterWebhookRequest{WebhookId: "wh-orders"}, nil},
+		{"webhook in other namespace", "/x", "Bearer orders-key", &pb.UnregisterWebhookRequest{WebhookId: "wh-users"}, ErrPermissionDenied},
+		{"watch webhook in namespace", "/x", "Bearer orders-key", &pb.WatchWebhookStatusRequest{
+			Identifier: &pb.WatchWebhookStatusRequest_WebhookId{WebhookId: "wh-orders"},
+		}, nil},
+		{"status batch in namespace", "/x", "Bearer orders-key", &pb.GetWebhookStatusBatchRequest{WebhookIds: []string{"wh-orders"}}, nil},
+		{"status batch with foreign webhook", "/x", "Bearer orders-key", &pb.GetWebhookStatusBatchRequest{WebhookIds: []string{"wh-orders", "wh-users"}}, ErrPermissionDenied},
+		{"batch with foreign event", "/x", "Bearer orders-key", &pb.PushEventsRequest{Events: []*pb.PushEventRequest{
+			{Namespace: "orders"}, {Namespace: "users"},
+		}}, ErrPermissionDenied},
+		{"admin procedure with namespace key", "/webhook.WebhookService/CreateAPIKey", "Bearer orders-key", &pb.CreateAPIKeyRequest{}, ErrPermissionDenied},
+		{"admin key", "/webhook.WebhookService/CreateAPIKey", "Bearer admin-key", &pb.CreateAPIKeyRequest{}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := a.Authorize(ctx, tt.procedure, tt.authorization, tt.msg)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Authorize() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestGenerateAPIKey(t *testing.T) {
+	key, hash, err := GenerateAPIKey()
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if HashAPIKey(key) != hash {
+		t.Errorf("Expected hash to match generated key")
+	}
+}
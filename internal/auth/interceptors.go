@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// UnaryServerInterceptor returns a gRPC interceptor that authorizes each unary call
+func (a *Authenticator) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if a.isPublic(info.FullMethod) {
+			return handler(ctx, req)
+		}
+		if err := a.Authorize(ctx, info.FullMethod, grpcAuthorization(ctx), req); err != nil {
+			return nil, status.Error(grpcCode(err), err.Error())
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns a gRPC interceptor that authorizes each streaming call
+// against the first message received from the client
+func (a *Authenticator) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if a.isPublic(info.FullMethod) {
+			return handler(srv, ss)
+		}
+		return handler(srv, &authorizedServerStream{ServerStream: ss, authenticator: a, procedure: info.FullMethod})
+	}
+}
+
+// authorizedServerStream authorizes the first message received on a gRPC stream
+type authorizedServerStream struct {
+	grpc.ServerStream
+	authenticator *Authenticator
+	procedure     string
+	authorized    bool
+}
+
+func (s *authorizedServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.authorized {
+		return nil
+	}
+
+	ctx := s.Context()
+	if err := s.authenticator.Authorize(ctx, s.procedure, grpcAuthorization(ctx), m); err != nil {
+		return status.Error(grpcCode(err), err.Error())
+	}
+	s.authorized = true
+	return nil
+}
+
+// ConnectInterceptor returns a Connect interceptor that authorizes each unary call, and each
+// streaming call against the first message received from the client
+func (a *Authenticator) ConnectInterceptor() connect.Interceptor {
+	return &connectInterceptor{authenticator: a}
+}
+
+type connectInterceptor struct {
+	authenticator *Authenticator
+}
+
+func (i *connectInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+
+		err := i.authenticator.Authorize(ctx, req.Spec().Procedure, req.Header().Get("Authorization"), req.Any())
+		if err != nil {
+			return nil, connect.NewError(connectCode(err), err)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *connectInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *connectInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &authorizedHandlerConn{StreamingHandlerConn: conn, ctx: ctx, authenticator: i.authenticator})
+	}
+}
+
+// authorizedHandlerConn authorizes the first message received on a Connect stream
+type authorizedHandlerConn struct {
+	connect.StreamingHandlerConn
+	ctx           context.Context
+	authenticator *Authenticator
+	authorized    bool
+}
+
+func (c *authorizedHandlerConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
+	}
+	if c.authorized {
+		return nil
+	}
+
+	err := c.authenticator.Authorize(c.ctx, c.Spec().Procedure, c.RequestHeader().Get("Authorization"), msg)
+	if err != nil {
+		return connect.NewError(connectCode(err), err)
+	}
+	c.authorized = true
+	return nil
+}
+
+// grpcAuthorization returns the authorization metadata of an incoming gRPC call
+func grpcAuthorization(ctx context.Context) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if values := md.Get("authorization"); len(values) > 0 {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func grpcCode(err error) codes.Code {
+	switch {
+	case errors.Is(err, ErrUnauthenticated):
+		return codes.Unauthenticated
+	case errors.Is(err, ErrPermissionDenied):
+		return codes.PermissionDenied
+	default:
+		return codes.Internal
+	}
+}
+
+func connectCode(err error) connect.Code {
+	switch {
+	case errors.Is(err, ErrUnauthenticated):
+		return connect.CodeUnauthenticated
+	case errors.Is(err, ErrPermissionDenied):
+		return connect.CodePermissionDenied
+	default:
+		return connect.CodeInternal
+	}
+}
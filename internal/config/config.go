@@ -1,23 +1,569 @@
 package config
 
 import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"net"
+	"net/url"
 	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 )
 
+// Version is the Sparrow release, set at build time with
+// -ldflags "-X github.com/sarathsp06/sparrow/internal/config.Version=<version>"
+var Version = "dev"
+
 // Config holds the application configuration
 type Config struct {
 	DatabaseURL string
+
+	// GRPCAddr and HTTPAddr are the host:port addresses the gRPC and Connect/HTTP servers
+	// listen on; an empty host listens on all interfaces
+	GRPCAddr string
+	HTTPAddr string
+
+	// ShutdownTimeout bounds the whole graceful shutdown: draining the servers, stopping the
+	// workers and flushing telemetry
+	ShutdownTimeout time.Duration
+
+	// HTTPWriteTimeout bounds writing a response on the Connect/HTTP server; 0 = unbounded
+	HTTPWriteTimeout time.Duration
+
+	// Environment, OTLPEndpoint and OTLPHeaders configure OpenTelemetry export, over plain
+	// HTTP when OTLPInsecure is set; TraceSampleRate is the fraction of traces sampled, from
+	// 0 to 1
+	Environment     string
+	OTLPEndpoint    string
+	OTLPHeaders     map[string]string
+	OTLPInsecure    bool
+	TraceSampleRate float64
+
+	// NamespaceTraceSampleRates overrides TraceSampleRate for requests to these namespaces
+	NamespaceTraceSampleRates map[string]float64
+
+	// TracingEnabled and MetricsEnabled turn exporting each signal on or off
+	TracingEnabled bool
+	MetricsEnabled bool
+
+	// DBMaxConns, DBMinConns and DBMaxConnLifetime size the Postgres connection pool
+	DBMaxConns        int
+	DBMinConns        int
+	DBMaxConnLifetime time.Duration
+
+	// QueueDefaultWorkers, QueueEventsWorkers and QueueWebhooksWorkers set the concurrency of each queue
+	QueueDefaultWorkers  int
+	QueueEventsWorkers   int
+	QueueWebhooksWorkers int
+
+	// QueueDepthPollInterval controls how often queue depth metrics are refreshed
+	QueueDepthPollInterval time.Duration
+
+	// CleanupInterval controls how often expired events and old deliveries are removed
+	CleanupInterval time.Duration
+	// DeliveryRetention is how long terminal deliveries are kept before deletion
+	DeliveryRetention time.Duration
+	// CleanupBatchSize limits rows deleted per statement to avoid long locks
+	CleanupBatchSize int
+
+	// StuckDeliverySweepInterval controls how often deliveries stuck in "sending" are looked for,
+	// and StuckDeliveryThreshold how long a delivery must have been sending to be considered
+	StuckDeliverySweepInterval time.Duration
+	StuckDeliveryThreshold     time.Duration
+
+	// WebhookExpirySweepInterval controls how often webhooks past their expires_at are deactivated
+	WebhookExpirySweepInterval time.Duration
+
+	// WebhookHealthWindow is the rolling window over which each webhook's success rate is
+	// tracked. A webhook whose failure rate over it exceeds WebhookAutoDisableFailureRate
+	// (0 = never), after at least WebhookAutoDisableMinAttempts attempts, is deactivated.
+	WebhookHealthWindow           time.Duration
+	WebhookAutoDisableFailureRate float64
+	WebhookAutoDisableMinAttempts int
+
+	// CompressEventPayloads stores event payloads larger than EventPayloadCompressionThreshold bytes gzipped
+	CompressEventPayloads            bool
+	EventPayloadCompressionThreshold int
+
+	// PayloadEncryptionKeys ("version:base64-key,...") encrypts stored event payloads with
+	// AES-GCM; PayloadEncryptionKeyVersion picks the key for new payloads (0 = highest version)
+	PayloadEncryptionKeys       string
+	PayloadEncryptionKeyVersion int
+
+	// MaxDeliveryTimeout caps webhook timeouts and per-event delivery timeout overrides
+	MaxDeliveryTimeout time.Duration
+
+	// DeliverySLO is the delivery duration above which an attempt counts as an SLO
+	// violation; 0 = no SLO
+	DeliverySLO time.Duration
+
+	// MaxFanoutPerEvent caps the deliveries one event processing job schedules; the rest of
+	// the fan-out continues in a follow-up job
+	MaxFanoutPerEvent int
+
+	// MaxSynchronousWebhooks caps the webhooks a synchronous event push delivers to inline;
+	// SynchronousPushTimeout caps how long it waits for them (0 = until they finish)
+	MaxSynchronousWebhooks int
+	SynchronousPushTimeout time.Duration
+
+	// DefaultEventTTLSeconds applies to events pushed without a TTL; MaxEventTTLSeconds is the
+	// longest TTL an event may have (0 = unlimited)
+	DefaultEventTTLSeconds int
+	MaxEventTTLSeconds     int
+
+	// MaxRetryAfter caps how long a receiver's Retry-After header can delay the next attempt
+	MaxRetryAfter time.Duration
+
+	// DeliveryCallbackURL, when set, is notified whenever a delivery reaches a terminal state
+	DeliveryCallbackURL string
+
+	// HTTPMaxIdleConnsPerHost and HTTPMaxConnsPerHost bound the delivery client's connections to each receiver host
+	HTTPMaxIdleConnsPerHost int
+	HTTPMaxConnsPerHost     int
+
+	// HTTPDialTimeout, HTTPTLSHandshakeTimeout and HTTPResponseHeaderTimeout bound the phases of
+	// a delivery request before its response body; 0 leaves the response header wait bounded
+	// by the delivery's timeout only
+	HTTPDialTimeout           time.Duration
+	HTTPTLSHandshakeTimeout   time.Duration
+	HTTPResponseHeaderTimeout time.Duration
+
+	// HTTPRedirectPolicy decides which redirects deliveries follow: RedirectNone, RedirectSameHost
+	// or RedirectAll
+	HTTPRedirectPolicy string
+
+	// AllowedWebhookPorts are the ports http(s) webhook URLs may use; empty = any port
+	AllowedWebhookPorts []int
+
+	// WebhookProxyURL is the proxy deliveries go through; empty = HTTP_PROXY, HTTPS_PROXY and
+	// NO_PROXY from the environment
+	WebhookProxyURL string
+
+	// SimulateDeliveries replaces real deliveries with simulated ones, for load testing the
+	// queue and database without receivers: each attempt waits a random latency between
+	// SimulatedLatencyMin and SimulatedLatencyMax, then fails with a 500 at
+	// SimulatedFailureRate or succeeds with a 200. It's refused outside the development, test
+	// and loadtest environments.
+	SimulateDeliveries   bool
+	SimulatedLatencyMin  time.Duration
+	SimulatedLatencyMax  time.Duration
+	SimulatedFailureRate float64
+
+	// HTTPBodyReadTimeout bounds reading a delivery's response body once its headers arrived;
+	// 0 = bounded by the delivery's timeout only
+	HTTPBodyReadTimeout time.Duration
+
+	// CompressBodyThreshold is the smallest body gzipped for webhooks with compress_body
+	CompressBodyThreshold int
+
+	// UserAgent is sent with every delivery unless the webhook sets its own User-Agent header
+	UserAgent string
+
+	// AWSRegion enables sqs:// delivery targets; the AWS credentials sign requests to SQS.
+	// SQSEndpoint overrides the regional endpoint, e.g. for a local emulator
+	AWSRegion          string
+	AWSAccessKeyID     string
+	AWSSecretAccessKey string
+	AWSSessionToken    string
+	SQSEndpoint        string
+
+	// MaxStoredResponseBytes is the default amount of each response body stored per delivery
+	MaxStoredResponseBytes int
+
+	// TLSCertFile and TLSKeyFile, when set, serve gRPC and HTTP over TLS instead of plaintext
+	TLSCertFile string
+	TLSKeyFile  string
+
+	// CORSAllowedOrigins lists the browser origins allowed to call the Connect API; empty
+	// disables CORS. CORSAllowedMethods and CORSAllowedHeaders extend the defaults.
+	CORSAllowedOrigins []string
+	CORSAllowedMethods []string
+	CORSAllowedHeaders []string
+
+	// LogLevel (debug, info, warn, error) and LogFormat (json, text) configure logging
+	LogLevel  string
+	LogFormat string
+
+	// StrictNamespaces requires namespaces to be created with CreateNamespace before use
+	StrictNamespaces bool
+	// MaxWebhooksPerNamespace caps the webhooks each namespace can register (0 = unlimited);
+	// a namespace's max_webhooks column overrides it
+	MaxWebhooksPerNamespace int
+
+	// AuthEnabled requires API keys on every gRPC/Connect request
+	AuthEnabled bool
+	// AdminAPIKey grants access to all namespaces and admin RPCs
+	AdminAPIKey string
 }
 
-// Load loads configuration from environment variables
-func Load() *Config {
+// DefaultDatabaseURL is used when DATABASE_URL is unset, for local development
+const DefaultDatabaseURL = "postgres://localhost/riverqueue?sslmode=disable"
+
+// DefaultEnvFile is the file Load reads variables from, if it exists; ENV_FILE overrides it
+const DefaultEnvFile = ".env"
+
+// simulationEnvironments are the only environments SIMULATE_DELIVERIES may be enabled in.
+// Anything else, including a misspelled or unfamiliar name, is treated as production.
+var simulationEnvironments = []string{"development", "test", "loadtest"}
+
+// Redirect policies for deliveries. Following a redirect can turn a POST into a GET to
+// another host, so by default none are followed.
+const (
+	RedirectNone     = "none"      // Record the redirect response itself
+	RedirectSameHost = "same_host" // Follow redirects to the same host, never from https to http
+	RedirectAll      = "all"       // Follow up to 10 redirects anywhere
+)
+
+// Load loads configuration from environment variables, after reading any variables missing
+// from the environment from the .env file. Every invalid setting is reported, not just the
+// first.
+func Load() (*Config, error) {
+	envFile := os.Getenv("ENV_FILE")
+	if envFile == "" {
+		envFile = DefaultEnvFile
+	}
+	if err := LoadEnvFile(envFile); err != nil && (envFile != DefaultEnvFile || !errors.Is(err, fs.ErrNotExist)) {
+		return nil, err
+	}
+
 	cfg := &Config{}
+	env := &envLoader{}
+
+	cfg.DatabaseURL = env.String("DATABASE_URL", DefaultDatabaseURL)
+
+	cfg.LogLevel = env.String("LOG_LEVEL", "info")
+	cfg.LogFormat = env.String("LOG_FORMAT", "json")
+
+	// GRPC_PORT and HTTP_PORT remain as shorthands for listening on all interfaces
+	cfg.GRPCAddr = env.String("GRPC_ADDR", ":"+strconv.Itoa(env.Int("GRPC_PORT", 50051)))
+	cfg.HTTPAddr = env.String("HTTP_ADDR", ":"+strconv.Itoa(env.Int("HTTP_PORT", 8080)))
+	cfg.ShutdownTimeout = env.Duration("SHUTDOWN_TIMEOUT", 30*time.Second)
+	cfg.HTTPWriteTimeout = env.Duration("HTTP_WRITE_TIMEOUT", 30*time.Second)
+
+	cfg.Environment = env.String("ENVIRONMENT", "development")
+	cfg.OTLPEndpoint = env.String("OTEL_EXPORTER_OTLP_ENDPOINT", "localhost:4318")
+	cfg.OTLPHeaders = env.Map("OTEL_EXPORTER_OTLP_HEADERS")
+	cfg.OTLPInsecure = env.Bool("OTEL_EXPORTER_OTLP_INSECURE", true)
+	cfg.TraceSampleRate = env.Float("OTEL_TRACE_SAMPLE_RATE", 1.0)
+	cfg.NamespaceTraceSampleRates = env.FloatMap("OTEL_TRACE_SAMPLE_RATES")
+	cfg.TracingEnabled = env.Bool("OTEL_TRACING_ENABLED", true)
+	cfg.MetricsEnabled = env.Bool("OTEL_METRICS_ENABLED", true)
+
+	cfg.DBMaxConns = env.Int("DB_MAX_CONNS", 30)
+	cfg.DBMinConns = env.Int("DB_MIN_CONNS", 2)
+	cfg.DBMaxConnLifetime = env.Duration("DB_MAX_CONN_LIFETIME", time.Hour)
+
+	cfg.QueueDefaultWorkers = env.Int("QUEUE_DEFAULT_WORKERS", 10)
+	cfg.QueueEventsWorkers = env.Int("QUEUE_EVENTS_WORKERS", 5)
+	cfg.QueueWebhooksWorkers = env.Int("QUEUE_WEBHOOKS_WORKERS", 8)
+
+	cfg.QueueDepthPollInterval = env.Duration("QUEUE_DEPTH_POLL_INTERVAL", 15*time.Second)
+
+	cfg.CleanupInterval = env.Duration("CLEANUP_INTERVAL", time.Hour)
+	cfg.DeliveryRetention = env.Duration("DELIVERY_RETENTION", 7*24*time.Hour)
+	cfg.CleanupBatchSize = env.Int("CLEANUP_BATCH_SIZE", 1000)
+
+	cfg.StuckDeliverySweepInterval = env.Duration("STUCK_DELIVERY_SWEEP_INTERVAL", 5*time.Minute)
+	cfg.StuckDeliveryThreshold = env.Duration("STUCK_DELIVERY_THRESHOLD", 15*time.Minute)
+	cfg.WebhookExpirySweepInterval = env.Duration("WEBHOOK_EXPIRY_SWEEP_INTERVAL", time.Minute)
+
+	cfg.WebhookHealthWindow = env.Duration("WEBHOOK_HEALTH_WINDOW", time.Hour)
+	cfg.WebhookAutoDisableFailureRate = env.Float("WEBHOOK_AUTO_DISABLE_FAILURE_RATE", 0)
+	cfg.WebhookAutoDisableMinAttempts = env.Int("WEBHOOK_AUTO_DISABLE_MIN_ATTEMPTS", 20)
+
+	cfg.CompressEventPayloads = env.Bool("COMPRESS_EVENT_PAYLOADS", false)
+	cfg.EventPayloadCompressionThreshold = env.Int("EVENT_PAYLOAD_COMPRESSION_THRESHOLD", 4096)
+
+	cfg.PayloadEncryptionKeys = env.String("PAYLOAD_ENCRYPTION_KEYS", "")
+	cfg.PayloadEncryptionKeyVersion = env.NonNegativeInt("PAYLOAD_ENCRYPTION_KEY_VERSION", 0)
+
+	cfg.MaxDeliveryTimeout = env.Duration("MAX_DELIVERY_TIMEOUT", 120*time.Second)
+	if seconds := env.Int("MAX_DELIVERY_TIMEOUT_SECONDS", 0); seconds > 0 {
+		cfg.MaxDeliveryTimeout = time.Duration(seconds) * time.Second
+	}
+	cfg.DeliverySLO = time.Duration(env.NonNegativeInt("DELIVERY_SLO_MS", 0)) * time.Millisecond
+	cfg.MaxFanoutPerEvent = env.Int("MAX_FANOUT_PER_EVENT", 500)
+	cfg.MaxSynchronousWebhooks = env.NonNegativeInt("MAX_SYNCHRONOUS_WEBHOOKS", 5)
+	cfg.SynchronousPushTimeout = env.Duration("SYNCHRONOUS_PUSH_TIMEOUT", 25*time.Second)
+	cfg.DefaultEventTTLSeconds = env.Int("DEFAULT_EVENT_TTL_SECONDS", 3600)
+	cfg.MaxEventTTLSeconds = env.NonNegativeInt("MAX_EVENT_TTL_SECONDS", 7*24*3600)
+
+	cfg.MaxRetryAfter = env.Duration("MAX_RETRY_AFTER", time.Hour)
+
+	cfg.DeliveryCallbackURL = env.String("DELIVERY_CALLBACK_URL", "")
+
+	cfg.HTTPMaxIdleConnsPerHost = env.Int("HTTP_MAX_IDLE_CONNS_PER_HOST", 10)
+	cfg.HTTPMaxConnsPerHost = env.Int("HTTP_MAX_CONNS_PER_HOST", 50)
+	cfg.HTTPRedirectPolicy = env.String("HTTP_REDIRECT_POLICY", RedirectNone)
+	cfg.WebhookProxyURL = env.String("WEBHOOK_PROXY_URL", "")
+	cfg.AllowedWebhookPorts = env.Ports("ALLOWED_WEBHOOK_PORTS", []int{80, 443})
+	cfg.HTTPDialTimeout = env.Duration("HTTP_DIAL_TIMEOUT", 30*time.Second)
+	cfg.HTTPTLSHandshakeTimeout = env.Duration("HTTP_TLS_HANDSHAKE_TIMEOUT", 10*time.Second)
+	cfg.HTTPResponseHeaderTimeout = env.Duration("HTTP_RESPONSE_HEADER_TIMEOUT", 0)
+	cfg.HTTPBodyReadTimeout = env.Duration("HTTP_BODY_READ_TIMEOUT", 0)
+
+	cfg.SimulateDeliveries = env.Bool("SIMULATE_DELIVERIES", false)
+	cfg.SimulatedLatencyMin = env.Duration("SIMULATED_LATENCY_MIN", 50*time.Millisecond)
+	cfg.SimulatedLatencyMax = env.Duration("SIMULATED_LATENCY_MAX", 200*time.Millisecond)
+	cfg.SimulatedFailureRate = env.Float("SIMULATED_FAILURE_RATE", 0)
 
-	cfg.DatabaseURL = os.Getenv("DATABASE_URL")
-	if cfg.DatabaseURL == "" {
-		// Default connection string for local development
-		cfg.DatabaseURL = "postgres://localhost/riverqueue?sslmode=disable"
+	cfg.CompressBodyThreshold = env.Int("COMPRESS_BODY_THRESHOLD", 1024)
+
+	cfg.UserAgent = env.String("USER_AGENT", "Sparrow/"+Version)
+
+	cfg.AWSRegion = env.String("AWS_REGION", "")
+	cfg.AWSAccessKeyID = env.String("AWS_ACCESS_KEY_ID", "")
+	cfg.AWSSecretAccessKey = env.String("AWS_SECRET_ACCESS_KEY", "")
+	cfg.AWSSessionToken = env.String("AWS_SESSION_TOKEN", "")
+	cfg.SQSEndpoint = env.String("SQS_ENDPOINT", "")
+
+	cfg.MaxStoredResponseBytes = env.Int("MAX_STORED_RESPONSE_BYTES", 1000)
+
+	cfg.TLSCertFile = env.String("TLS_CERT_FILE", "")
+	cfg.TLSKeyFile = env.String("TLS_KEY_FILE", "")
+
+	cfg.CORSAllowedOrigins = env.List("CORS_ALLOWED_ORIGINS")
+	cfg.CORSAllowedMethods = env.List("CORS_ALLOWED_METHODS")
+	cfg.CORSAllowedHeaders = env.List("CORS_ALLOWED_HEADERS")
+
+	cfg.StrictNamespaces = env.Bool("STRICT_NAMESPACES", false)
+	cfg.MaxWebhooksPerNamespace = env.NonNegativeInt("MAX_WEBHOOKS_PER_NAMESPACE", 0)
+	cfg.AuthEnabled = env.Bool("AUTH_ENABLED", false)
+	cfg.AdminAPIKey = env.String("ADMIN_API_KEY", "")
+
+	if err := errors.Join(append(env.errs, cfg.Validate())...); err != nil {
+		return nil, fmt.Errorf("invalid configuration: %w", err)
 	}
+	return cfg, nil
+}
 
-	return cfg
+// Validate checks settings that depend on each other or have a limited range
+func (c *Config) Validate() error {
+	var errs []error
+	if err := validateListenAddr("GRPC_ADDR", c.GRPCAddr); err != nil {
+		errs = append(errs, err)
+	}
+	if err := validateListenAddr("HTTP_ADDR", c.HTTPAddr); err != nil {
+		errs = append(errs, err)
+	}
+	if c.GRPCAddr == c.HTTPAddr {
+		errs = append(errs, fmt.Errorf("GRPC_ADDR and HTTP_ADDR must differ, both are %q", c.GRPCAddr))
+	}
+	if c.MaxEventTTLSeconds > 0 && c.DefaultEventTTLSeconds > c.MaxEventTTLSeconds {
+		errs = append(errs, fmt.Errorf("DEFAULT_EVENT_TTL_SECONDS (%d) must not exceed MAX_EVENT_TTL_SECONDS (%d)", c.DefaultEventTTLSeconds, c.MaxEventTTLSeconds))
+	}
+	if c.HTTPWriteTimeout > 0 && (c.SynchronousPushTimeout <= 0 || c.SynchronousPushTimeout >= c.HTTPWriteTimeout) {
+		errs = append(errs, fmt.Errorf("SYNCHRONOUS_PUSH_TIMEOUT (%v) must be set below HTTP_WRITE_TIMEOUT (%v)", c.SynchronousPushTimeout, c.HTTPWriteTimeout))
+	}
+	if c.DBMaxConns < c.DBMinConns {
+		errs = append(errs, fmt.Errorf("DB_MAX_CONNS (%d) must be greater than or equal to DB_MIN_CONNS (%d)", c.DBMaxConns, c.DBMinConns))
+	}
+	if c.TraceSampleRate < 0 || c.TraceSampleRate > 1 {
+		errs = append(errs, fmt.Errorf("OTEL_TRACE_SAMPLE_RATE must be between 0 and 1, got %g", c.TraceSampleRate))
+	}
+	for namespace, rate := range c.NamespaceTraceSampleRates {
+		if rate < 0 || rate > 1 {
+			errs = append(errs, fmt.Errorf("OTEL_TRACE_SAMPLE_RATES must be between 0 and 1, got %g for %q", rate, namespace))
+		}
+	}
+	if c.WebhookAutoDisableFailureRate < 0 || c.WebhookAutoDisableFailureRate >= 1 {
+		errs = append(errs, fmt.Errorf("WEBHOOK_AUTO_DISABLE_FAILURE_RATE must be at least 0 and below 1, got %g", c.WebhookAutoDisableFailureRate))
+	}
+	switch c.HTTPRedirectPolicy {
+	case RedirectNone, RedirectSameHost, RedirectAll:
+	default:
+		errs = append(errs, fmt.Errorf("HTTP_REDIRECT_POLICY must be %s, %s or %s, got %q", RedirectNone, RedirectSameHost, RedirectAll, c.HTTPRedirectPolicy))
+	}
+	if c.WebhookProxyURL != "" {
+		u, err := url.Parse(c.WebhookProxyURL)
+		if err != nil || u.Host == "" || (u.Scheme != "http" && u.Scheme != "https" && u.Scheme != "socks5") {
+			errs = append(errs, fmt.Errorf("WEBHOOK_PROXY_URL must be an http, https or socks5 URL, got %q", c.WebhookProxyURL))
+		}
+	}
+	if c.SimulateDeliveries {
+		if !slices.Contains(simulationEnvironments, strings.ToLower(strings.TrimSpace(c.Environment))) {
+			errs = append(errs, fmt.Errorf("SIMULATE_DELIVERIES is only allowed when ENVIRONMENT is one of %s, got %q",
+				strings.Join(simulationEnvironments, ", "), c.Environment))
+		}
+		if c.SimulatedLatencyMin < 0 || c.SimulatedLatencyMax < c.SimulatedLatencyMin {
+			errs = append(errs, fmt.Errorf("SIMULATED_LATENCY_MIN (%v) must be at least 0 and not exceed SIMULATED_LATENCY_MAX (%v)", c.SimulatedLatencyMin, c.SimulatedLatencyMax))
+		}
+		if c.SimulatedFailureRate < 0 || c.SimulatedFailureRate > 1 {
+			errs = append(errs, fmt.Errorf("SIMULATED_FAILURE_RATE must be between 0 and 1, got %g", c.SimulatedFailureRate))
+		}
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		errs = append(errs, fmt.Errorf("TLS_CERT_FILE and TLS_KEY_FILE must be set together"))
+	}
+	return errors.Join(errs...)
+}
+
+// validateListenAddr checks that addr is a host:port address with a port from 0 to 65535
+// (0 picks a free port)
+func validateListenAddr(name, addr string) error {
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return fmt.Errorf("%s must be a host:port address such as :8080 or 127.0.0.1:8080, got %q", name, addr)
+	}
+	if n, err := strconv.Atoi(port); err != nil || n < 0 || n > 65535 {
+		return fmt.Errorf("%s must have a port between 0 and 65535, got %q", name, addr)
+	}
+	return nil
+}
+
+// envLoader reads typed settings from the environment, collecting an error for each value
+// that can't be parsed. Unset or empty variables take their default.
+type envLoader struct {
+	errs []error
+}
+
+// String returns the variable, or def when it is unset or empty
+func (l *envLoader) String(key, def string) string {
+	if value := os.Getenv(key); value != "" {
+		return value
+	}
+	return def
+}
+
+// List splits a comma-separated variable, dropping empty entries
+func (l *envLoader) List(key string) []string {
+	var list []string
+	for _, item := range strings.Split(os.Getenv(key), ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	return list
+}
+
+// Map parses a comma-separated list of key=value pairs, as used by OTEL_EXPORTER_OTLP_HEADERS
+func (l *envLoader) Map(key string) map[string]string {
+	list := l.List(key)
+	if len(list) == 0 {
+		return nil
+	}
+	m := make(map[string]string, len(list))
+	for _, item := range list {
+		k, v, ok := strings.Cut(item, "=")
+		if !ok || strings.TrimSpace(k) == "" {
+			l.errs = append(l.errs, fmt.Errorf("%s must be a comma-separated list of key=value pairs, got %q", key, item))
+			continue
+		}
+		m[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	return m
+}
+
+// FloatMap parses a comma-separated list of key=number pairs
+func (l *envLoader) FloatMap(key string) map[string]float64 {
+	pairs := l.Map(key)
+	if len(pairs) == 0 {
+		return nil
+	}
+	m := make(map[string]float64, len(pairs))
+	for k, v := range pairs {
+		f, err := strconv.ParseFloat(v, 64)
+		if err != nil {
+			l.errs = append(l.errs, fmt.Errorf("%s must map keys to numbers, got %q for %q", key, v, k))
+			continue
+		}
+		m[k] = f
+	}
+	return m
+}
+
+// Ports parses a comma-separated list of TCP ports. Unlike other settings, setting it to an
+// empty value gives an empty list rather than def.
+func (l *envLoader) Ports(key string, def []int) []int {
+	value, ok := os.LookupEnv(key)
+	if !ok {
+		return def
+	}
+	var ports []int
+	for _, field := range strings.Split(value, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		port, err := strconv.Atoi(field)
+		if err != nil || port < 1 || port > 65535 {
+			l.errs = append(l.errs, fmt.Errorf("%s must be a comma-separated list of ports from 1 to 65535, got %q", key, field))
+			continue
+		}
+		ports = append(ports, port)
+	}
+	return ports
+}
+
+// Duration parses a positive duration (e.g. "30s")
+func (l *envLoader) Duration(key string, def time.Duration) time.Duration {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d <= 0 {
+		l.errs = append(l.errs, fmt.Errorf("%s must be a positive duration such as 30s, got %q", key, value))
+		return def
+	}
+	return d
+}
+
+// Int parses a positive integer
+func (l *envLoader) Int(key string, def int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n <= 0 {
+		l.errs = append(l.errs, fmt.Errorf("%s must be a positive integer, got %q", key, value))
+		return def
+	}
+	return n
+}
+
+// NonNegativeInt parses an integer that may be zero
+func (l *envLoader) NonNegativeInt(key string, def int) int {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		l.errs = append(l.errs, fmt.Errorf("%s must be a non-negative integer, got %q", key, value))
+		return def
+	}
+	return n
+}
+
+// Float parses a floating-point number
+func (l *envLoader) Float(key string, def float64) float64 {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+	f, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%s must be a number, got %q", key, value))
+		return def
+	}
+	return f
+}
+
+// Bool parses a boolean (true/false/1/0)
+func (l *envLoader) Bool(key string, def bool) bool {
+	value := os.Getenv(key)
+	if value == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		l.errs = append(l.errs, fmt.Errorf("%s must be true or false, got %q", key, value))
+		return def
+	}
+	return b
 }
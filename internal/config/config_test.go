@@ -0,0 +1,130 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadDefaults(t *testing.T) {
+	t.Setenv("ENV_FILE", filepath.Join(t.TempDir(), "missing.env"))
+
+	if _, err := Load(); err == nil {
+		t.Fatal("expected an error for a missing ENV_FILE")
+	}
+
+	t.Setenv("ENV_FILE", "")
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.GRPCAddr != ":50051" || cfg.HTTPAddr != ":8080" || cfg.DBMaxConns != 30 {
+		t.Errorf("got addresses %q/%q and DB_MAX_CONNS %d, want defaults", cfg.GRPCAddr, cfg.HTTPAddr, cfg.DBMaxConns)
+	}
+	if !slices.Equal(cfg.AllowedWebhookPorts, []int{80, 443}) {
+		t.Errorf("got ALLOWED_WEBHOOK_PORTS %v, want 80 and 443", cfg.AllowedWebhookPorts)
+	}
+
+	// An empty allowlist allows any port
+	t.Setenv("ALLOWED_WEBHOOK_PORTS", "")
+	if cfg, err := Load(); err != nil || len(cfg.AllowedWebhookPorts) != 0 {
+		t.Errorf("got ALLOWED_WEBHOOK_PORTS %v, %v; want none", cfg.AllowedWebhookPorts, err)
+	}
+}
+
+func TestLoadReportsInvalidSettings(t *testing.T) {
+	t.Setenv("DB_MAX_CONNS", "many")
+	t.Setenv("CLEANUP_INTERVAL", "-1h")
+	t.Setenv("AUTH_ENABLED", "maybe")
+	t.Setenv("OTEL_TRACE_SAMPLE_RATE", "2")
+	t.Setenv("WEBHOOK_AUTO_DISABLE_FAILURE_RATE", "1.5")
+	t.Setenv("HTTP_ADDR", "localhost")
+	t.Setenv("DEFAULT_EVENT_TTL_SECONDS", "86400")
+	t.Setenv("MAX_EVENT_TTL_SECONDS", "3600")
+	t.Setenv("HTTP_REDIRECT_POLICY", "sometimes")
+	t.Setenv("WEBHOOK_PROXY_URL", "proxy.internal:3128")
+	t.Setenv("ALLOWED_WEBHOOK_PORTS", "443,https")
+	t.Setenv("ENVIRONMENT", "staging")
+	t.Setenv("SIMULATE_DELIVERIES", "true")
+	t.Setenv("SIMULATED_FAILURE_RATE", "1.5")
+	t.Setenv("SYNCHRONOUS_PUSH_TIMEOUT", "45s")
+
+	_, err := Load()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	for _, key := range []string{"DB_MAX_CONNS", "CLEANUP_INTERVAL", "AUTH_ENABLED", "OTEL_TRACE_SAMPLE_RATE", "WEBHOOK_AUTO_DISABLE_FAILURE_RATE", "HTTP_ADDR", "MAX_EVENT_TTL_SECONDS", "HTTP_REDIRECT_POLICY", "WEBHOOK_PROXY_URL", "ALLOWED_WEBHOOK_PORTS", "SIMULATE_DELIVERIES", "SIMULATED_FAILURE_RATE", "SYNCHRONOUS_PUSH_TIMEOUT"} {
+		if !strings.Contains(err.Error(), key) {
+			t.Errorf("error %q doesn't mention %s", err, key)
+		}
+	}
+}
+
+func TestLoadEnvFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".env")
+	contents := `# Local settings
+DATABASE_URL=postgres://dev@localhost/sparrow
+export QUEUE_EVENTS_WORKERS=3
+CLEANUP_INTERVAL="30m"
+USER_AGENT='Sparrow Dev'
+OTEL_EXPORTER_OTLP_HEADERS=x-api-key=secret, x-team=hooks
+GRPC_PORT=9000 # overridden below
+`
+	if err := os.WriteFile(path, []byte(contents), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("ENV_FILE", path)
+	t.Setenv("GRPC_PORT", "9090")
+
+	// Variables set by the file are cleaned up with the test's own
+	for _, key := range []string{"DATABASE_URL", "QUEUE_EVENTS_WORKERS", "CLEANUP_INTERVAL", "USER_AGENT", "OTEL_EXPORTER_OTLP_HEADERS"} {
+		t.Setenv(key, "")
+		os.Unsetenv(key)
+	}
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load failed: %v", err)
+	}
+	if cfg.DatabaseURL != "postgres://dev@localhost/sparrow" {
+		t.Errorf("DatabaseURL = %q", cfg.DatabaseURL)
+	}
+	if cfg.QueueEventsWorkers != 3 || cfg.CleanupInterval != 30*time.Minute || cfg.UserAgent != "Sparrow Dev" {
+		t.Errorf("got workers=%d cleanup=%v user_agent=%q", cfg.QueueEventsWorkers, cfg.CleanupInterval, cfg.UserAgent)
+	}
+	if cfg.OTLPHeaders["x-api-key"] != "secret" || cfg.OTLPHeaders["x-team"] != "hooks" {
+		t.Errorf("OTLPHeaders = %v", cfg.OTLPHeaders)
+	}
+	// The environment wins over the file
+	if cfg.GRPCAddr != ":9090" {
+		t.Errorf("GRPCAddr = %q, want :9090 from the environment", cfg.GRPCAddr)
+	}
+}
+
+func TestParseEnvFileRejectsMalformedLines(t *testing.T) {
+	if _, err := parseEnvFile(strings.NewReader("OK=1\nnot a variable\n"), ".env"); err == nil ||
+		!strings.Contains(err.Error(), ".env:2") {
+		t.Errorf("expected an error for line 2, got %v", err)
+	}
+}
+
+func TestSimulateDeliveriesEnvironments(t *testing.T) {
+	t.Setenv("ENV_FILE", "")
+	t.Setenv("SIMULATE_DELIVERIES", "true")
+	for environment, allowed := range map[string]bool{
+		"development": true,
+		"test":        true,
+		"LoadTest":    true,
+		"staging":     false,
+		"production":  false,
+		"prd":         false,
+	} {
+		t.Setenv("ENVIRONMENT", environment)
+		if _, err := Load(); (err == nil) != allowed {
+			t.Errorf("ENVIRONMENT=%s: Load error = %v, want allowed %t", environment, err, allowed)
+		}
+	}
+}
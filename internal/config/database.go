@@ -0,0 +1,41 @@
+package config
+
+import (
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// PoolHeadroom is the number of connections kept free for River's own polling and leader
+// election and for API requests, beyond one per queue worker
+const PoolHeadroom = 5
+
+// CheckPoolCapacity reports an error if workers running jobs at once, plus PoolHeadroom,
+// could need more connections than DB_MAX_CONNS allows. Workers then block waiting for a
+// connection while holding their jobs, which can stall every queue.
+func (c *Config) CheckPoolCapacity(workers int) error {
+	if needed := workers + PoolHeadroom; needed > c.DBMaxConns {
+		return fmt.Errorf("DB_MAX_CONNS (%d) is too small for %d queue workers: set it to at least %d, "+
+			"or lower QUEUE_DEFAULT_WORKERS, QUEUE_EVENTS_WORKERS and QUEUE_WEBHOOKS_WORKERS",
+			c.DBMaxConns, workers, needed)
+	}
+	return nil
+}
+
+// PoolConfig builds a pgxpool configuration from DatabaseURL and the pool settings
+func (c *Config) PoolConfig() (*pgxpool.Config, error) {
+	if c.DBMaxConns < c.DBMinConns {
+		return nil, fmt.Errorf("DB_MAX_CONNS (%d) must be greater than or equal to DB_MIN_CONNS (%d)", c.DBMaxConns, c.DBMinConns)
+	}
+
+	poolConfig, err := pgxpool.ParseConfig(c.DatabaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse database URL: %w", err)
+	}
+
+	poolConfig.MaxConns = int32(c.DBMaxConns)
+	poolConfig.MinConns = int32(c.DBMinConns)
+	poolConfig.MaxConnLifetime = c.DBMaxConnLifetime
+
+	return poolConfig, nil
+}
@@ -0,0 +1,49 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPoolConfig(t *testing.T) {
+	cfg := &Config{
+		DatabaseURL:       "postgres://localhost/riverqueue?sslmode=disable",
+		DBMaxConns:        30,
+		DBMinConns:        5,
+		DBMaxConnLifetime: 10 * time.Minute,
+	}
+
+	poolConfig, err := cfg.PoolConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if poolConfig.MaxConns != 30 || poolConfig.MinConns != 5 {
+		t.Errorf("got max=%d min=%d, want max=30 min=5", poolConfig.MaxConns, poolConfig.MinConns)
+	}
+	if poolConfig.MaxConnLifetime != 10*time.Minute {
+		t.Errorf("got lifetime %v, want 10m", poolConfig.MaxConnLifetime)
+	}
+}
+
+func TestPoolConfigRejectsMinAboveMax(t *testing.T) {
+	cfg := &Config{
+		DatabaseURL: "postgres://localhost/riverqueue?sslmode=disable",
+		DBMaxConns:  2,
+		DBMinConns:  5,
+	}
+
+	if _, err := cfg.PoolConfig(); err == nil {
+		t.Fatal("expected error when DB_MIN_CONNS exceeds DB_MAX_CONNS")
+	}
+}
+
+func TestCheckPoolCapacity(t *testing.T) {
+	cfg := &Config{DBMaxConns: 20}
+
+	if err := cfg.CheckPoolCapacity(20 - PoolHeadroom); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := cfg.CheckPoolCapacity(20 - PoolHeadroom + 1); err == nil {
+		t.Error("expected error when workers and headroom exceed DB_MAX_CONNS")
+	}
+}
@@ -0,0 +1,23 @@
+package config
+
+import "testing"
+
+func TestTLSConfigDisabled(t *testing.T) {
+	cfg := &Config{}
+
+	tlsConfig, err := cfg.TLSConfig()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if tlsConfig != nil {
+		t.Errorf("expected no TLS config when no certificate is set")
+	}
+}
+
+func TestTLSConfigRequiresCertAndKey(t *testing.T) {
+	cfg := &Config{TLSCertFile: "server.crt"}
+
+	if _, err := cfg.TLSConfig(); err == nil {
+		t.Error("expected an error when only TLS_CERT_FILE is set")
+	}
+}
@@ -0,0 +1,83 @@
+package connect
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// corsRequiredHeaders are the request headers Connect, gRPC-Web and authentication use
+var corsRequiredHeaders = []string{
+	"Content-Type",
+	"Connect-Protocol-Version",
+	"Connect-Timeout-Ms",
+	"Grpc-Timeout",
+	"X-Grpc-Web",
+	"X-User-Agent",
+	"Authorization",
+}
+
+// corsExposedHeaders are the response headers browser clients need to read errors
+var corsExposedHeaders = []string{
+	"Grpc-Status",
+	"Grpc-Message",
+	"Grpc-Status-Details-Bin",
+}
+
+// corsMaxAge is how long browsers may cache a preflight response
+const corsMaxAge = 2 * time.Hour
+
+// CORSConfig configures which browser origins may call the Connect handler
+type CORSConfig struct {
+	AllowedOrigins []string // Exact origins such as https://app.example.com, or "*" for any
+	AllowedMethods []string // Defaults to GET and POST, the methods Connect uses
+	AllowedHeaders []string // Allowed in addition to the headers Connect needs
+}
+
+// CORS wraps next so browsers at the configured origins can call it, answering preflight
+// requests itself. Requests from other origins get no CORS headers, so browsers block them.
+// With no allowed origins, next is returned unchanged.
+func CORS(cfg CORSConfig, next http.Handler) http.Handler {
+	if len(cfg.AllowedOrigins) == 0 {
+		return next
+	}
+
+	methods := cfg.AllowedMethods
+	if len(methods) == 0 {
+		methods = []string{http.MethodGet, http.MethodPost}
+	}
+	allowMethods := strings.Join(methods, ", ")
+	allowHeaders := strings.Join(append(slices.Clone(corsRequiredHeaders), cfg.AllowedHeaders...), ", ")
+	exposeHeaders := strings.Join(corsExposedHeaders, ", ")
+	anyOrigin := slices.Contains(cfg.AllowedOrigins, "*")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		origin := r.Header.Get("Origin")
+		preflight := r.Method == http.MethodOptions && r.Header.Get("Access-Control-Request-Method") != ""
+
+		w.Header().Add("Vary", "Origin")
+		allowed := origin != "" && (anyOrigin || slices.Contains(cfg.AllowedOrigins, origin))
+		if allowed {
+			w.Header().Set("Access-Control-Allow-Origin", origin)
+		}
+
+		if preflight {
+			w.Header().Add("Vary", "Access-Control-Request-Method")
+			w.Header().Add("Vary", "Access-Control-Request-Headers")
+			if allowed {
+				w.Header().Set("Access-Control-Allow-Methods", allowMethods)
+				w.Header().Set("Access-Control-Allow-Headers", allowHeaders)
+				w.Header().Set("Access-Control-Max-Age", strconv.Itoa(int(corsMaxAge.Seconds())))
+			}
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		if allowed {
+			w.Header().Set("Access-Control-Expose-Headers", exposeHeaders)
+		}
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,49 @@
+package connect
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCORS(t *testing.T) {
+	next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	handler := CORS(CORSConfig{AllowedOrigins: []string{"https://app.example.com"}}, next)
+
+	// Preflight from an allowed origin is answered without reaching the handler
+	req := httptest.NewRequest(http.MethodOptions, "/webhook.WebhookService/PushEvent", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	req.Header.Set("Access-Control-Request-Method", "POST")
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusNoContent {
+		t.Errorf("Preflight status = %d, want %d", rec.Code, http.StatusNoContent)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "https://app.example.com" {
+		t.Errorf("Access-Control-Allow-Origin = %q", got)
+	}
+	if got := rec.Header().Get("Access-Control-Allow-Headers"); !strings.Contains(got, "Connect-Protocol-Version") {
+		t.Errorf("Access-Control-Allow-Headers = %q, want Connect-Protocol-Version allowed", got)
+	}
+
+	// Preflight from another origin gets no CORS headers
+	req.Header.Set("Origin", "https://evil.example.com")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("Access-Control-Allow-Origin = %q for a disallowed origin", got)
+	}
+
+	// Actual requests reach the handler with CORS headers for allowed origins
+	req = httptest.NewRequest(http.MethodPost, "/webhook.WebhookService/PushEvent", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	if rec.Code != http.StatusOK || rec.Header().Get("Access-Control-Allow-Origin") != "https://app.example.com" {
+		t.Errorf("Got status %d, headers %v", rec.Code, rec.Header())
+	}
+}
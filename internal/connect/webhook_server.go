@@ -2,7 +2,7 @@ package connect
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"log/slog"
@@ -17,26 +17,62 @@ import (
 	otelcodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/sarathsp06/sparrow/internal/auth"
 	"github.com/sarathsp06/sparrow/internal/jobs"
 	"github.com/sarathsp06/sparrow/internal/logger"
 	"github.com/sarathsp06/sparrow/internal/observability"
 	"github.com/sarathsp06/sparrow/internal/queue"
+	"github.com/sarathsp06/sparrow/internal/validation"
 	"github.com/sarathsp06/sparrow/internal/webhooks"
 	pb "github.com/sarathsp06/sparrow/proto"
 	"github.com/sarathsp06/sparrow/proto/protoconnect"
 )
 
+const (
+	// maxPushEventsBatchSize caps the number of events accepted by PushEvents
+	maxPushEventsBatchSize = 1000
+
+	// defaultWebhookTimeoutSeconds applies to webhooks registered without a timeout
+	defaultWebhookTimeoutSeconds = 30
+
+	// watchPollInterval is how often WatchWebhookStatus checks for delivery changes
+	watchPollInterval = time.Second
+
+	// defaultListEventsLimit and maxListEventsLimit bound the page size of ListEvents,
+	// ListEventFailures and ListDeliveries
+	defaultListEventsLimit = 50
+	maxListEventsLimit     = 500
+
+	// defaultListAllWebhooksLimit and maxListAllWebhooksLimit bound the page size of ListAllWebhooks
+	defaultListAllWebhooksLimit = 50
+	maxListAllWebhooksLimit     = 500
+
+	// defaultDeliveryStatsRange is the range GetDeliveryStats covers when since is unset
+	defaultDeliveryStatsRange = 24 * time.Hour
+
+	// maxDeliveryTTLExtension caps the new TTL given to an expired delivery by ExtendDeliveryTTL
+	maxDeliveryTTLExtension = 24 * time.Hour
+)
+
 // WebhookConnectServer implements the WebhookService Connect-RPC interface
 type WebhookConnectServer struct {
 	queueManager *queue.Manager
-	webhookRepo  *webhooks.Repository
+	webhookRepo  webhooks.WebhookStore
 	logger       *slog.Logger
 	tracer       trace.Tracer
 	metrics      *observability.SparrowMetrics
+
+	schemaValidator *webhooks.SchemaValidator
+
+	// maxDeliveryTimeout is the longest timeout a webhook can register
+	maxDeliveryTimeout time.Duration
+
+	// allowedPorts are the ports http(s) webhook URLs may use; empty = any port
+	allowedPorts []int
 }
 
 // NewWebhookConnectServer creates a new Connect-RPC server instance
-func NewWebhookConnectServer(queueManager *queue.Manager, webhookRepo *webhooks.Repository) *WebhookConnectServer {
+func NewWebhookConnectServer(queueManager *queue.Manager, webhookRepo webhooks.WebhookStore, maxDeliveryTimeout time.Duration) *WebhookConnectServer {
 	metrics, err := observability.NewSparrowMetrics()
 	if err != nil {
 		// Log error but continue without metrics
@@ -50,9 +86,18 @@ func NewWebhookConnectServer(queueManager *queue.Manager, webhookRepo *webhooks.
 		logger:       logger.NewLogger("connect-webhook-server"),
 		tracer:       observability.GetTracer("sparrow.connect.webhook"),
 		metrics:      metrics,
+
+		schemaValidator:    webhooks.NewSchemaValidator(webhookRepo),
+		maxDeliveryTimeout: maxDeliveryTimeout,
 	}
 }
 
+// LimitWebhookPorts restricts the ports registered http(s) webhook URLs may use; an empty
+// list allows any port
+func (s *WebhookConnectServer) LimitWebhookPorts(ports []int) {
+	s.allowedPorts = ports
+}
+
 // RegisterWebhook registers a URL for specific events in a namespace
 func (s *WebhookConnectServer) RegisterWebhook(
 	ctx context.Context,
@@ -71,56 +116,46 @@ func (s *WebhookConnectServer) RegisterWebhook(
 		"namespace", req.Msg.Namespace,
 		"events", req.Msg.Events,
 		"url", req.Msg.Url,
+		"auth_type", req.Msg.GetAuth().GetType().String(),
 	)
 
-	// Validate required fields
-	if req.Msg.Namespace == "" {
-		span.RecordError(fmt.Errorf("namespace is required"))
-		span.SetStatus(otelcodes.Error, "namespace is required")
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
-	}
-	if len(req.Msg.Events) == 0 {
-		span.RecordError(fmt.Errorf("at least one event is required"))
-		span.SetStatus(otelcodes.Error, "at least one event is required")
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one event is required"))
-	}
-	if req.Msg.Url == "" {
-		span.RecordError(fmt.Errorf("URL is required"))
-		span.SetStatus(otelcodes.Error, "URL is required")
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("URL is required"))
+	// Check every field, reporting all violations at once
+	registration, err := validation.ValidateRegistration(req.Msg, int32(s.maxDeliveryTimeout/time.Second), s.allowedPorts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "invalid webhook registration")
+		return nil, validation.ConnectError(err)
 	}
 
-	// Validate events are not empty
-	for _, event := range req.Msg.Events {
-		if event == "" {
-			span.RecordError(fmt.Errorf("event names cannot be empty"))
-			span.SetStatus(otelcodes.Error, "event names cannot be empty")
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("event names cannot be empty"))
-		}
+	if err := s.checkNamespace(ctx, req.Msg.Namespace); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "unknown namespace")
+		return nil, err
 	}
 
-	// Set default timeout
-	timeout := req.Msg.Timeout
-	if timeout <= 0 {
-		timeout = 30
+	// Webhooks registered without a timeout get the namespace's default
+	if registration.Timeout == 0 {
+		timeout, err := s.defaultTimeout(ctx, req.Msg.Namespace)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, "failed to load namespace defaults")
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to load namespace defaults: %w", err))
+		}
+		registration.Timeout = int(timeout)
 	}
 
-	span.SetAttributes(attribute.Int("timeout", int(timeout)))
-
-	// Create webhook registration
-	registration := &webhooks.WebhookRegistration{
-		Namespace:   req.Msg.Namespace,
-		Events:      req.Msg.Events,
-		URL:         req.Msg.Url,
-		Headers:     req.Msg.Headers,
-		Timeout:     int(timeout),
-		Active:      req.Msg.Active,
-		Description: req.Msg.Description,
-	}
+	span.SetAttributes(
+		attribute.Int("timeout", registration.Timeout),
+		attribute.Int("max_attempts", registration.MaxAttempts),
+	)
 
 	// Store the registration
 	if err := s.webhookRepo.RegisterWebhook(ctx, registration); err != nil {
 		span.RecordError(err)
+		if errors.Is(err, webhooks.ErrWebhookQuotaExceeded) {
+			span.SetStatus(otelcodes.Error, "webhook quota exceeded")
+			return nil, connect.NewError(connect.CodeResourceExhausted, err)
+		}
 		span.SetStatus(otelcodes.Error, "failed to register webhook")
 		s.logger.Error("Failed to register webhook",
 			"namespace", req.Msg.Namespace,
@@ -194,6 +229,247 @@ func (s *WebhookConnectServer) UnregisterWebhook(
 	return connect.NewResponse(result), nil
 }
 
+// PauseWebhook stops new deliveries to a webhook without deleting it
+func (s *WebhookConnectServer) PauseWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.PauseWebhookRequest],
+) (*connect.Response[pb.PauseWebhookResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.webhook.pause")
+	defer span.End()
+
+	s.logger.Info("Connect: Received webhook pause request",
+		"webhook_id", req.Msg.WebhookId,
+	)
+
+	if err := s.setWebhookActive(ctx, req.Msg.WebhookId, false); err != nil {
+		return nil, err
+	}
+
+	result := &pb.PauseWebhookResponse{
+		Success: true,
+		Message: "Webhook paused successfully",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// ResumeWebhook re-enables deliveries to a paused webhook
+func (s *WebhookConnectServer) ResumeWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.ResumeWebhookRequest],
+) (*connect.Response[pb.ResumeWebhookResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.webhook.resume")
+	defer span.End()
+
+	s.logger.Info("Connect: Received webhook resume request",
+		"webhook_id", req.Msg.WebhookId,
+	)
+
+	if err := s.setWebhookActive(ctx, req.Msg.WebhookId, true); err != nil {
+		return nil, err
+	}
+
+	result := &pb.ResumeWebhookResponse{
+		Success: true,
+		Message: "Webhook resumed successfully",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// RotateSecret replaces a signed webhook's signing secret, keeping the old one signing
+// deliveries during the grace period
+func (s *WebhookConnectServer) RotateSecret(
+	ctx context.Context,
+	req *connect.Request[pb.RotateSecretRequest],
+) (*connect.Response[pb.RotateSecretResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.webhook.rotate_secret")
+	defer span.End()
+
+	s.logger.Info("Connect: Received signing secret rotation request",
+		"webhook_id", req.Msg.WebhookId,
+		"grace_period_seconds", req.Msg.GracePeriodSeconds,
+	)
+
+	grace, err := validation.ValidateRotateSecret(req.Msg)
+	if err != nil {
+		return nil, validation.ConnectError(err)
+	}
+
+	webhook, err := s.webhookRepo.GetWebhookByID(ctx, req.Msg.WebhookId)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook not found"))
+	}
+	if err != nil {
+		span.RecordError(err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get webhook: %w", err))
+	}
+	if !webhook.Signed {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("webhook has no signing secret"))
+	}
+
+	previousExpiresAt := time.Now().Add(grace)
+	err = s.queueManager.RotateSigningSecret(ctx, req.Msg.WebhookId, req.Msg.NewSecret, previousExpiresAt)
+	switch {
+	case errors.Is(err, webhooks.ErrNotFound):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("webhook has no signing secret"))
+	case err != nil:
+		span.RecordError(err)
+		s.logger.Error("Failed to rotate signing secret", "webhook_id", req.Msg.WebhookId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to rotate signing secret: %w", err))
+	}
+
+	s.logger.Info("Signing secret rotated", "webhook_id", req.Msg.WebhookId, "previous_expires_at", previousExpiresAt)
+
+	result := &pb.RotateSecretResponse{
+		Success:                 true,
+		Message:                 "Signing secret rotated",
+		PreviousSecretExpiresAt: previousExpiresAt.Unix(),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// setWebhookActive toggles a webhook and keeps the active webhooks gauge in step
+func (s *WebhookConnectServer) setWebhookActive(ctx context.Context, webhookID string, active bool) error {
+	if webhookID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("webhook_id is required"))
+	}
+
+	changed, err := s.webhookRepo.SetWebhookActive(ctx, webhookID, active)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook not found"))
+		}
+		s.logger.Error("Failed to update webhook state",
+			"webhook_id", webhookID,
+			"active", active,
+			"error", err,
+		)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to update webhook: %w", err))
+	}
+
+	if changed && s.metrics != nil {
+		if active {
+			s.metrics.ActiveWebhooks.Add(ctx, 1)
+		} else {
+			s.metrics.ActiveWebhooks.Add(ctx, -1)
+		}
+	}
+
+	s.logger.Info("Webhook state updated",
+		"webhook_id", webhookID,
+		"active", active,
+		"changed", changed,
+	)
+	return nil
+}
+
+// DeleteWebhooks removes all webhooks matching a namespace and optional event
+func (s *WebhookConnectServer) DeleteWebhooks(
+	ctx context.Context,
+	req *connect.Request[pb.DeleteWebhooksRequest],
+) (*connect.Response[pb.DeleteWebhooksResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.webhook.delete_many")
+	defer span.End()
+
+	s.logger.Info("Connect: Received bulk webhook deletion request",
+		"namespace", req.Msg.Namespace,
+		"event", req.Msg.Event,
+	)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+
+	deleted, active, err := s.webhookRepo.DeleteWebhooksByFilter(ctx, req.Msg.Namespace, req.Msg.Event)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "failed to delete webhooks")
+		s.logger.Error("Failed to delete webhooks",
+			"namespace", req.Msg.Namespace,
+			"event", req.Msg.Event,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to delete webhooks: %w", err))
+	}
+
+	if s.metrics != nil {
+		s.metrics.ActiveWebhooks.Add(ctx, -active)
+	}
+
+	span.SetAttributes(attribute.Int64("deleted_count", deleted))
+	s.logger.Info("Webhooks deleted successfully",
+		"namespace", req.Msg.Namespace,
+		"event", req.Msg.Event,
+		"deleted_count", deleted,
+	)
+
+	result := &pb.DeleteWebhooksResponse{
+		DeletedCount: int32(deleted),
+		Success:      true,
+		Message:      fmt.Sprintf("Deleted %d webhooks", deleted),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// RegisterEventSchema registers the JSON Schema that payloads of an event must match
+func (s *WebhookConnectServer) RegisterEventSchema(
+	ctx context.Context,
+	req *connect.Request[pb.RegisterEventSchemaRequest],
+) (*connect.Response[pb.RegisterEventSchemaResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.event_schema.register")
+	defer span.End()
+
+	s.logger.Info("Connect: Received event schema registration request",
+		"namespace", req.Msg.Namespace,
+		"event", req.Msg.Event,
+	)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+	if req.Msg.Event == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("event is required"))
+	}
+	if req.Msg.Schema == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("schema is required"))
+	}
+	if _, err := webhooks.CompileSchema(req.Msg.Schema); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	schema := &webhooks.EventSchema{
+		Namespace: req.Msg.Namespace,
+		Event:     req.Msg.Event,
+		Schema:    req.Msg.Schema,
+	}
+	if err := s.webhookRepo.UpsertEventSchema(ctx, schema); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "failed to register event schema")
+		s.logger.Error("Failed to register event schema",
+			"namespace", req.Msg.Namespace,
+			"event", req.Msg.Event,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to register event schema: %w", err))
+	}
+	s.schemaValidator.Invalidate(req.Msg.Namespace, req.Msg.Event)
+
+	s.logger.Info("Event schema registered successfully",
+		"namespace", req.Msg.Namespace,
+		"event", req.Msg.Event,
+	)
+
+	result := &pb.RegisterEventSchemaResponse{
+		Success: true,
+		Message: "Event schema registered successfully",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
 // PushEvent pushes an event that triggers registered webhooks
 func (s *WebhookConnectServer) PushEvent(
 	ctx context.Context,
@@ -212,32 +488,29 @@ func (s *WebhookConnectServer) PushEvent(
 		"event", req.Msg.Event,
 	)
 
-	// Validate required fields
-	if req.Msg.Namespace == "" {
-		span.RecordError(fmt.Errorf("namespace is required"))
-		span.SetStatus(otelcodes.Error, "namespace is required")
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
-	}
-	if req.Msg.Event == "" {
-		span.RecordError(fmt.Errorf("event is required"))
-		span.SetStatus(otelcodes.Error, "event is required")
-		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("event is required"))
+	// Validate required fields and payload
+	ttl, err := validation.ValidatePushEvent(req.Msg, s.queueManager.EventTTL)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		return nil, validation.ConnectError(err)
 	}
 
-	// Validate JSON payload
-	if req.Msg.Payload != "" {
-		var payload interface{}
-		if err := json.Unmarshal([]byte(req.Msg.Payload), &payload); err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelcodes.Error, "invalid JSON payload")
-			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid JSON payload: %w", err))
-		}
+	if err := s.checkNamespace(ctx, req.Msg.Namespace); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "unknown namespace")
+		return nil, err
 	}
 
-	// Set default TTL if not provided
-	ttl := req.Msg.TtlSeconds
-	if ttl <= 0 {
-		ttl = 3600 // Default 1 hour
+	// Validate against the event's registered JSON Schema, if any
+	if err := s.schemaValidator.Validate(ctx, req.Msg.Namespace, req.Msg.Event, req.Msg.ContentType, validation.EventPayload(req.Msg)); err != nil {
+		span.RecordError(err)
+		if errors.Is(err, webhooks.ErrSchemaValidation) {
+			span.SetStatus(otelcodes.Error, "payload does not match event schema")
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		span.SetStatus(otelcodes.Error, "failed to validate event schema")
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to validate event schema: %w", err))
 	}
 
 	// Generate event ID
@@ -245,13 +518,27 @@ func (s *WebhookConnectServer) PushEvent(
 
 	// Create event processing job
 	eventArgs := jobs.EventArgs{
-		EventID:    eventID,
-		Namespace:  req.Msg.Namespace,
-		Event:      req.Msg.Event,
-		Payload:    req.Msg.Payload,
-		TTLSeconds: ttl,
-		Metadata:   req.Msg.Metadata,
-		CreatedAt:  time.Now(),
+		EventID:     eventID,
+		Namespace:   req.Msg.Namespace,
+		Event:       req.Msg.Event,
+		Payload:     jobs.Payload(validation.EventPayload(req.Msg)),
+		ContentType: req.Msg.ContentType,
+		TTLSeconds:  ttl,
+		Metadata:    req.Msg.Metadata,
+		CreatedAt:   time.Now(),
+
+		TimeoutOverride: int(req.Msg.DeliveryTimeoutOverride),
+		Priority:        int(req.Msg.Priority),
+		DeliverAt:       eventDeliverAt(req.Msg),
+		TraceContext:    observability.InjectTraceContext(ctx),
+	}
+
+	if req.Msg.Synchronous {
+		result, err := s.pushEventSynchronously(ctx, span, req.Msg, eventArgs)
+		if err != nil {
+			return nil, err
+		}
+		return connect.NewResponse(result), nil
 	}
 
 	// Find registered webhooks first to know how many will be triggered
@@ -266,6 +553,7 @@ func (s *WebhookConnectServer) PushEvent(
 		)
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get registered webhooks: %w", err))
 	}
+	registeredWebhooks = webhooks.FilterByMetadata(registeredWebhooks, req.Msg.Metadata)
 
 	span.SetAttributes(
 		attribute.String("event_id", eventID),
@@ -278,9 +566,7 @@ func (s *WebhookConnectServer) PushEvent(
 	}
 
 	// Insert the event processing job
-	_, err = s.queueManager.GetClient().Insert(ctx, eventArgs, &river.InsertOpts{
-		Queue: "events",
-	})
+	_, err = s.queueManager.GetClient().Insert(ctx, eventArgs, eventInsertOpts(req.Msg))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, "failed to schedule event processing")
@@ -318,6 +604,214 @@ func (s *WebhookConnectServer) PushEvent(
 	return connect.NewResponse(result), nil
 }
 
+// pushEventSynchronously pushes an event, making its first delivery attempts inline, and
+// returns their results
+func (s *WebhookConnectServer) pushEventSynchronously(ctx context.Context, span trace.Span, req *pb.PushEventRequest, eventArgs jobs.EventArgs) (*pb.PushEventResponse, error) {
+	result, err := s.queueManager.PushEventSynchronously(ctx, eventArgs, eventInsertOpts(req))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "failed to push event synchronously")
+		s.logger.Error("Failed to push event synchronously",
+			"event_id", eventArgs.EventID,
+			"namespace", req.Namespace,
+			"event", req.Event,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to push event: %w", err))
+	}
+
+	webhookIDs := make([]string, len(result.Webhooks))
+	for i, wh := range result.Webhooks {
+		webhookIDs[i] = wh.ID
+	}
+
+	delivered := 0
+	deliveries := make([]*pb.SynchronousDelivery, len(result.Deliveries))
+	for i, d := range result.Deliveries {
+		if d.Status == webhooks.StatusSuccess {
+			delivered++
+		}
+		deliveries[i] = &pb.SynchronousDelivery{
+			WebhookId:    d.WebhookID,
+			DeliveryId:   d.DeliveryID,
+			Status:       convertDeliveryStatus(d.Status),
+			ResponseCode: int32(d.StatusCode),
+			ErrorMessage: d.Error,
+		}
+	}
+
+	if s.metrics != nil {
+		s.metrics.EventsPushed.Add(ctx, 1)
+	}
+
+	span.SetAttributes(
+		attribute.String("event_id", eventArgs.EventID),
+		attribute.Int("webhooks_count", len(result.Webhooks)),
+		attribute.Int("synchronous_deliveries", len(deliveries)),
+	)
+	span.SetStatus(otelcodes.Ok, "event pushed synchronously")
+
+	s.logger.Info("Event pushed synchronously",
+		"event_id", eventArgs.EventID,
+		"namespace", req.Namespace,
+		"event", req.Event,
+		"webhooks_to_trigger", len(result.Webhooks),
+		"delivered_inline", delivered,
+		"attempted_inline", len(deliveries),
+	)
+
+	return &pb.PushEventResponse{
+		EventId:               eventArgs.EventID,
+		WebhooksTriggered:     int32(len(result.Webhooks)),
+		WebhookIds:            webhookIDs,
+		Success:               true,
+		Message:               fmt.Sprintf("Event delivered inline to %d of %d webhooks attempted, %d webhooks will be triggered", delivered, len(deliveries), len(result.Webhooks)),
+		SynchronousDeliveries: deliveries,
+	}, nil
+}
+
+// PushEvents pushes a batch of events, scheduling all valid ones in a single insert
+func (s *WebhookConnectServer) PushEvents(
+	ctx context.Context,
+	req *connect.Request[pb.PushEventsRequest],
+) (*connect.Response[pb.PushEventsResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.event.push_batch",
+		trace.WithAttributes(attribute.Int("batch_size", len(req.Msg.Events))),
+	)
+	defer span.End()
+
+	s.logger.Info("Connect: Received push events request", "batch_size", len(req.Msg.Events))
+
+	if len(req.Msg.Events) == 0 {
+		span.SetStatus(otelcodes.Error, "at least one event is required")
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one event is required"))
+	}
+	if len(req.Msg.Events) > maxPushEventsBatchSize {
+		span.SetStatus(otelcodes.Error, "too many events in batch")
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("batch contains %d events, maximum is %d", len(req.Msg.Events), maxPushEventsBatchSize))
+	}
+
+	results := make([]*pb.PushEventResult, len(req.Msg.Events))
+	params := make([]river.InsertManyParams, 0, len(req.Msg.Events))
+	scheduled := make([]int, 0, len(req.Msg.Events))
+
+	// Webhook lookups are cached per namespace/event since batches tend to repeat them
+	webhooksByEvent := make(map[string][]*webhooks.WebhookRegistration)
+
+	for i, eventReq := range req.Msg.Events {
+		results[i] = &pb.PushEventResult{Index: int32(i)}
+
+		ttl, err := validation.ValidatePushEvent(eventReq, s.queueManager.EventTTL)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if eventReq.Synchronous {
+			results[i].Error = "synchronous is not supported in PushEvents"
+			continue
+		}
+		if err := s.webhookRepo.EnsureNamespace(ctx, eventReq.Namespace); err != nil {
+			results[i].Error = fmt.Sprintf("namespace %q: %v", eventReq.Namespace, err)
+			continue
+		}
+		if err := s.schemaValidator.Validate(ctx, eventReq.Namespace, eventReq.Event, eventReq.ContentType, validation.EventPayload(eventReq)); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		key := eventReq.Namespace + "/" + eventReq.Event
+		registeredWebhooks, ok := webhooksByEvent[key]
+		if !ok {
+			registeredWebhooks, err = s.webhookRepo.GetWebhooksByEvent(ctx, eventReq.Namespace, eventReq.Event)
+			if err != nil {
+				s.logger.Error("Failed to get registered webhooks",
+					"namespace", eventReq.Namespace,
+					"event", eventReq.Event,
+					"error", err,
+				)
+				results[i].Error = fmt.Sprintf("failed to get registered webhooks: %v", err)
+				continue
+			}
+			webhooksByEvent[key] = registeredWebhooks
+		}
+
+		// Cached lookups are shared by the namespace/event; metadata filters are per event
+		matching := webhooks.FilterByMetadata(registeredWebhooks, eventReq.Metadata)
+		webhookIDs := make([]string, len(matching))
+		for j, wh := range matching {
+			webhookIDs[j] = wh.ID
+		}
+
+		eventID := uuid.New().String()
+		params = append(params, river.InsertManyParams{
+			Args: jobs.EventArgs{
+				EventID:     eventID,
+				Namespace:   eventReq.Namespace,
+				Event:       eventReq.Event,
+				Payload:     jobs.Payload(validation.EventPayload(eventReq)),
+				ContentType: eventReq.ContentType,
+				TTLSeconds:  ttl,
+				Metadata:    eventReq.Metadata,
+				CreatedAt:   time.Now(),
+
+				TimeoutOverride: int(eventReq.DeliveryTimeoutOverride),
+				Priority:        int(eventReq.Priority),
+				DeliverAt:       eventDeliverAt(eventReq),
+				TraceContext:    observability.InjectTraceContext(ctx),
+			},
+			InsertOpts: eventInsertOpts(eventReq),
+		})
+		scheduled = append(scheduled, i)
+
+		results[i].EventId = eventID
+		results[i].WebhooksTriggered = int32(len(webhookIDs))
+		results[i].WebhookIds = webhookIDs
+	}
+
+	if len(params) > 0 {
+		if _, err := s.queueManager.InsertManyJobs(ctx, params); err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, "failed to schedule event processing")
+			s.logger.Error("Failed to schedule event processing jobs",
+				"batch_size", len(params),
+				"error", err,
+			)
+			return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to schedule event processing: %w", err))
+		}
+	}
+
+	accepted := len(scheduled)
+	for _, i := range scheduled {
+		results[i].Success = true
+	}
+
+	// Record metrics
+	if s.metrics != nil && accepted > 0 {
+		s.metrics.EventsPushed.Add(ctx, int64(accepted))
+	}
+
+	span.SetAttributes(
+		attribute.Int("accepted_count", accepted),
+		attribute.Int("rejected_count", len(req.Msg.Events)-accepted),
+	)
+	span.SetStatus(otelcodes.Ok, "events scheduled")
+
+	s.logger.Info("Event batch scheduled",
+		"accepted_count", accepted,
+		"rejected_count", len(req.Msg.Events)-accepted,
+	)
+
+	result := &pb.PushEventsResponse{
+		Results:       results,
+		AcceptedCount: int32(accepted),
+		RejectedCount: int32(len(req.Msg.Events) - accepted),
+		Success:       accepted == len(req.Msg.Events),
+		Message:       fmt.Sprintf("%d of %d events scheduled for processing", accepted, len(req.Msg.Events)),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
 // GetWebhookStatus gets the status of webhook deliveries
 func (s *WebhookConnectServer) GetWebhookStatus(
 	ctx context.Context,
@@ -351,28 +845,17 @@ func (s *WebhookConnectServer) GetWebhookStatus(
 		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get webhook status: %w", err))
 	}
 
+	if req.Msg.FailureReason != pb.DeliveryFailureReason_FAILURE_NONE {
+		deliveries = webhooks.FilterByFailureReason(deliveries, failureReasonFromProto(req.Msg.FailureReason))
+	}
+
 	// Convert to protobuf format
 	pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
 	for i, d := range deliveries {
-		pbDeliveries[i] = &pb.WebhookDelivery{
-			DeliveryId:   d.ID,
-			WebhookId:    d.WebhookID,
-			EventId:      d.EventID,
-			Status:       convertDeliveryStatus(d.Status),
-			AttemptCount: int32(d.AttemptCount),
-			MaxAttempts:  int32(d.MaxAttempts),
-			CreatedAt:    d.CreatedAt.Unix(),
-			ExpiresAt:    d.ExpiresAt.Unix(),
-			ResponseCode: int32(d.ResponseCode),
-			ResponseBody: d.ResponseBody,
-			ErrorMessage: d.ErrorMessage,
-		}
-
-		if d.LastAttemptedAt != nil {
-			pbDeliveries[i].LastAttemptedAt = d.LastAttemptedAt.Unix()
-		}
-		if d.NextRetryAt != nil {
-			pbDeliveries[i].NextRetryAt = d.NextRetryAt.Unix()
+		pbDeliveries[i] = convertDelivery(d)
+		if req.Msg.IncludeHeaders {
+			pbDeliveries[i].RequestHeaders = d.RequestHeaders
+			pbDeliveries[i].ResponseHeaders = d.ResponseHeaders
 		}
 	}
 
@@ -386,6 +869,78 @@ func (s *WebhookConnectServer) GetWebhookStatus(
 	return connect.NewResponse(result), nil
 }
 
+// GetWebhookStatusBatch gets the status of the deliveries of several webhooks and events
+func (s *WebhookConnectServer) GetWebhookStatusBatch(
+	ctx context.Context,
+	req *connect.Request[pb.GetWebhookStatusBatchRequest],
+) (*connect.Response[pb.GetWebhookStatusBatchResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.webhook.status_batch")
+	defer span.End()
+
+	s.logger.Info("Connect: Received batch webhook status request",
+		"webhook_ids", len(req.Msg.WebhookIds),
+		"event_ids", len(req.Msg.EventIds),
+	)
+
+	if err := webhooks.ValidateStatusBatch(req.Msg.WebhookIds, req.Msg.EventIds); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	deliveries, err := s.webhookRepo.GetDeliveriesBatch(ctx, req.Msg.WebhookIds, req.Msg.EventIds)
+	if err != nil {
+		s.logger.Error("Failed to get webhook deliveries", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get webhook status: %w", err))
+	}
+
+	if req.Msg.FailureReason != pb.DeliveryFailureReason_FAILURE_NONE {
+		deliveries = webhooks.FilterByFailureReason(deliveries, failureReasonFromProto(req.Msg.FailureReason))
+	}
+
+	byWebhook, byEvent := webhooks.GroupDeliveries(deliveries, req.Msg.WebhookIds, req.Msg.EventIds)
+
+	result := &pb.GetWebhookStatusBatchResponse{
+		Webhooks:        convertDeliveryGroups(req.Msg.WebhookIds, byWebhook, req.Msg.IncludeHeaders),
+		Events:          convertDeliveryGroups(req.Msg.EventIds, byEvent, req.Msg.IncludeHeaders),
+		TotalDeliveries: int32(len(deliveries)),
+		Success:         true,
+		Message:         fmt.Sprintf("Found %d webhook deliveries", len(deliveries)),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// WatchWebhookStatus streams delivery status changes until the client disconnects
+func (s *WebhookConnectServer) WatchWebhookStatus(
+	ctx context.Context,
+	req *connect.Request[pb.WatchWebhookStatusRequest],
+	stream *connect.ServerStream[pb.WebhookDelivery],
+) error {
+	webhookID, eventID := req.Msg.GetWebhookId(), req.Msg.GetEventId()
+	if webhookID == "" && eventID == "" {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("either webhook_id or event_id is required"))
+	}
+
+	s.logger.Info("Connect: Received watch webhook status request",
+		"webhook_id", webhookID,
+		"event_id", eventID,
+	)
+
+	err := s.webhookRepo.WatchDeliveries(ctx, webhookID, eventID, watchPollInterval, func(d *webhooks.WebhookDelivery) error {
+		return stream.Send(convertDelivery(d))
+	})
+	if err != nil && ctx.Err() == nil {
+		s.logger.Error("Failed to watch webhook status", "error", err)
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to watch webhook status: %w", err))
+	}
+
+	s.logger.Info("Webhook status watch ended",
+		"webhook_id", webhookID,
+		"event_id", eventID,
+	)
+
+	return nil
+}
+
 // ListWebhooks lists all registered webhooks for a namespace
 func (s *WebhookConnectServer) ListWebhooks(
 	ctx context.Context,
@@ -433,18 +988,7 @@ func (s *WebhookConnectServer) ListWebhooks(
 	// Convert to protobuf format
 	pbWebhooks := make([]*pb.RegisteredWebhook, len(filteredRegistrations))
 	for i, reg := range filteredRegistrations {
-		pbWebhooks[i] = &pb.RegisteredWebhook{
-			WebhookId:   reg.ID,
-			Namespace:   reg.Namespace,
-			Events:      reg.Events,
-			Url:         reg.URL,
-			Headers:     reg.Headers,
-			Timeout:     int32(reg.Timeout),
-			Active:      reg.Active,
-			Description: reg.Description,
-			CreatedAt:   reg.CreatedAt.Unix(),
-			UpdatedAt:   reg.UpdatedAt.Unix(),
-		}
+		pbWebhooks[i] = convertRegisteredWebhook(reg)
 	}
 
 	s.logger.Info("Listed webhooks successfully",
@@ -462,33 +1006,1217 @@ func (s *WebhookConnectServer) ListWebhooks(
 	return connect.NewResponse(result), nil
 }
 
-// convertDeliveryStatus converts internal status to protobuf status
-func convertDeliveryStatus(status webhooks.WebhookDeliveryStatus) pb.WebhookDeliveryStatus {
-	switch status {
-	case webhooks.StatusPending:
-		return pb.WebhookDeliveryStatus_DELIVERY_PENDING
-	case webhooks.StatusSending:
-		return pb.WebhookDeliveryStatus_DELIVERY_SENDING
-	case webhooks.StatusSuccess:
-		return pb.WebhookDeliveryStatus_DELIVERY_SUCCESS
-	case webhooks.StatusFailed:
-		return pb.WebhookDeliveryStatus_DELIVERY_FAILED
-	case webhooks.StatusRetrying:
-		return pb.WebhookDeliveryStatus_DELIVERY_RETRYING
-	case webhooks.StatusExpired:
-		return pb.WebhookDeliveryStatus_DELIVERY_EXPIRED
-	default:
-		return pb.WebhookDeliveryStatus_DELIVERY_UNKNOWN
+// ListEvents returns stored events for a namespace, newest first
+func (s *WebhookConnectServer) ListEvents(
+	ctx context.Context,
+	req *connect.Request[pb.ListEventsRequest],
+) (*connect.Response[pb.ListEventsResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.event.list")
+	defer span.End()
+
+	s.logger.Info("Connect: Received list events request",
+		"namespace", req.Msg.Namespace,
+		"event", req.Msg.Event,
+	)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+
+	filter, err := eventListFilter(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	events, err := s.webhookRepo.ListEvents(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "failed to list events")
+		s.logger.Error("Failed to list events",
+			"namespace", req.Msg.Namespace,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list events: %w", err))
+	}
+
+	pbEvents := make([]*pb.StoredEvent, len(events))
+	for i, e := range events {
+		pbEvents[i] = convertStoredEvent(e)
+	}
+
+	var nextPageToken string
+	if len(events) == filter.Limit {
+		nextPageToken = webhooks.EncodeEventCursor(events[len(events)-1].EventRecord)
+	}
+
+	result := &pb.ListEventsResponse{
+		Events:        pbEvents,
+		NextPageToken: nextPageToken,
+		Success:       true,
+		Message:       fmt.Sprintf("Found %d events", len(pbEvents)),
 	}
+
+	return connect.NewResponse(result), nil
 }
 
-// Handler returns the Connect-RPC handler
-func (s *WebhookConnectServer) Handler() (string, http.Handler) {
-	// Create simple handler
-	otelInterceptor, err := otelconnect.NewInterceptor()
+// ListEventFailures returns a namespace's event processing failures, newest first
+func (s *WebhookConnectServer) ListEventFailures(
+	ctx context.Context,
+	req *connect.Request[pb.ListEventFailuresRequest],
+) (*connect.Response[pb.ListEventFailuresResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.event.list_failures")
+	defer span.End()
+
+	s.logger.Info("Connect: Received list event failures request", "namespace", req.Msg.Namespace)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+
+	filter, err := eventFailureListFilter(req.Msg)
 	if err != nil {
-		log.Fatal(err)
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	failures, err := s.webhookRepo.ListEventFailures(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		s.logger.Error("Failed to list event failures",
+			"namespace", req.Msg.Namespace,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list event failures: %w", err))
+	}
+
+	pbFailures := make([]*pb.EventProcessingFailure, len(failures))
+	for i, f := range failures {
+		pbFailures[i] = convertEventFailure(f)
+	}
+
+	var nextPageToken string
+	if len(failures) == filter.Limit {
+		nextPageToken = webhooks.EncodeEventFailureCursor(failures[len(failures)-1])
+	}
+
+	result := &pb.ListEventFailuresResponse{
+		Failures:      pbFailures,
+		NextPageToken: nextPageToken,
+		Success:       true,
+		Message:       fmt.Sprintf("Found %d event failures", len(pbFailures)),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// ListDeliveries returns deliveries to a namespace's webhooks, newest first
+func (s *WebhookConnectServer) ListDeliveries(
+	ctx context.Context,
+	req *connect.Request[pb.ListDeliveriesRequest],
+) (*connect.Response[pb.ListDeliveriesResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.delivery.list")
+	defer span.End()
+
+	s.logger.Info("Connect: Received list deliveries request",
+		"namespace", req.Msg.Namespace,
+		"status", req.Msg.Status,
+	)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+
+	filter, err := deliveryListFilter(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	deliveries, err := s.webhookRepo.ListDeliveries(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		s.logger.Error("Failed to list deliveries",
+			"namespace", req.Msg.Namespace,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list deliveries: %w", err))
+	}
+
+	pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		pbDeliveries[i] = convertDelivery(d)
+	}
+
+	var nextPageToken string
+	if len(deliveries) == filter.Limit {
+		nextPageToken = webhooks.EncodeDeliveryCursor(deliveries[len(deliveries)-1])
+	}
+
+	result := &pb.ListDeliveriesResponse{
+		Deliveries:    pbDeliveries,
+		NextPageToken: nextPageToken,
+		Success:       true,
+		Message:       fmt.Sprintf("Found %d deliveries", len(pbDeliveries)),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// ReplayEvents delivers stored events again to the webhooks currently registered for them
+func (s *WebhookConnectServer) ReplayEvents(
+	ctx context.Context,
+	req *connect.Request[pb.ReplayEventsRequest],
+) (*connect.Response[pb.ReplayEventsResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.event.replay")
+	defer span.End()
+
+	s.logger.Info("Connect: Received replay events request",
+		"namespace", req.Msg.Namespace,
+		"event", req.Msg.Event,
+		"since", req.Msg.Since,
+		"until", req.Msg.Until,
+	)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+	if req.Msg.Event == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("event is required"))
+	}
+
+	replayed, err := s.queueManager.ReplayEvents(ctx, replayFilter(req.Msg))
+	if err != nil {
+		span.RecordError(err)
+		s.logger.Error("Failed to replay events",
+			"namespace", req.Msg.Namespace,
+			"event", req.Msg.Event,
+			"deliveries_scheduled", replayed.Scheduled,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to replay events: %w", err))
+	}
+
+	s.logger.Info("Events replayed",
+		"namespace", req.Msg.Namespace,
+		"event", req.Msg.Event,
+		"events_replayed", replayed.Events,
+		"events_expired", replayed.Expired,
+		"deliveries_scheduled", replayed.Scheduled,
+	)
+
+	result := &pb.ReplayEventsResponse{
+		EventsReplayed:      int32(replayed.Events),
+		EventsExpired:       int32(replayed.Expired),
+		DeliveriesScheduled: int32(replayed.Scheduled),
+		Success:             true,
+		Message:             fmt.Sprintf("Scheduled %d deliveries for %d events", replayed.Scheduled, replayed.Events),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// GetDeliveryStats aggregates a namespace's deliveries over a time range
+func (s *WebhookConnectServer) GetDeliveryStats(
+	ctx context.Context,
+	req *connect.Request[pb.GetDeliveryStatsRequest],
+) (*connect.Response[pb.GetDeliveryStatsResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.deliveries.stats")
+	defer span.End()
+
+	s.logger.Info("Connect: Received delivery stats request",
+		"namespace", req.Msg.Namespace,
+		"since", req.Msg.Since,
+		"until", req.Msg.Until,
+	)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+
+	since, until, err := deliveryStatsRange(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	stats, err := s.webhookRepo.GetDeliveryStats(ctx, req.Msg.Namespace, since, until)
+	if err != nil {
+		s.logger.Error("Failed to get delivery stats",
+			"namespace", req.Msg.Namespace,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get delivery stats: %w", err))
+	}
+
+	pbEvents := make([]*pb.EventDeliveryStats, len(stats.Events))
+	for i, e := range stats.Events {
+		pbEvents[i] = &pb.EventDeliveryStats{
+			Event: e.Event,
+			Stats: convertDeliveryStats(&e.DeliveryStats),
+		}
+	}
+
+	result := &pb.GetDeliveryStatsResponse{
+		Totals:  convertDeliveryStats(&stats.Totals),
+		Events:  pbEvents,
+		Since:   since.Unix(),
+		Until:   until.Unix(),
+		Success: true,
+		Message: fmt.Sprintf("Found %d deliveries", stats.Totals.Total),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// ListEventTypes lists the event names a namespace uses
+func (s *WebhookConnectServer) ListEventTypes(
+	ctx context.Context,
+	req *connect.Request[pb.ListEventTypesRequest],
+) (*connect.Response[pb.ListEventTypesResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.events.types")
+	defer span.End()
+
+	s.logger.Info("Connect: Received list event types request",
+		"namespace", req.Msg.Namespace,
+		"since", req.Msg.Since,
+		"until", req.Msg.Until,
+	)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+
+	types, err := s.webhookRepo.ListEventTypes(ctx, eventTypeListFilter(req.Msg))
+	if err != nil {
+		s.logger.Error("Failed to list event types",
+			"namespace", req.Msg.Namespace,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list event types: %w", err))
+	}
+
+	result := &pb.ListEventTypesResponse{
+		EventTypes: convertEventTypes(types),
+		Success:    true,
+		Message:    fmt.Sprintf("Found %d event types", len(types)),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// GetWebhook returns a single webhook registration
+func (s *WebhookConnectServer) GetWebhook(
+	ctx context.Context,
+	req *connect.Request[pb.GetWebhookRequest],
+) (*connect.Response[pb.GetWebhookResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.webhook.get")
+	defer span.End()
+
+	s.logger.Info("Connect: Received get webhook request",
+		"webhook_id", req.Msg.WebhookId,
+	)
+
+	if req.Msg.WebhookId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("webhook_id is required"))
+	}
+
+	registration, err := s.webhookRepo.GetWebhookByID(ctx, req.Msg.WebhookId)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("webhook not found"))
+		}
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "failed to get webhook")
+		s.logger.Error("Failed to get webhook",
+			"webhook_id", req.Msg.WebhookId,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get webhook: %w", err))
+	}
+
+	result := &pb.GetWebhookResponse{
+		Webhook: convertRegisteredWebhook(registration),
+		Success: true,
+		Message: "Webhook found",
+	}
+
+	// Health is informational; the webhook is still returned without it
+	health, err := s.queueManager.WebhookHealth(ctx, req.Msg.WebhookId)
+	if err != nil {
+		s.logger.Warn("Failed to get webhook health",
+			"webhook_id", req.Msg.WebhookId,
+			"error", err,
+		)
+	} else {
+		result.Health = convertWebhookHealth(health)
 	}
-	path, handler := protoconnect.NewWebhookServiceHandler(s, connect.WithInterceptors(otelInterceptor))
-	return path, handler
+
+	return connect.NewResponse(result), nil
+}
+
+// GetDelivery returns a single delivery
+func (s *WebhookConnectServer) GetDelivery(
+	ctx context.Context,
+	req *connect.Request[pb.GetDeliveryRequest],
+) (*connect.Response[pb.GetDeliveryResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.delivery.get")
+	defer span.End()
+
+	s.logger.Info("Connect: Received get delivery request",
+		"delivery_id", req.Msg.DeliveryId,
+	)
+
+	if req.Msg.DeliveryId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("delivery_id is required"))
+	}
+
+	delivery, err := s.webhookRepo.GetDeliveryByID(ctx, req.Msg.DeliveryId)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("delivery not found"))
+		}
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "failed to get delivery")
+		s.logger.Error("Failed to get delivery",
+			"delivery_id", req.Msg.DeliveryId,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get delivery: %w", err))
+	}
+
+	pbDelivery := convertDelivery(delivery)
+	if req.Msg.IncludeHeaders {
+		pbDelivery.RequestHeaders = delivery.RequestHeaders
+		pbDelivery.ResponseHeaders = delivery.ResponseHeaders
+	}
+
+	return connect.NewResponse(&pb.GetDeliveryResponse{
+		Delivery: pbDelivery,
+		Success:  true,
+		Message:  "Delivery found",
+	}), nil
+}
+
+// GetEvent returns a single event with a summary of its deliveries
+func (s *WebhookConnectServer) GetEvent(
+	ctx context.Context,
+	req *connect.Request[pb.GetEventRequest],
+) (*connect.Response[pb.GetEventResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.event.get")
+	defer span.End()
+
+	s.logger.Info("Connect: Received get event request",
+		"event_id", req.Msg.EventId,
+	)
+
+	if req.Msg.EventId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("event_id is required"))
+	}
+
+	event, err := s.webhookRepo.GetEvent(ctx, req.Msg.EventId)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("event not found"))
+		}
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "failed to get event")
+		s.logger.Error("Failed to get event",
+			"event_id", req.Msg.EventId,
+			"error", err,
+		)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get event: %w", err))
+	}
+
+	return connect.NewResponse(&pb.GetEventResponse{
+		Event:   convertStoredEvent(&webhooks.ListedEvent{EventRecord: event, WebhookCount: event.Deliveries.Total}),
+		Success: true,
+		Message: "Event found",
+	}), nil
+}
+
+// CreateAPIKey creates an API key scoped to a set of namespaces
+func (s *WebhookConnectServer) CreateAPIKey(
+	ctx context.Context,
+	req *connect.Request[pb.CreateAPIKeyRequest],
+) (*connect.Response[pb.CreateAPIKeyResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.apikey.create")
+	defer span.End()
+
+	s.logger.Info("Connect: Received API key creation request",
+		"namespaces", req.Msg.Namespaces,
+	)
+
+	if len(req.Msg.Namespaces) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("at least one namespace is required"))
+	}
+	for _, namespace := range req.Msg.Namespaces {
+		if namespace == "" {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace names cannot be empty"))
+		}
+	}
+
+	plainKey, keyHash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create API key: %w", err))
+	}
+
+	apiKey := &webhooks.APIKey{
+		KeyHash:     keyHash,
+		Namespaces:  req.Msg.Namespaces,
+		Description: req.Msg.Description,
+	}
+	if err := s.webhookRepo.CreateAPIKey(ctx, apiKey); err != nil {
+		s.logger.Error("Failed to create API key", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create API key: %w", err))
+	}
+
+	s.logger.Info("API key created successfully",
+		"key_id", apiKey.ID,
+		"namespaces", apiKey.Namespaces,
+	)
+
+	result := &pb.CreateAPIKeyResponse{
+		KeyId:      apiKey.ID,
+		ApiKey:     plainKey,
+		Namespaces: apiKey.Namespaces,
+		CreatedAt:  apiKey.CreatedAt.Unix(),
+		Success:    true,
+		Message:    "API key created successfully",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// RevokeAPIKey revokes an API key
+func (s *WebhookConnectServer) RevokeAPIKey(
+	ctx context.Context,
+	req *connect.Request[pb.RevokeAPIKeyRequest],
+) (*connect.Response[pb.RevokeAPIKeyResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.apikey.revoke")
+	defer span.End()
+
+	s.logger.Info("Connect: Received API key revocation request",
+		"key_id", req.Msg.KeyId,
+	)
+
+	if req.Msg.KeyId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("key_id is required"))
+	}
+
+	if err := s.webhookRepo.RevokeAPIKey(ctx, req.Msg.KeyId); err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("API key not found"))
+		}
+		s.logger.Error("Failed to revoke API key", "key_id", req.Msg.KeyId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to revoke API key: %w", err))
+	}
+
+	s.logger.Info("API key revoked successfully", "key_id", req.Msg.KeyId)
+
+	result := &pb.RevokeAPIKeyResponse{
+		Success: true,
+		Message: "API key revoked successfully",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// CreateNamespace registers a namespace
+func (s *WebhookConnectServer) CreateNamespace(
+	ctx context.Context,
+	req *connect.Request[pb.CreateNamespaceRequest],
+) (*connect.Response[pb.CreateNamespaceResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.namespace.create")
+	defer span.End()
+
+	s.logger.Info("Connect: Received namespace creation request", "namespace", req.Msg.Name)
+
+	if req.Msg.Name == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("name is required"))
+	}
+
+	namespace := &webhooks.Namespace{
+		Name:        req.Msg.Name,
+		Description: req.Msg.Description,
+	}
+	if err := s.webhookRepo.CreateNamespace(ctx, namespace); err != nil {
+		if errors.Is(err, webhooks.ErrNamespaceExists) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, fmt.Errorf("namespace %q already exists", req.Msg.Name))
+		}
+		s.logger.Error("Failed to create namespace", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to create namespace: %w", err))
+	}
+
+	s.logger.Info("Namespace created successfully", "namespace", namespace.Name)
+
+	result := &pb.CreateNamespaceResponse{
+		Name:      namespace.Name,
+		CreatedAt: namespace.CreatedAt.Unix(),
+		Success:   true,
+		Message:   "Namespace created successfully",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// SetNamespaceDefaults sets the headers and timeout a namespace's webhooks inherit
+func (s *WebhookConnectServer) SetNamespaceDefaults(
+	ctx context.Context,
+	req *connect.Request[pb.SetNamespaceDefaultsRequest],
+) (*connect.Response[pb.SetNamespaceDefaultsResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.namespace.set_defaults")
+	defer span.End()
+
+	s.logger.Info("Connect: Received namespace defaults update request",
+		"namespace", req.Msg.Namespace,
+		"timeout", req.Msg.Timeout,
+	)
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+	maxTimeout := int32(s.maxDeliveryTimeout / time.Second)
+	if req.Msg.Timeout < 0 || req.Msg.Timeout > maxTimeout {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("timeout must be between 0 and %d seconds", maxTimeout))
+	}
+	if err := webhooks.ValidateHeaderTemplates(req.Msg.Headers); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.checkNamespace(ctx, req.Msg.Namespace); err != nil {
+		span.RecordError(err)
+		return nil, err
+	}
+
+	defaults := &webhooks.NamespaceDefaults{
+		Headers: req.Msg.Headers,
+		Timeout: int(req.Msg.Timeout),
+	}
+	if err := s.webhookRepo.SetNamespaceDefaults(ctx, req.Msg.Namespace, defaults); err != nil {
+		span.RecordError(err)
+		if errors.Is(err, webhooks.ErrNamespaceNotFound) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("namespace %q does not exist", req.Msg.Namespace))
+		}
+		s.logger.Error("Failed to set namespace defaults", "namespace", req.Msg.Namespace, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to set namespace defaults: %w", err))
+	}
+
+	s.logger.Info("Namespace defaults updated successfully", "namespace", req.Msg.Namespace)
+
+	result := &pb.SetNamespaceDefaultsResponse{
+		Success: true,
+		Message: "Namespace defaults updated successfully",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// GetNamespaceDefaults returns the headers and timeout a namespace's webhooks inherit
+func (s *WebhookConnectServer) GetNamespaceDefaults(
+	ctx context.Context,
+	req *connect.Request[pb.GetNamespaceDefaultsRequest],
+) (*connect.Response[pb.GetNamespaceDefaultsResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.namespace.get_defaults")
+	defer span.End()
+
+	if req.Msg.Namespace == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("namespace is required"))
+	}
+
+	defaults, err := s.webhookRepo.GetNamespaceDefaults(ctx, req.Msg.Namespace)
+	if err != nil {
+		span.RecordError(err)
+		s.logger.Error("Failed to get namespace defaults", "namespace", req.Msg.Namespace, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to get namespace defaults: %w", err))
+	}
+
+	result := &pb.GetNamespaceDefaultsResponse{
+		Namespace: req.Msg.Namespace,
+		Headers:   defaults.Headers,
+		Timeout:   int32(defaults.Timeout),
+		Success:   true,
+		Message:   "Namespace defaults retrieved successfully",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// ExtendDeliveryTTL gives an expired delivery a new expiry and schedules it again
+func (s *WebhookConnectServer) ExtendDeliveryTTL(
+	ctx context.Context,
+	req *connect.Request[pb.ExtendDeliveryTTLRequest],
+) (*connect.Response[pb.ExtendDeliveryTTLResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.delivery.extend_ttl")
+	defer span.End()
+
+	s.logger.Info("Connect: Received delivery TTL extension request", "delivery_id", req.Msg.DeliveryId, "ttl_seconds", req.Msg.TtlSeconds)
+
+	if req.Msg.DeliveryId == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("delivery_id is required"))
+	}
+	ttl := time.Duration(req.Msg.TtlSeconds) * time.Second
+	if ttl <= 0 || ttl > maxDeliveryTTLExtension {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("ttl_seconds must be between 1 and %d", int64(maxDeliveryTTLExtension.Seconds())))
+	}
+
+	delivery, err := s.queueManager.ExtendDeliveryTTL(ctx, req.Msg.DeliveryId, time.Now().Add(ttl))
+	switch {
+	case errors.Is(err, webhooks.ErrNotFound):
+		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("delivery %q not found", req.Msg.DeliveryId))
+	case errors.Is(err, webhooks.ErrDeliveryNotExpired), errors.Is(err, webhooks.ErrDeliveryJobNotFound):
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	case err != nil:
+		span.RecordError(err)
+		s.logger.Error("Failed to extend delivery TTL", "delivery_id", req.Msg.DeliveryId, "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to extend delivery TTL: %w", err))
+	}
+
+	s.logger.Info("Delivery rescheduled with extended TTL", "delivery_id", delivery.ID, "expires_at", delivery.ExpiresAt)
+
+	result := &pb.ExtendDeliveryTTLResponse{
+		Delivery: convertDelivery(delivery),
+		Success:  true,
+		Message:  "Delivery rescheduled",
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// ListAllWebhooks pages through webhooks across all namespaces
+func (s *WebhookConnectServer) ListAllWebhooks(
+	ctx context.Context,
+	req *connect.Request[pb.ListAllWebhooksRequest],
+) (*connect.Response[pb.ListAllWebhooksResponse], error) {
+	ctx, span := s.tracer.Start(ctx, "connect.webhook.list_all")
+	defer span.End()
+
+	s.logger.Info("Connect: Received list all webhooks request",
+		"active_only", req.Msg.ActiveOnly,
+		"url_contains", req.Msg.UrlContains,
+	)
+
+	filter, err := webhookListFilter(req.Msg)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	registrations, err := s.webhookRepo.ListAllWebhooks(ctx, filter)
+	if err != nil {
+		span.RecordError(err)
+		s.logger.Error("Failed to list all webhooks", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("failed to list webhooks: %w", err))
+	}
+
+	pbWebhooks := make([]*pb.RegisteredWebhook, len(registrations))
+	for i, reg := range registrations {
+		pbWebhooks[i] = convertRegisteredWebhook(reg)
+	}
+
+	var nextPageToken string
+	if len(registrations) == filter.Limit {
+		nextPageToken = webhooks.EncodeWebhookCursor(registrations[len(registrations)-1])
+	}
+
+	result := &pb.ListAllWebhooksResponse{
+		Webhooks:      pbWebhooks,
+		NextPageToken: nextPageToken,
+		Success:       true,
+		Message:       fmt.Sprintf("Found %d webhooks", len(pbWebhooks)),
+	}
+
+	return connect.NewResponse(result), nil
+}
+
+// defaultTimeout returns the timeout of a webhook registered without one: its namespace's
+// default timeout if set, otherwise the server's, within the server's ceiling
+func (s *WebhookConnectServer) defaultTimeout(ctx context.Context, namespace string) (int32, error) {
+	defaults, err := s.webhookRepo.GetNamespaceDefaults(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+	maxTimeout := int32(s.maxDeliveryTimeout / time.Second)
+	if defaults.Timeout > 0 {
+		return min(int32(defaults.Timeout), maxTimeout), nil
+	}
+	return min(defaultWebhookTimeoutSeconds, maxTimeout), nil
+}
+
+// checkNamespace rejects namespaces that haven't been created when strict namespaces are
+// enabled, and registers new ones otherwise
+func (s *WebhookConnectServer) checkNamespace(ctx context.Context, namespace string) error {
+	err := s.webhookRepo.EnsureNamespace(ctx, namespace)
+	if errors.Is(err, webhooks.ErrNamespaceNotFound) {
+		return connect.NewError(connect.CodeFailedPrecondition, fmt.Errorf("namespace %q does not exist", namespace))
+	}
+	if err != nil {
+		return connect.NewError(connect.CodeInternal, fmt.Errorf("failed to check namespace: %w", err))
+	}
+	return nil
+}
+
+// eventDeliverAt returns when an event should be delivered; zero means immediately
+func eventDeliverAt(req *pb.PushEventRequest) time.Time {
+	if req.DeliverAt <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(req.DeliverAt, 0)
+}
+
+// eventInsertOpts returns the insert options for an event's processing job, scheduling it
+// for the event's deliver_at when that's in the future
+func eventInsertOpts(req *pb.PushEventRequest) *river.InsertOpts {
+	opts := &river.InsertOpts{
+		Queue:    "events",
+		Priority: webhooks.ResolvePriority(int(req.Priority), 0),
+	}
+	if deliverAt := eventDeliverAt(req); deliverAt.After(time.Now()) {
+		opts.ScheduledAt = deliverAt
+	}
+	return opts
+}
+
+// convertRegisteredWebhook converts a webhook registration to its protobuf form
+func convertRegisteredWebhook(reg *webhooks.WebhookRegistration) *pb.RegisteredWebhook {
+	webhook := &pb.RegisteredWebhook{
+		WebhookId:   reg.ID,
+		Namespace:   reg.Namespace,
+		Events:      reg.Events,
+		Url:         reg.URL,
+		Headers:     reg.Headers,
+		Timeout:     int32(reg.Timeout),
+		Active:      reg.Active,
+		Description: reg.Description,
+		CreatedAt:   reg.CreatedAt.Unix(),
+		UpdatedAt:   reg.UpdatedAt.Unix(),
+		MaxAttempts: int32(reg.MaxAttempts),
+		ContentType: reg.ContentType,
+
+		MaxStoredResponseBytes:  int32(reg.MaxStoredResponseBytes),
+		DisableTracePropagation: reg.DisableTracePropagation,
+		Ordered:                 reg.Ordered,
+		IncludeFields:           reg.IncludeFields,
+		ExcludeFields:           reg.ExcludeFields,
+		FallbackUrls:            reg.FallbackURLs,
+		SuccessBodyMatcher:      convertSuccessBodyMatcher(reg.SuccessBodyMatcher),
+		AuthType:                convertAuthType(reg.AuthType),
+		MaxInFlight:             int32(reg.MaxInFlight),
+		CompressBody:            reg.CompressBody,
+		BatchWindowMs:           int32(reg.BatchWindowMs),
+		BatchMaxSize:            int32(reg.BatchMaxSize),
+		RetryScheduleSeconds:    retryScheduleToProto(reg.RetrySchedule),
+		QueryParams:             reg.QueryParams,
+		MetadataFilter:          reg.MetadataFilter,
+		Signed:                  reg.Signed,
+		BypassProxy:             reg.BypassProxy,
+		Priority:                int32(reg.Priority),
+	}
+	if reg.ExpiresAt != nil {
+		webhook.ExpiresAt = reg.ExpiresAt.Unix()
+	}
+	return webhook
+}
+
+// convertWebhookHealth converts a webhook's health score to protobuf format
+func convertWebhookHealth(score webhooks.HealthScore) *pb.WebhookHealth {
+	health := &pb.WebhookHealth{
+		SuccessRate: score.SuccessRate,
+		Attempts:    score.Attempts,
+	}
+	if score.DisabledAt != nil {
+		health.DisabledAt = score.DisabledAt.Unix()
+	}
+	return health
+}
+
+// retryScheduleToProto converts a webhook's retry delays to protobuf format
+func retryScheduleToProto(schedule []int) []int32 {
+	if len(schedule) == 0 {
+		return nil
+	}
+	seconds := make([]int32, len(schedule))
+	for i, s := range schedule {
+		seconds[i] = int32(s)
+	}
+	return seconds
+}
+
+// convertDeliveryGroups converts grouped deliveries to protobuf, one group per ID in order
+func convertDeliveryGroups(ids []string, groups map[string][]*webhooks.WebhookDelivery, includeHeaders bool) []*pb.DeliveryStatusGroup {
+	pbGroups := make([]*pb.DeliveryStatusGroup, len(ids))
+	for i, id := range ids {
+		deliveries := groups[id]
+		pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+		for j, d := range deliveries {
+			pbDeliveries[j] = convertDelivery(d)
+			if includeHeaders {
+				pbDeliveries[j].RequestHeaders = d.RequestHeaders
+				pbDeliveries[j].ResponseHeaders = d.ResponseHeaders
+			}
+		}
+		pbGroups[i] = &pb.DeliveryStatusGroup{Id: id, Deliveries: pbDeliveries}
+	}
+	return pbGroups
+}
+
+// convertDelivery converts a webhook delivery to its protobuf form
+func convertDelivery(d *webhooks.WebhookDelivery) *pb.WebhookDelivery {
+	delivery := &pb.WebhookDelivery{
+		DeliveryId:   d.ID,
+		WebhookId:    d.WebhookID,
+		EventId:      d.EventID,
+		Status:       convertDeliveryStatus(d.Status),
+		AttemptCount: int32(d.AttemptCount),
+		MaxAttempts:  int32(d.MaxAttempts),
+		CreatedAt:    d.CreatedAt.Unix(),
+		ExpiresAt:    d.ExpiresAt.Unix(),
+		ResponseCode: int32(d.ResponseCode),
+		ResponseBody: d.ResponseBody,
+		ErrorMessage: d.ErrorMessage,
+
+		ResponseContentType: d.ResponseContentType,
+		ResponseUrl:         d.ResponseURL,
+		FailureReason:       convertFailureReason(d.FailureReason),
+		ResponseTruncated:   d.ResponseTruncated,
+	}
+
+	if d.LastAttemptedAt != nil {
+		delivery.LastAttemptedAt = d.LastAttemptedAt.Unix()
+	}
+	if d.NextRetryAt != nil {
+		delivery.NextRetryAt = d.NextRetryAt.Unix()
+	}
+
+	return delivery
+}
+
+// convertStoredEvent converts a listed event record to its protobuf form
+func convertStoredEvent(e *webhooks.ListedEvent) *pb.StoredEvent {
+	event := &pb.StoredEvent{
+		EventId:      e.ID,
+		Namespace:    e.Namespace,
+		Event:        e.Event,
+		ContentType:  e.ContentType,
+		Metadata:     e.Metadata,
+		CreatedAt:    e.CreatedAt.Unix(),
+		ExpiresAt:    e.ExpiresAt.Unix(),
+		WebhookCount: int32(e.WebhookCount),
+		DeliverySummary: &pb.EventDeliverySummary{
+			TotalDeliveries: int32(e.Deliveries.Total),
+			Succeeded:       int32(e.Deliveries.Succeeded),
+			Failed:          int32(e.Deliveries.Failed),
+			Pending:         int32(e.Deliveries.Pending),
+		},
+	}
+	// A protobuf string must be UTF-8, so other payloads are returned as bytes
+	if webhooks.IsTextPayload(e.Payload) {
+		event.Payload = e.Payload
+	} else {
+		event.RawPayload = []byte(e.Payload)
+	}
+	return event
+}
+
+// deliveryStatsRange returns the [since, until) range of a GetDeliveryStats request
+func deliveryStatsRange(req *pb.GetDeliveryStatsRequest) (time.Time, time.Time, error) {
+	until := time.Now()
+	if req.Until > 0 {
+		until = time.Unix(req.Until, 0)
+	}
+	since := until.Add(-defaultDeliveryStatsRange)
+	if req.Since > 0 {
+		since = time.Unix(req.Since, 0)
+	}
+	if !since.Before(until) {
+		return since, until, fmt.Errorf("since must be before until")
+	}
+	return since, until, nil
+}
+
+// eventTypeListFilter builds a repository filter from a ListEventTypes request
+func eventTypeListFilter(req *pb.ListEventTypesRequest) webhooks.EventTypeListFilter {
+	filter := webhooks.EventTypeListFilter{Namespace: req.Namespace}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	return filter
+}
+
+// convertEventTypes converts a namespace's event names to protobuf
+func convertEventTypes(types []*webhooks.EventType) []*pb.EventType {
+	pbTypes := make([]*pb.EventType, len(types))
+	for i, t := range types {
+		pbTypes[i] = &pb.EventType{
+			Event:      t.Event,
+			Count:      t.Count,
+			Registered: t.Registered,
+		}
+		if t.LastSeenAt != nil {
+			pbTypes[i].LastSeenAt = t.LastSeenAt.Unix()
+		}
+	}
+	return pbTypes
+}
+
+// convertDeliveryStats converts aggregated delivery statistics to protobuf
+func convertDeliveryStats(stats *webhooks.DeliveryStats) *pb.DeliveryStats {
+	return &pb.DeliveryStats{
+		Total:        stats.Total,
+		Pending:      stats.Pending,
+		Sending:      stats.Sending,
+		Success:      stats.Success,
+		Failed:       stats.Failed,
+		Retrying:     stats.Retrying,
+		Expired:      stats.Expired,
+		SuccessRate:  stats.SuccessRate(),
+		LatencyP50Ms: stats.LatencyP50,
+		LatencyP95Ms: stats.LatencyP95,
+	}
+}
+
+// eventListFilter builds a repository filter from a ListEvents request
+func eventListFilter(req *pb.ListEventsRequest) (webhooks.EventListFilter, error) {
+	filter := webhooks.EventListFilter{
+		Namespace: req.Namespace,
+		Event:     req.Event,
+		Limit:     int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListEventsLimit
+	}
+	if filter.Limit > maxListEventsLimit {
+		filter.Limit = maxListEventsLimit
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	if req.PageToken != "" {
+		cursor, err := webhooks.DecodeEventCursor(req.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.Cursor = cursor
+	}
+	return filter, nil
+}
+
+// deliveryListFilter builds a repository filter from a ListDeliveries request
+func deliveryListFilter(req *pb.ListDeliveriesRequest) (webhooks.DeliveryListFilter, error) {
+	filter := webhooks.DeliveryListFilter{
+		Namespace: req.Namespace,
+		Status:    deliveryStatusFromProto(req.Status),
+		Limit:     int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListEventsLimit
+	}
+	if filter.Limit > maxListEventsLimit {
+		filter.Limit = maxListEventsLimit
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	if req.PageToken != "" {
+		cursor, err := webhooks.DecodeEventCursor(req.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.Cursor = cursor
+	}
+	return filter, nil
+}
+
+// eventFailureListFilter builds a repository filter from a ListEventFailures request
+func eventFailureListFilter(req *pb.ListEventFailuresRequest) (webhooks.EventFailureListFilter, error) {
+	filter := webhooks.EventFailureListFilter{
+		Namespace: req.Namespace,
+		Limit:     int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListEventsLimit
+	}
+	if filter.Limit > maxListEventsLimit {
+		filter.Limit = maxListEventsLimit
+	}
+	if req.PageToken != "" {
+		cursor, err := webhooks.DecodeEventCursor(req.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.Cursor = cursor
+	}
+	return filter, nil
+}
+
+// convertEventFailure converts an event processing failure to protobuf
+func convertEventFailure(f *webhooks.EventProcessingFailure) *pb.EventProcessingFailure {
+	return &pb.EventProcessingFailure{
+		Id:        f.ID,
+		EventId:   f.EventID,
+		Namespace: f.Namespace,
+		Event:     f.Event,
+		Error:     f.Error,
+		Attempts:  int32(f.Attempts),
+		FailedAt:  f.FailedAt.Unix(),
+	}
+}
+
+// replayFilter builds a repository filter selecting the events a ReplayEvents request replays
+func replayFilter(req *pb.ReplayEventsRequest) webhooks.EventListFilter {
+	filter := webhooks.EventListFilter{
+		Namespace: req.Namespace,
+		Event:     req.Event,
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	return filter
+}
+
+// webhookListFilter builds a repository filter from a ListAllWebhooks request
+func webhookListFilter(req *pb.ListAllWebhooksRequest) (webhooks.WebhookListFilter, error) {
+	filter := webhooks.WebhookListFilter{
+		ActiveOnly:  req.ActiveOnly,
+		URLContains: req.UrlContains,
+		Limit:       int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListAllWebhooksLimit
+	}
+	if filter.Limit > maxListAllWebhooksLimit {
+		filter.Limit = maxListAllWebhooksLimit
+	}
+	if req.PageToken != "" {
+		afterID, err := webhooks.DecodeWebhookCursor(req.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.AfterID = afterID
+	}
+	return filter, nil
+}
+
+// convertSuccessBodyMatcher converts a stored success body matcher to protobuf format
+func convertSuccessBodyMatcher(m *webhooks.BodyMatcher) *pb.SuccessBodyMatcher {
+	if m == nil {
+		return nil
+	}
+	return &pb.SuccessBodyMatcher{
+		JsonPath:      m.JSONPath,
+		ExpectedValue: m.Expected,
+		Regex:         m.Regex,
+	}
+}
+
+// convertAuthType converts a stored auth type to protobuf format
+func convertAuthType(authType string) pb.WebhookAuthType {
+	switch authType {
+	case webhooks.AuthTypeBasic:
+		return pb.WebhookAuthType_AUTH_BASIC
+	case webhooks.AuthTypeBearer:
+		return pb.WebhookAuthType_AUTH_BEARER
+	default:
+		return pb.WebhookAuthType_AUTH_NONE
+	}
+}
+
+// failureReasons maps stored failure reasons to their protobuf values
+var failureReasons = map[webhooks.FailureReason]pb.DeliveryFailureReason{
+	webhooks.FailureDNS:               pb.DeliveryFailureReason_FAILURE_DNS_ERROR,
+	webhooks.FailureConnectionRefused: pb.DeliveryFailureReason_FAILURE_CONNECTION_REFUSED,
+	webhooks.FailureTLS:               pb.DeliveryFailureReason_FAILURE_TLS_ERROR,
+	webhooks.FailureTimeout:           pb.DeliveryFailureReason_FAILURE_TIMEOUT,
+	webhooks.FailureHTTP4xx:           pb.DeliveryFailureReason_FAILURE_HTTP_4XX,
+	webhooks.FailureHTTP5xx:           pb.DeliveryFailureReason_FAILURE_HTTP_5XX,
+	webhooks.FailureBodyMatch:         pb.DeliveryFailureReason_FAILURE_BODY_MATCH_FAILED,
+	webhooks.FailureHeaderTemplate:    pb.DeliveryFailureReason_FAILURE_HEADER_TEMPLATE_ERROR,
+	webhooks.FailurePortNotAllowed:    pb.DeliveryFailureReason_FAILURE_PORT_NOT_ALLOWED,
+	webhooks.FailureOther:             pb.DeliveryFailureReason_FAILURE_OTHER,
+}
+
+// convertFailureReason converts a stored failure reason to protobuf
+func convertFailureReason(reason webhooks.FailureReason) pb.DeliveryFailureReason {
+	return failureReasons[reason]
+}
+
+// failureReasonFromProto converts a protobuf failure reason to its stored form
+func failureReasonFromProto(reason pb.DeliveryFailureReason) webhooks.FailureReason {
+	for stored, value := range failureReasons {
+		if value == reason {
+			return stored
+		}
+	}
+	return webhooks.FailureNone
+}
+
+// convertDeliveryStatus converts internal status to protobuf status
+func convertDeliveryStatus(status webhooks.WebhookDeliveryStatus) pb.WebhookDeliveryStatus {
+	switch status {
+	case webhooks.StatusPending:
+		return pb.WebhookDeliveryStatus_DELIVERY_PENDING
+	case webhooks.StatusSending:
+		return pb.WebhookDeliveryStatus_DELIVERY_SENDING
+	case webhooks.StatusSuccess:
+		return pb.WebhookDeliveryStatus_DELIVERY_SUCCESS
+	case webhooks.StatusFailed:
+		return pb.WebhookDeliveryStatus_DELIVERY_FAILED
+	case webhooks.StatusRetrying:
+		return pb.WebhookDeliveryStatus_DELIVERY_RETRYING
+	case webhooks.StatusExpired:
+		return pb.WebhookDeliveryStatus_DELIVERY_EXPIRED
+	default:
+		return pb.WebhookDeliveryStatus_DELIVERY_UNKNOWN
+	}
+}
+
+// deliveryStatusFromProto converts a protobuf status to the stored status; DELIVERY_UNKNOWN
+// maps to no status
+func deliveryStatusFromProto(status pb.WebhookDeliveryStatus) webhooks.WebhookDeliveryStatus {
+	for _, stored := range []webhooks.WebhookDeliveryStatus{
+		webhooks.StatusPending,
+		webhooks.StatusSending,
+		webhooks.StatusSuccess,
+		webhooks.StatusFailed,
+		webhooks.StatusRetrying,
+		webhooks.StatusExpired,
+	} {
+		if convertDeliveryStatus(stored) == status {
+			return stored
+		}
+	}
+	return ""
+}
+
+// Handler returns the Connect-RPC handler. Additional interceptors run after the OpenTelemetry one.
+func (s *WebhookConnectServer) Handler(interceptors ...connect.Interceptor) (string, http.Handler) {
+	// Create simple handler
+	otelInterceptor, err := otelconnect.NewInterceptor()
+	if err != nil {
+		log.Fatal(err)
+	}
+	interceptors = append([]connect.Interceptor{otelInterceptor}, interceptors...)
+	path, handler := protoconnect.NewWebhookServiceHandler(s, connect.WithInterceptors(interceptors...))
+	return path, withoutStreamWriteDeadline(handler)
+}
+
+// withoutStreamWriteDeadline clears the HTTP server's write timeout for WatchWebhookStatus,
+// whose stream lasts until the client cancels it rather than one response
+func withoutStreamWriteDeadline(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == protoconnect.WebhookServiceWatchWebhookStatusProcedure {
+			if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+				logger.NewLogger("connect-webhook-server").Warn("Failed to clear write deadline of watch stream", "error", err)
+			}
+		}
+		next.ServeHTTP(w, r)
+	})
 }
@@ -0,0 +1,68 @@
+package connect
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+	pb "github.com/sarathsp06/sparrow/proto"
+	"github.com/sarathsp06/sparrow/proto/protoconnect"
+)
+
+func TestWatchWebhookStatusOutlivesWriteTimeout(t *testing.T) {
+	ctx := context.Background()
+	store := webhooks.NewMemoryStore()
+	webhook := &webhooks.WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com/hook", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreEvent(ctx, &webhooks.EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 60}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Serve through the same middleware as main, with a write timeout the stream outlives
+	path, handler := NewWebhookConnectServer(nil, store, time.Minute).Handler()
+	mux := http.NewServeMux()
+	mux.Handle(path, handler)
+	srv := httptest.NewUnstartedServer(otelhttp.NewHandler(logger.HTTPMiddleware(mux), "test"))
+	srv.Config.WriteTimeout = 200 * time.Millisecond
+	srv.Start()
+	defer srv.Close()
+
+	// The delivery appears after the write timeout has passed
+	delivery := &webhooks.WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, ExpiresAt: time.Now().Add(time.Minute)}
+	created := make(chan error, 1)
+	go func() {
+		time.Sleep(2 * srv.Config.WriteTimeout)
+		_, err := store.CreateDelivery(ctx, delivery)
+		created <- err
+	}()
+
+	watchCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	client := protoconnect.NewWebhookServiceClient(srv.Client(), srv.URL)
+	stream, err := client.WatchWebhookStatus(watchCtx, connect.NewRequest(&pb.WatchWebhookStatusRequest{
+		Identifier: &pb.WatchWebhookStatusRequest_WebhookId{WebhookId: webhook.ID},
+	}))
+	if err != nil {
+		t.Fatalf("WatchWebhookStatus: %v", err)
+	}
+	defer stream.Close()
+	if err := <-created; err != nil {
+		t.Fatal(err)
+	}
+
+	if !stream.Receive() {
+		t.Fatalf("stream ended before the delivery was sent: %v", stream.Err())
+	}
+	if got := stream.Msg().DeliveryId; got != delivery.ID {
+		t.Errorf("received delivery %q, want %q", got, delivery.ID)
+	}
+}
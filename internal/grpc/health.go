@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/sarathsp06/sparrow/internal/logger"
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+// RunHealthChecks keeps the gRPC health service in step with check until ctx is done.
+// Both the overall server ("") and the webhook service report the same status.
+func RunHealthChecks(ctx context.Context, hs *health.Server, check func(context.Context) error, interval time.Duration) {
+	log := logger.NewLogger("grpc-health")
+	services := []string{"", pb.WebhookService_ServiceDesc.ServiceName}
+
+	var last healthpb.HealthCheckResponse_ServingStatus
+	update := func() {
+		checkCtx, cancel := context.WithTimeout(ctx, interval)
+		defer cancel()
+
+		status := healthpb.HealthCheckResponse_SERVING
+		if err := check(checkCtx); err != nil {
+			status = healthpb.HealthCheckResponse_NOT_SERVING
+			if last != status {
+				log.Warn("Server is not ready", "error", err)
+			}
+		}
+		for _, service := range services {
+			hs.SetServingStatus(service, status)
+		}
+		last = status
+	}
+
+	update()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			update()
+		}
+	}
+}
@@ -2,7 +2,7 @@ package grpc
 
 import (
 	"context"
-	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -13,28 +13,64 @@ import (
 	otelcodes "go.opentelemetry.io/otel/codes"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/sarathsp06/sparrow/internal/auth"
 	"github.com/sarathsp06/sparrow/internal/jobs"
 	"github.com/sarathsp06/sparrow/internal/logger"
 	"github.com/sarathsp06/sparrow/internal/observability"
 	"github.com/sarathsp06/sparrow/internal/queue"
+	"github.com/sarathsp06/sparrow/internal/validation"
 	"github.com/sarathsp06/sparrow/internal/webhooks"
 	pb "github.com/sarathsp06/sparrow/proto"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// maxPushEventsBatchSize caps the number of events accepted by PushEvents
+	maxPushEventsBatchSize = 1000
+
+	// defaultWebhookTimeoutSeconds applies to webhooks registered without a timeout
+	defaultWebhookTimeoutSeconds = 30
+
+	// watchPollInterval is how often WatchWebhookStatus checks for delivery changes
+	watchPollInterval = time.Second
+
+	// defaultListEventsLimit and maxListEventsLimit bound the page size of ListEvents,
+	// ListEventFailures and ListDeliveries
+	defaultListEventsLimit = 50
+	maxListEventsLimit     = 500
+
+	// defaultListAllWebhooksLimit and maxListAllWebhooksLimit bound the page size of ListAllWebhooks
+	defaultListAllWebhooksLimit = 50
+	maxListAllWebhooksLimit     = 500
+
+	// defaultDeliveryStatsRange is the range GetDeliveryStats covers when since is unset
+	defaultDeliveryStatsRange = 24 * time.Hour
+
+	// maxDeliveryTTLExtension caps the new TTL given to an expired delivery by ExtendDeliveryTTL
+	maxDeliveryTTLExtension = 24 * time.Hour
+)
+
 // WebhookServer implements the WebhookService gRPC interface
 type WebhookServer struct {
 	pb.UnimplementedWebhookServiceServer
 	queueManager *queue.Manager
-	webhookRepo  *webhooks.Repository
+	webhookRepo  webhooks.WebhookStore
 	logger       *slog.Logger
 	tracer       trace.Tracer
 	metrics      *observability.SparrowMetrics
+
+	schemaValidator *webhooks.SchemaValidator
+
+	// maxDeliveryTimeout is the longest timeout a webhook can register
+	maxDeliveryTimeout time.Duration
+
+	// allowedPorts are the ports http(s) webhook URLs may use; empty = any port
+	allowedPorts []int
 }
 
 // NewWebhookServer creates a new WebhookServer instance
-func NewWebhookServer(queueManager *queue.Manager, webhookRepo *webhooks.Repository) *WebhookServer {
+func NewWebhookServer(queueManager *queue.Manager, webhookRepo webhooks.WebhookStore, maxDeliveryTimeout time.Duration) *WebhookServer {
 	metrics, err := observability.NewSparrowMetrics()
 	if err != nil {
 		// Log error but continue without metrics
@@ -48,9 +84,18 @@ func NewWebhookServer(queueManager *queue.Manager, webhookRepo *webhooks.Reposit
 		logger:       logger.NewLogger("grpc-webhook-server"),
 		tracer:       observability.GetTracer("sparrow.grpc.webhook"),
 		metrics:      metrics,
+
+		schemaValidator:    webhooks.NewSchemaValidator(webhookRepo),
+		maxDeliveryTimeout: maxDeliveryTimeout,
 	}
 }
 
+// LimitWebhookPorts restricts the ports registered http(s) webhook URLs may use; an empty
+// list allows any port
+func (s *WebhookServer) LimitWebhookPorts(ports []int) {
+	s.allowedPorts = ports
+}
+
 // RegisterWebhook registers a URL for specific events in a namespace
 func (s *WebhookServer) RegisterWebhook(ctx context.Context, req *pb.RegisterWebhookRequest) (*pb.RegisterWebhookResponse, error) {
 	ctx, span := s.tracer.Start(ctx, "webhook.register",
@@ -66,56 +111,46 @@ func (s *WebhookServer) RegisterWebhook(ctx context.Context, req *pb.RegisterWeb
 		"namespace", req.Namespace,
 		"events", req.Events,
 		"url", req.Url,
+		"auth_type", req.GetAuth().GetType().String(),
 	)
 
-	// Validate required fields
-	if req.Namespace == "" {
-		span.RecordError(fmt.Errorf("namespace is required"))
-		span.SetStatus(otelcodes.Error, "namespace is required")
-		return nil, status.Error(codes.InvalidArgument, "namespace is required")
-	}
-	if len(req.Events) == 0 {
-		span.RecordError(fmt.Errorf("at least one event is required"))
-		span.SetStatus(otelcodes.Error, "at least one event is required")
-		return nil, status.Error(codes.InvalidArgument, "at least one event is required")
-	}
-	if req.Url == "" {
-		span.RecordError(fmt.Errorf("URL is required"))
-		span.SetStatus(otelcodes.Error, "URL is required")
-		return nil, status.Error(codes.InvalidArgument, "URL is required")
+	// Check every field, reporting all violations at once
+	registration, err := validation.ValidateRegistration(req, int32(s.maxDeliveryTimeout/time.Second), s.allowedPorts)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "invalid webhook registration")
+		return nil, err
 	}
 
-	// Validate events are not empty
-	for _, event := range req.Events {
-		if event == "" {
-			span.RecordError(fmt.Errorf("event names cannot be empty"))
-			span.SetStatus(otelcodes.Error, "event names cannot be empty")
-			return nil, status.Error(codes.InvalidArgument, "event names cannot be empty")
-		}
+	if err := s.checkNamespace(ctx, req.Namespace); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "unknown namespace")
+		return nil, err
 	}
 
-	// Set default timeout
-	timeout := req.Timeout
-	if timeout <= 0 {
-		timeout = 30
+	// Webhooks registered without a timeout get the namespace's default
+	if registration.Timeout == 0 {
+		timeout, err := s.defaultTimeout(ctx, req.Namespace)
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, "failed to load namespace defaults")
+			return nil, status.Errorf(codes.Internal, "failed to load namespace defaults: %v", err)
+		}
+		registration.Timeout = int(timeout)
 	}
 
-	span.SetAttributes(attribute.Int("timeout", int(timeout)))
-
-	// Create webhook registration (method is always POST)
-	registration := &webhooks.WebhookRegistration{
-		Namespace:   req.Namespace,
-		Events:      req.Events,
-		URL:         req.Url,
-		Headers:     req.Headers,
-		Timeout:     int(timeout),
-		Active:      req.Active,
-		Description: req.Description,
-	}
+	span.SetAttributes(
+		attribute.Int("timeout", registration.Timeout),
+		attribute.Int("max_attempts", registration.MaxAttempts),
+	)
 
 	// Store the registration
 	if err := s.webhookRepo.RegisterWebhook(ctx, registration); err != nil {
 		span.RecordError(err)
+		if errors.Is(err, webhooks.ErrWebhookQuotaExceeded) {
+			span.SetStatus(otelcodes.Error, "webhook quota exceeded")
+			return nil, status.Error(codes.ResourceExhausted, err.Error())
+		}
 		span.SetStatus(otelcodes.Error, "failed to register webhook")
 		s.logger.Error("Failed to register webhook",
 			"namespace", req.Namespace,
@@ -179,6 +214,200 @@ func (s *WebhookServer) UnregisterWebhook(ctx context.Context, req *pb.Unregiste
 	}, nil
 }
 
+// PauseWebhook stops new deliveries to a webhook without deleting it
+func (s *WebhookServer) PauseWebhook(ctx context.Context, req *pb.PauseWebhookRequest) (*pb.PauseWebhookResponse, error) {
+	s.logger.Info("Received webhook pause request",
+		"webhook_id", req.WebhookId,
+	)
+
+	if err := s.setWebhookActive(ctx, req.WebhookId, false); err != nil {
+		return nil, err
+	}
+
+	return &pb.PauseWebhookResponse{
+		Success: true,
+		Message: "Webhook paused successfully",
+	}, nil
+}
+
+// ResumeWebhook re-enables deliveries to a paused webhook
+func (s *WebhookServer) ResumeWebhook(ctx context.Context, req *pb.ResumeWebhookRequest) (*pb.ResumeWebhookResponse, error) {
+	s.logger.Info("Received webhook resume request",
+		"webhook_id", req.WebhookId,
+	)
+
+	if err := s.setWebhookActive(ctx, req.WebhookId, true); err != nil {
+		return nil, err
+	}
+
+	return &pb.ResumeWebhookResponse{
+		Success: true,
+		Message: "Webhook resumed successfully",
+	}, nil
+}
+
+// RotateSecret replaces a signed webhook's signing secret, keeping the old one signing
+// deliveries during the grace period
+func (s *WebhookServer) RotateSecret(ctx context.Context, req *pb.RotateSecretRequest) (*pb.RotateSecretResponse, error) {
+	s.logger.Info("Received signing secret rotation request",
+		"webhook_id", req.WebhookId,
+		"grace_period_seconds", req.GracePeriodSeconds,
+	)
+
+	grace, err := validation.ValidateRotateSecret(req)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := s.webhookRepo.GetWebhookByID(ctx, req.WebhookId)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return nil, status.Error(codes.NotFound, "webhook not found")
+	}
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to get webhook: %v", err)
+	}
+	if !webhook.Signed {
+		return nil, status.Error(codes.FailedPrecondition, "webhook has no signing secret")
+	}
+
+	previousExpiresAt := time.Now().Add(grace)
+	err = s.queueManager.RotateSigningSecret(ctx, req.WebhookId, req.NewSecret, previousExpiresAt)
+	switch {
+	case errors.Is(err, webhooks.ErrNotFound):
+		return nil, status.Error(codes.FailedPrecondition, "webhook has no signing secret")
+	case err != nil:
+		s.logger.Error("Failed to rotate signing secret", "webhook_id", req.WebhookId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to rotate signing secret: %v", err)
+	}
+
+	s.logger.Info("Signing secret rotated", "webhook_id", req.WebhookId, "previous_expires_at", previousExpiresAt)
+
+	return &pb.RotateSecretResponse{
+		Success:                 true,
+		Message:                 "Signing secret rotated",
+		PreviousSecretExpiresAt: previousExpiresAt.Unix(),
+	}, nil
+}
+
+// setWebhookActive toggles a webhook and keeps the active webhooks gauge in step
+func (s *WebhookServer) setWebhookActive(ctx context.Context, webhookID string, active bool) error {
+	if webhookID == "" {
+		return status.Error(codes.InvalidArgument, "webhook_id is required")
+	}
+
+	changed, err := s.webhookRepo.SetWebhookActive(ctx, webhookID, active)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return status.Error(codes.NotFound, "webhook not found")
+		}
+		s.logger.Error("Failed to update webhook state",
+			"webhook_id", webhookID,
+			"active", active,
+			"error", err,
+		)
+		return status.Errorf(codes.Internal, "failed to update webhook: %v", err)
+	}
+
+	if changed && s.metrics != nil {
+		if active {
+			s.metrics.ActiveWebhooks.Add(ctx, 1)
+		} else {
+			s.metrics.ActiveWebhooks.Add(ctx, -1)
+		}
+	}
+
+	s.logger.Info("Webhook state updated",
+		"webhook_id", webhookID,
+		"active", active,
+		"changed", changed,
+	)
+	return nil
+}
+
+// DeleteWebhooks removes all webhooks matching a namespace and optional event
+func (s *WebhookServer) DeleteWebhooks(ctx context.Context, req *pb.DeleteWebhooksRequest) (*pb.DeleteWebhooksResponse, error) {
+	s.logger.Info("Received bulk webhook deletion request",
+		"namespace", req.Namespace,
+		"event", req.Event,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	deleted, active, err := s.webhookRepo.DeleteWebhooksByFilter(ctx, req.Namespace, req.Event)
+	if err != nil {
+		s.logger.Error("Failed to delete webhooks",
+			"namespace", req.Namespace,
+			"event", req.Event,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to delete webhooks: %v", err)
+	}
+
+	if s.metrics != nil {
+		s.metrics.ActiveWebhooks.Add(ctx, -active)
+	}
+
+	s.logger.Info("Webhooks deleted successfully",
+		"namespace", req.Namespace,
+		"event", req.Event,
+		"deleted_count", deleted,
+	)
+
+	return &pb.DeleteWebhooksResponse{
+		DeletedCount: int32(deleted),
+		Success:      true,
+		Message:      fmt.Sprintf("Deleted %d webhooks", deleted),
+	}, nil
+}
+
+// RegisterEventSchema registers the JSON Schema that payloads of an event must match
+func (s *WebhookServer) RegisterEventSchema(ctx context.Context, req *pb.RegisterEventSchemaRequest) (*pb.RegisterEventSchemaResponse, error) {
+	s.logger.Info("Received event schema registration request",
+		"namespace", req.Namespace,
+		"event", req.Event,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+	if req.Event == "" {
+		return nil, status.Error(codes.InvalidArgument, "event is required")
+	}
+	if req.Schema == "" {
+		return nil, status.Error(codes.InvalidArgument, "schema is required")
+	}
+	if _, err := webhooks.CompileSchema(req.Schema); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	schema := &webhooks.EventSchema{
+		Namespace: req.Namespace,
+		Event:     req.Event,
+		Schema:    req.Schema,
+	}
+	if err := s.webhookRepo.UpsertEventSchema(ctx, schema); err != nil {
+		s.logger.Error("Failed to register event schema",
+			"namespace", req.Namespace,
+			"event", req.Event,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to register event schema: %v", err)
+	}
+	s.schemaValidator.Invalidate(req.Namespace, req.Event)
+
+	s.logger.Info("Event schema registered successfully",
+		"namespace", req.Namespace,
+		"event", req.Event,
+	)
+
+	return &pb.RegisterEventSchemaResponse{
+		Success: true,
+		Message: "Event schema registered successfully",
+	}, nil
+}
+
 // PushEvent pushes an event that triggers registered webhooks
 func (s *WebhookServer) PushEvent(ctx context.Context, req *pb.PushEventRequest) (*pb.PushEventResponse, error) {
 	ctx, span := s.tracer.Start(ctx, "event.push",
@@ -194,32 +423,29 @@ func (s *WebhookServer) PushEvent(ctx context.Context, req *pb.PushEventRequest)
 		"event", req.Event,
 	)
 
-	// Validate required fields
-	if req.Namespace == "" {
-		span.RecordError(fmt.Errorf("namespace is required"))
-		span.SetStatus(otelcodes.Error, "namespace is required")
-		return nil, status.Error(codes.InvalidArgument, "namespace is required")
-	}
-	if req.Event == "" {
-		span.RecordError(fmt.Errorf("event is required"))
-		span.SetStatus(otelcodes.Error, "event is required")
-		return nil, status.Error(codes.InvalidArgument, "event is required")
+	// Validate required fields and payload
+	ttl, err := validation.ValidatePushEvent(req, s.queueManager.EventTTL)
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, err.Error())
+		return nil, err
 	}
 
-	// Validate JSON payload
-	if req.Payload != "" {
-		var payload interface{}
-		if err := json.Unmarshal([]byte(req.Payload), &payload); err != nil {
-			span.RecordError(err)
-			span.SetStatus(otelcodes.Error, "invalid JSON payload")
-			return nil, status.Errorf(codes.InvalidArgument, "invalid JSON payload: %v", err)
-		}
+	if err := s.checkNamespace(ctx, req.Namespace); err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "unknown namespace")
+		return nil, err
 	}
 
-	// Set default TTL if not provided
-	ttl := req.TtlSeconds
-	if ttl <= 0 {
-		ttl = 3600 // Default 1 hour
+	// Validate against the event's registered JSON Schema, if any
+	if err := s.schemaValidator.Validate(ctx, req.Namespace, req.Event, req.ContentType, validation.EventPayload(req)); err != nil {
+		span.RecordError(err)
+		if errors.Is(err, webhooks.ErrSchemaValidation) {
+			span.SetStatus(otelcodes.Error, "payload does not match event schema")
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		span.SetStatus(otelcodes.Error, "failed to validate event schema")
+		return nil, status.Errorf(codes.Internal, "failed to validate event schema: %v", err)
 	}
 
 	// Generate event ID
@@ -227,13 +453,27 @@ func (s *WebhookServer) PushEvent(ctx context.Context, req *pb.PushEventRequest)
 
 	// Create event processing job
 	eventArgs := jobs.EventArgs{
-		EventID:    eventID,
-		Namespace:  req.Namespace,
-		Event:      req.Event,
-		Payload:    req.Payload,
-		TTLSeconds: ttl,
-		Metadata:   req.Metadata,
-		CreatedAt:  time.Now(),
+		EventID:     eventID,
+		Namespace:   req.Namespace,
+		Event:       req.Event,
+		Payload:     jobs.Payload(validation.EventPayload(req)),
+		ContentType: req.ContentType,
+		TTLSeconds:  ttl,
+		Metadata:    req.Metadata,
+		CreatedAt:   time.Now(),
+
+		TimeoutOverride: int(req.DeliveryTimeoutOverride),
+		Priority:        int(req.Priority),
+		DeliverAt:       eventDeliverAt(req),
+		TraceContext:    observability.InjectTraceContext(ctx),
+	}
+
+	if req.Synchronous {
+		result, err := s.pushEventSynchronously(ctx, span, req, eventArgs)
+		if err != nil {
+			return nil, err
+		}
+		return result, nil
 	}
 
 	// Find registered webhooks first to know how many will be triggered
@@ -248,6 +488,7 @@ func (s *WebhookServer) PushEvent(ctx context.Context, req *pb.PushEventRequest)
 		)
 		return nil, status.Errorf(codes.Internal, "failed to get registered webhooks: %v", err)
 	}
+	registeredWebhooks = webhooks.FilterByMetadata(registeredWebhooks, req.Metadata)
 
 	span.SetAttributes(
 		attribute.String("event_id", eventID),
@@ -260,9 +501,7 @@ func (s *WebhookServer) PushEvent(ctx context.Context, req *pb.PushEventRequest)
 	}
 
 	// Insert the event processing job
-	_, err = s.queueManager.GetClient().Insert(ctx, eventArgs, &river.InsertOpts{
-		Queue: "events",
-	})
+	_, err = s.queueManager.GetClient().Insert(ctx, eventArgs, eventInsertOpts(req))
 	if err != nil {
 		span.RecordError(err)
 		span.SetStatus(otelcodes.Error, "failed to schedule event processing")
@@ -298,6 +537,209 @@ func (s *WebhookServer) PushEvent(ctx context.Context, req *pb.PushEventRequest)
 	}, nil
 }
 
+// pushEventSynchronously pushes an event, making its first delivery attempts inline, and
+// returns their results
+func (s *WebhookServer) pushEventSynchronously(ctx context.Context, span trace.Span, req *pb.PushEventRequest, eventArgs jobs.EventArgs) (*pb.PushEventResponse, error) {
+	result, err := s.queueManager.PushEventSynchronously(ctx, eventArgs, eventInsertOpts(req))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "failed to push event synchronously")
+		s.logger.Error("Failed to push event synchronously",
+			"event_id", eventArgs.EventID,
+			"namespace", req.Namespace,
+			"event", req.Event,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to push event: %v", err)
+	}
+
+	webhookIDs := make([]string, len(result.Webhooks))
+	for i, wh := range result.Webhooks {
+		webhookIDs[i] = wh.ID
+	}
+
+	delivered := 0
+	deliveries := make([]*pb.SynchronousDelivery, len(result.Deliveries))
+	for i, d := range result.Deliveries {
+		if d.Status == webhooks.StatusSuccess {
+			delivered++
+		}
+		deliveries[i] = &pb.SynchronousDelivery{
+			WebhookId:    d.WebhookID,
+			DeliveryId:   d.DeliveryID,
+			Status:       convertDeliveryStatus(d.Status),
+			ResponseCode: int32(d.StatusCode),
+			ErrorMessage: d.Error,
+		}
+	}
+
+	if s.metrics != nil {
+		s.metrics.EventsPushed.Add(ctx, 1)
+	}
+
+	span.SetAttributes(
+		attribute.String("event_id", eventArgs.EventID),
+		attribute.Int("webhooks_count", len(result.Webhooks)),
+		attribute.Int("synchronous_deliveries", len(deliveries)),
+	)
+	span.SetStatus(otelcodes.Ok, "event pushed synchronously")
+
+	s.logger.Info("Event pushed synchronously",
+		"event_id", eventArgs.EventID,
+		"namespace", req.Namespace,
+		"event", req.Event,
+		"webhooks_to_trigger", len(result.Webhooks),
+		"delivered_inline", delivered,
+		"attempted_inline", len(deliveries),
+	)
+
+	return &pb.PushEventResponse{
+		EventId:               eventArgs.EventID,
+		WebhooksTriggered:     int32(len(result.Webhooks)),
+		WebhookIds:            webhookIDs,
+		Success:               true,
+		Message:               fmt.Sprintf("Event delivered inline to %d of %d webhooks attempted, %d webhooks will be triggered", delivered, len(deliveries), len(result.Webhooks)),
+		SynchronousDeliveries: deliveries,
+	}, nil
+}
+
+// PushEvents pushes a batch of events, scheduling all valid ones in a single insert
+func (s *WebhookServer) PushEvents(ctx context.Context, req *pb.PushEventsRequest) (*pb.PushEventsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "event.push_batch",
+		trace.WithAttributes(attribute.Int("batch_size", len(req.Events))),
+	)
+	defer span.End()
+
+	s.logger.Info("Received push events request", "batch_size", len(req.Events))
+
+	if len(req.Events) == 0 {
+		span.SetStatus(otelcodes.Error, "at least one event is required")
+		return nil, status.Error(codes.InvalidArgument, "at least one event is required")
+	}
+	if len(req.Events) > maxPushEventsBatchSize {
+		span.SetStatus(otelcodes.Error, "too many events in batch")
+		return nil, status.Errorf(codes.InvalidArgument, "batch contains %d events, maximum is %d", len(req.Events), maxPushEventsBatchSize)
+	}
+
+	results := make([]*pb.PushEventResult, len(req.Events))
+	params := make([]river.InsertManyParams, 0, len(req.Events))
+	scheduled := make([]int, 0, len(req.Events))
+
+	// Webhook lookups are cached per namespace/event since batches tend to repeat them
+	webhooksByEvent := make(map[string][]*webhooks.WebhookRegistration)
+
+	for i, eventReq := range req.Events {
+		results[i] = &pb.PushEventResult{Index: int32(i)}
+
+		ttl, err := validation.ValidatePushEvent(eventReq, s.queueManager.EventTTL)
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		if eventReq.Synchronous {
+			results[i].Error = "synchronous is not supported in PushEvents"
+			continue
+		}
+		if err := s.webhookRepo.EnsureNamespace(ctx, eventReq.Namespace); err != nil {
+			results[i].Error = fmt.Sprintf("namespace %q: %v", eventReq.Namespace, err)
+			continue
+		}
+		if err := s.schemaValidator.Validate(ctx, eventReq.Namespace, eventReq.Event, eventReq.ContentType, validation.EventPayload(eventReq)); err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+
+		key := eventReq.Namespace + "/" + eventReq.Event
+		registeredWebhooks, ok := webhooksByEvent[key]
+		if !ok {
+			registeredWebhooks, err = s.webhookRepo.GetWebhooksByEvent(ctx, eventReq.Namespace, eventReq.Event)
+			if err != nil {
+				s.logger.Error("Failed to get registered webhooks",
+					"namespace", eventReq.Namespace,
+					"event", eventReq.Event,
+					"error", err,
+				)
+				results[i].Error = fmt.Sprintf("failed to get registered webhooks: %v", err)
+				continue
+			}
+			webhooksByEvent[key] = registeredWebhooks
+		}
+
+		// Cached lookups are shared by the namespace/event; metadata filters are per event
+		matching := webhooks.FilterByMetadata(registeredWebhooks, eventReq.Metadata)
+		webhookIDs := make([]string, len(matching))
+		for j, wh := range matching {
+			webhookIDs[j] = wh.ID
+		}
+
+		eventID := uuid.New().String()
+		params = append(params, river.InsertManyParams{
+			Args: jobs.EventArgs{
+				EventID:     eventID,
+				Namespace:   eventReq.Namespace,
+				Event:       eventReq.Event,
+				Payload:     jobs.Payload(validation.EventPayload(eventReq)),
+				ContentType: eventReq.ContentType,
+				TTLSeconds:  ttl,
+				Metadata:    eventReq.Metadata,
+				CreatedAt:   time.Now(),
+
+				TimeoutOverride: int(eventReq.DeliveryTimeoutOverride),
+				Priority:        int(eventReq.Priority),
+				DeliverAt:       eventDeliverAt(eventReq),
+				TraceContext:    observability.InjectTraceContext(ctx),
+			},
+			InsertOpts: eventInsertOpts(eventReq),
+		})
+		scheduled = append(scheduled, i)
+
+		results[i].EventId = eventID
+		results[i].WebhooksTriggered = int32(len(webhookIDs))
+		results[i].WebhookIds = webhookIDs
+	}
+
+	if len(params) > 0 {
+		if _, err := s.queueManager.InsertManyJobs(ctx, params); err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, "failed to schedule event processing")
+			s.logger.Error("Failed to schedule event processing jobs",
+				"batch_size", len(params),
+				"error", err,
+			)
+			return nil, status.Errorf(codes.Internal, "failed to schedule event processing: %v", err)
+		}
+	}
+
+	accepted := len(scheduled)
+	for _, i := range scheduled {
+		results[i].Success = true
+	}
+
+	// Record metrics
+	if s.metrics != nil && accepted > 0 {
+		s.metrics.EventsPushed.Add(ctx, int64(accepted))
+	}
+
+	span.SetAttributes(
+		attribute.Int("accepted_count", accepted),
+		attribute.Int("rejected_count", len(req.Events)-accepted),
+	)
+	span.SetStatus(otelcodes.Ok, "events scheduled")
+
+	s.logger.Info("Event batch scheduled",
+		"accepted_count", accepted,
+		"rejected_count", len(req.Events)-accepted,
+	)
+
+	return &pb.PushEventsResponse{
+		Results:       results,
+		AcceptedCount: int32(accepted),
+		RejectedCount: int32(len(req.Events) - accepted),
+		Success:       accepted == len(req.Events),
+		Message:       fmt.Sprintf("%d of %d events scheduled for processing", accepted, len(req.Events)),
+	}, nil
+}
+
 // GetWebhookStatus gets the status of webhook deliveries
 func (s *WebhookServer) GetWebhookStatus(ctx context.Context, req *pb.GetWebhookStatusRequest) (*pb.GetWebhookStatusResponse, error) {
 	s.logger.Info("Received webhook status request")
@@ -325,28 +767,17 @@ func (s *WebhookServer) GetWebhookStatus(ctx context.Context, req *pb.GetWebhook
 		return nil, status.Errorf(codes.Internal, "failed to get webhook status: %v", err)
 	}
 
+	if req.FailureReason != pb.DeliveryFailureReason_FAILURE_NONE {
+		deliveries = webhooks.FilterByFailureReason(deliveries, failureReasonFromProto(req.FailureReason))
+	}
+
 	// Convert to protobuf format
 	pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
 	for i, d := range deliveries {
-		pbDeliveries[i] = &pb.WebhookDelivery{
-			DeliveryId:   d.ID,
-			WebhookId:    d.WebhookID,
-			EventId:      d.EventID,
-			Status:       convertDeliveryStatus(d.Status),
-			AttemptCount: int32(d.AttemptCount),
-			MaxAttempts:  int32(d.MaxAttempts),
-			CreatedAt:    d.CreatedAt.Unix(),
-			ExpiresAt:    d.ExpiresAt.Unix(),
-			ResponseCode: int32(d.ResponseCode),
-			ResponseBody: d.ResponseBody,
-			ErrorMessage: d.ErrorMessage,
-		}
-
-		if d.LastAttemptedAt != nil {
-			pbDeliveries[i].LastAttemptedAt = d.LastAttemptedAt.Unix()
-		}
-		if d.NextRetryAt != nil {
-			pbDeliveries[i].NextRetryAt = d.NextRetryAt.Unix()
+		pbDeliveries[i] = convertDelivery(d)
+		if req.IncludeHeaders {
+			pbDeliveries[i].RequestHeaders = d.RequestHeaders
+			pbDeliveries[i].ResponseHeaders = d.ResponseHeaders
 		}
 	}
 
@@ -358,30 +789,91 @@ func (s *WebhookServer) GetWebhookStatus(ctx context.Context, req *pb.GetWebhook
 	}, nil
 }
 
-// ListWebhooks lists all registered webhooks for a namespace
-func (s *WebhookServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
-	s.logger.Info("Received list webhooks request",
-		"namespace", req.Namespace,
-		"event", req.Event,
-		"active_only", req.ActiveOnly,
+// GetWebhookStatusBatch gets the status of the deliveries of several webhooks and events
+func (s *WebhookServer) GetWebhookStatusBatch(ctx context.Context, req *pb.GetWebhookStatusBatchRequest) (*pb.GetWebhookStatusBatchResponse, error) {
+	s.logger.Info("Received batch webhook status request",
+		"webhook_ids", len(req.WebhookIds),
+		"event_ids", len(req.EventIds),
 	)
 
-	if req.Namespace == "" {
-		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	if err := webhooks.ValidateStatusBatch(req.WebhookIds, req.EventIds); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	// Get webhooks from repository
-	registrations, err := s.webhookRepo.ListWebhooks(ctx, req.Namespace, req.ActiveOnly)
+	deliveries, err := s.webhookRepo.GetDeliveriesBatch(ctx, req.WebhookIds, req.EventIds)
 	if err != nil {
-		s.logger.Error("Failed to list webhooks",
-			"namespace", req.Namespace,
-			"error", err,
-		)
-		return nil, status.Errorf(codes.Internal, "failed to list webhooks: %v", err)
+		s.logger.Error("Failed to get webhook deliveries", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get webhook status: %v", err)
 	}
 
-	// Filter by event if specified
-	var filteredRegistrations []*webhooks.WebhookRegistration
+	if req.FailureReason != pb.DeliveryFailureReason_FAILURE_NONE {
+		deliveries = webhooks.FilterByFailureReason(deliveries, failureReasonFromProto(req.FailureReason))
+	}
+
+	byWebhook, byEvent := webhooks.GroupDeliveries(deliveries, req.WebhookIds, req.EventIds)
+
+	return &pb.GetWebhookStatusBatchResponse{
+		Webhooks:        convertDeliveryGroups(req.WebhookIds, byWebhook, req.IncludeHeaders),
+		Events:          convertDeliveryGroups(req.EventIds, byEvent, req.IncludeHeaders),
+		TotalDeliveries: int32(len(deliveries)),
+		Success:         true,
+		Message:         fmt.Sprintf("Found %d webhook deliveries", len(deliveries)),
+	}, nil
+}
+
+// WatchWebhookStatus streams delivery status changes until the client disconnects
+func (s *WebhookServer) WatchWebhookStatus(req *pb.WatchWebhookStatusRequest, stream pb.WebhookService_WatchWebhookStatusServer) error {
+	webhookID, eventID := req.GetWebhookId(), req.GetEventId()
+	if webhookID == "" && eventID == "" {
+		return status.Error(codes.InvalidArgument, "either webhook_id or event_id is required")
+	}
+
+	s.logger.Info("Received watch webhook status request",
+		"webhook_id", webhookID,
+		"event_id", eventID,
+	)
+
+	ctx := stream.Context()
+	err := s.webhookRepo.WatchDeliveries(ctx, webhookID, eventID, watchPollInterval, func(d *webhooks.WebhookDelivery) error {
+		return stream.Send(convertDelivery(d))
+	})
+	if err != nil && ctx.Err() == nil {
+		s.logger.Error("Failed to watch webhook status", "error", err)
+		return status.Errorf(codes.Internal, "failed to watch webhook status: %v", err)
+	}
+
+	s.logger.Info("Webhook status watch ended",
+		"webhook_id", webhookID,
+		"event_id", eventID,
+	)
+
+	return nil
+}
+
+// ListWebhooks lists all registered webhooks for a namespace
+func (s *WebhookServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRequest) (*pb.ListWebhooksResponse, error) {
+	s.logger.Info("Received list webhooks request",
+		"namespace", req.Namespace,
+		"event", req.Event,
+		"active_only", req.ActiveOnly,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	// Get webhooks from repository
+	registrations, err := s.webhookRepo.ListWebhooks(ctx, req.Namespace, req.ActiveOnly)
+	if err != nil {
+		s.logger.Error("Failed to list webhooks",
+			"namespace", req.Namespace,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to list webhooks: %v", err)
+	}
+
+	// Filter by event if specified
+	var filteredRegistrations []*webhooks.WebhookRegistration
 	if req.Event != "" {
 		for _, reg := range registrations {
 			// Check if the webhook listens to the requested event
@@ -399,18 +891,7 @@ func (s *WebhookServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRe
 	// Convert to protobuf format
 	pbWebhooks := make([]*pb.RegisteredWebhook, len(filteredRegistrations))
 	for i, reg := range filteredRegistrations {
-		pbWebhooks[i] = &pb.RegisteredWebhook{
-			WebhookId:   reg.ID,
-			Namespace:   reg.Namespace,
-			Events:      reg.Events,
-			Url:         reg.URL,
-			Headers:     reg.Headers,
-			Timeout:     int32(reg.Timeout),
-			Active:      reg.Active,
-			Description: reg.Description,
-			CreatedAt:   reg.CreatedAt.Unix(),
-			UpdatedAt:   reg.UpdatedAt.Unix(),
-		}
+		pbWebhooks[i] = convertRegisteredWebhook(reg)
 	}
 
 	s.logger.Info("Listed webhooks successfully",
@@ -426,6 +907,1019 @@ func (s *WebhookServer) ListWebhooks(ctx context.Context, req *pb.ListWebhooksRe
 	}, nil
 }
 
+// ListEvents returns stored events for a namespace, newest first
+func (s *WebhookServer) ListEvents(ctx context.Context, req *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	s.logger.Info("Received list events request",
+		"namespace", req.Namespace,
+		"event", req.Event,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	filter, err := eventListFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	events, err := s.webhookRepo.ListEvents(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list events",
+			"namespace", req.Namespace,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to list events: %v", err)
+	}
+
+	pbEvents := make([]*pb.StoredEvent, len(events))
+	for i, e := range events {
+		pbEvents[i] = convertStoredEvent(e)
+	}
+
+	var nextPageToken string
+	if len(events) == filter.Limit {
+		nextPageToken = webhooks.EncodeEventCursor(events[len(events)-1].EventRecord)
+	}
+
+	return &pb.ListEventsResponse{
+		Events:        pbEvents,
+		NextPageToken: nextPageToken,
+		Success:       true,
+		Message:       fmt.Sprintf("Found %d events", len(pbEvents)),
+	}, nil
+}
+
+// ListEventFailures returns a namespace's event processing failures, newest first
+func (s *WebhookServer) ListEventFailures(ctx context.Context, req *pb.ListEventFailuresRequest) (*pb.ListEventFailuresResponse, error) {
+	s.logger.Info("Received list event failures request", "namespace", req.Namespace)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	filter, err := eventFailureListFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	failures, err := s.webhookRepo.ListEventFailures(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list event failures",
+			"namespace", req.Namespace,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to list event failures: %v", err)
+	}
+
+	pbFailures := make([]*pb.EventProcessingFailure, len(failures))
+	for i, f := range failures {
+		pbFailures[i] = convertEventFailure(f)
+	}
+
+	var nextPageToken string
+	if len(failures) == filter.Limit {
+		nextPageToken = webhooks.EncodeEventFailureCursor(failures[len(failures)-1])
+	}
+
+	return &pb.ListEventFailuresResponse{
+		Failures:      pbFailures,
+		NextPageToken: nextPageToken,
+		Success:       true,
+		Message:       fmt.Sprintf("Found %d event failures", len(pbFailures)),
+	}, nil
+}
+
+// ListDeliveries returns deliveries to a namespace's webhooks, newest first
+func (s *WebhookServer) ListDeliveries(ctx context.Context, req *pb.ListDeliveriesRequest) (*pb.ListDeliveriesResponse, error) {
+	s.logger.Info("Received list deliveries request",
+		"namespace", req.Namespace,
+		"status", req.Status,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	filter, err := deliveryListFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	deliveries, err := s.webhookRepo.ListDeliveries(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list deliveries",
+			"namespace", req.Namespace,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to list deliveries: %v", err)
+	}
+
+	pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+	for i, d := range deliveries {
+		pbDeliveries[i] = convertDelivery(d)
+	}
+
+	var nextPageToken string
+	if len(deliveries) == filter.Limit {
+		nextPageToken = webhooks.EncodeDeliveryCursor(deliveries[len(deliveries)-1])
+	}
+
+	return &pb.ListDeliveriesResponse{
+		Deliveries:    pbDeliveries,
+		NextPageToken: nextPageToken,
+		Success:       true,
+		Message:       fmt.Sprintf("Found %d deliveries", len(pbDeliveries)),
+	}, nil
+}
+
+// ReplayEvents delivers stored events again to the webhooks currently registered for them
+func (s *WebhookServer) ReplayEvents(ctx context.Context, req *pb.ReplayEventsRequest) (*pb.ReplayEventsResponse, error) {
+	s.logger.Info("Received replay events request",
+		"namespace", req.Namespace,
+		"event", req.Event,
+		"since", req.Since,
+		"until", req.Until,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+	if req.Event == "" {
+		return nil, status.Error(codes.InvalidArgument, "event is required")
+	}
+
+	replayed, err := s.queueManager.ReplayEvents(ctx, replayFilter(req))
+	if err != nil {
+		s.logger.Error("Failed to replay events",
+			"namespace", req.Namespace,
+			"event", req.Event,
+			"deliveries_scheduled", replayed.Scheduled,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to replay events: %v", err)
+	}
+
+	s.logger.Info("Events replayed",
+		"namespace", req.Namespace,
+		"event", req.Event,
+		"events_replayed", replayed.Events,
+		"events_expired", replayed.Expired,
+		"deliveries_scheduled", replayed.Scheduled,
+	)
+
+	return &pb.ReplayEventsResponse{
+		EventsReplayed:      int32(replayed.Events),
+		EventsExpired:       int32(replayed.Expired),
+		DeliveriesScheduled: int32(replayed.Scheduled),
+		Success:             true,
+		Message:             fmt.Sprintf("Scheduled %d deliveries for %d events", replayed.Scheduled, replayed.Events),
+	}, nil
+}
+
+// GetDeliveryStats aggregates a namespace's deliveries over a time range
+func (s *WebhookServer) GetDeliveryStats(ctx context.Context, req *pb.GetDeliveryStatsRequest) (*pb.GetDeliveryStatsResponse, error) {
+	s.logger.Info("Received delivery stats request",
+		"namespace", req.Namespace,
+		"since", req.Since,
+		"until", req.Until,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	since, until, err := deliveryStatsRange(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	stats, err := s.webhookRepo.GetDeliveryStats(ctx, req.Namespace, since, until)
+	if err != nil {
+		s.logger.Error("Failed to get delivery stats",
+			"namespace", req.Namespace,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to get delivery stats: %v", err)
+	}
+
+	pbEvents := make([]*pb.EventDeliveryStats, len(stats.Events))
+	for i, e := range stats.Events {
+		pbEvents[i] = &pb.EventDeliveryStats{
+			Event: e.Event,
+			Stats: convertDeliveryStats(&e.DeliveryStats),
+		}
+	}
+
+	return &pb.GetDeliveryStatsResponse{
+		Totals:  convertDeliveryStats(&stats.Totals),
+		Events:  pbEvents,
+		Since:   since.Unix(),
+		Until:   until.Unix(),
+		Success: true,
+		Message: fmt.Sprintf("Found %d deliveries", stats.Totals.Total),
+	}, nil
+}
+
+// ListEventTypes lists the event names a namespace uses
+func (s *WebhookServer) ListEventTypes(ctx context.Context, req *pb.ListEventTypesRequest) (*pb.ListEventTypesResponse, error) {
+	s.logger.Info("Received list event types request",
+		"namespace", req.Namespace,
+		"since", req.Since,
+		"until", req.Until,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	types, err := s.webhookRepo.ListEventTypes(ctx, eventTypeListFilter(req))
+	if err != nil {
+		s.logger.Error("Failed to list event types",
+			"namespace", req.Namespace,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to list event types: %v", err)
+	}
+
+	return &pb.ListEventTypesResponse{
+		EventTypes: convertEventTypes(types),
+		Success:    true,
+		Message:    fmt.Sprintf("Found %d event types", len(types)),
+	}, nil
+}
+
+// GetWebhook returns a single webhook registration
+func (s *WebhookServer) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	s.logger.Info("Received get webhook request",
+		"webhook_id", req.WebhookId,
+	)
+
+	if req.WebhookId == "" {
+		return nil, status.Error(codes.InvalidArgument, "webhook_id is required")
+	}
+
+	registration, err := s.webhookRepo.GetWebhookByID(ctx, req.WebhookId)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "webhook not found")
+		}
+		s.logger.Error("Failed to get webhook",
+			"webhook_id", req.WebhookId,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to get webhook: %v", err)
+	}
+
+	response := &pb.GetWebhookResponse{
+		Webhook: convertRegisteredWebhook(registration),
+		Success: true,
+		Message: "Webhook found",
+	}
+
+	// Health is informational; the webhook is still returned without it
+	health, err := s.queueManager.WebhookHealth(ctx, req.WebhookId)
+	if err != nil {
+		s.logger.Warn("Failed to get webhook health",
+			"webhook_id", req.WebhookId,
+			"error", err,
+		)
+	} else {
+		response.Health = convertWebhookHealth(health)
+	}
+
+	return response, nil
+}
+
+// GetDelivery returns a single delivery
+func (s *WebhookServer) GetDelivery(ctx context.Context, req *pb.GetDeliveryRequest) (*pb.GetDeliveryResponse, error) {
+	s.logger.Info("Received get delivery request",
+		"delivery_id", req.DeliveryId,
+	)
+
+	if req.DeliveryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "delivery_id is required")
+	}
+
+	delivery, err := s.webhookRepo.GetDeliveryByID(ctx, req.DeliveryId)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "delivery not found")
+		}
+		s.logger.Error("Failed to get delivery",
+			"delivery_id", req.DeliveryId,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to get delivery: %v", err)
+	}
+
+	pbDelivery := convertDelivery(delivery)
+	if req.IncludeHeaders {
+		pbDelivery.RequestHeaders = delivery.RequestHeaders
+		pbDelivery.ResponseHeaders = delivery.ResponseHeaders
+	}
+
+	return &pb.GetDeliveryResponse{
+		Delivery: pbDelivery,
+		Success:  true,
+		Message:  "Delivery found",
+	}, nil
+}
+
+// GetEvent returns a single event with a summary of its deliveries
+func (s *WebhookServer) GetEvent(ctx context.Context, req *pb.GetEventRequest) (*pb.GetEventResponse, error) {
+	s.logger.Info("Received get event request",
+		"event_id", req.EventId,
+	)
+
+	if req.EventId == "" {
+		return nil, status.Error(codes.InvalidArgument, "event_id is required")
+	}
+
+	event, err := s.webhookRepo.GetEvent(ctx, req.EventId)
+	if err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "event not found")
+		}
+		s.logger.Error("Failed to get event",
+			"event_id", req.EventId,
+			"error", err,
+		)
+		return nil, status.Errorf(codes.Internal, "failed to get event: %v", err)
+	}
+
+	return &pb.GetEventResponse{
+		Event:   convertStoredEvent(&webhooks.ListedEvent{EventRecord: event, WebhookCount: event.Deliveries.Total}),
+		Success: true,
+		Message: "Event found",
+	}, nil
+}
+
+// CreateAPIKey creates an API key scoped to a set of namespaces
+func (s *WebhookServer) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.CreateAPIKeyResponse, error) {
+	s.logger.Info("Received API key creation request",
+		"namespaces", req.Namespaces,
+	)
+
+	if len(req.Namespaces) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one namespace is required")
+	}
+	for _, namespace := range req.Namespaces {
+		if namespace == "" {
+			return nil, status.Error(codes.InvalidArgument, "namespace names cannot be empty")
+		}
+	}
+
+	plainKey, keyHash, err := auth.GenerateAPIKey()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create API key: %v", err)
+	}
+
+	apiKey := &webhooks.APIKey{
+		KeyHash:     keyHash,
+		Namespaces:  req.Namespaces,
+		Description: req.Description,
+	}
+	if err := s.webhookRepo.CreateAPIKey(ctx, apiKey); err != nil {
+		s.logger.Error("Failed to create API key", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create API key: %v", err)
+	}
+
+	s.logger.Info("API key created successfully",
+		"key_id", apiKey.ID,
+		"namespaces", apiKey.Namespaces,
+	)
+
+	return &pb.CreateAPIKeyResponse{
+		KeyId:      apiKey.ID,
+		ApiKey:     plainKey,
+		Namespaces: apiKey.Namespaces,
+		CreatedAt:  apiKey.CreatedAt.Unix(),
+		Success:    true,
+		Message:    "API key created successfully",
+	}, nil
+}
+
+// RevokeAPIKey revokes an API key
+func (s *WebhookServer) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*pb.RevokeAPIKeyResponse, error) {
+	s.logger.Info("Received API key revocation request",
+		"key_id", req.KeyId,
+	)
+
+	if req.KeyId == "" {
+		return nil, status.Error(codes.InvalidArgument, "key_id is required")
+	}
+
+	if err := s.webhookRepo.RevokeAPIKey(ctx, req.KeyId); err != nil {
+		if errors.Is(err, webhooks.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "API key not found")
+		}
+		s.logger.Error("Failed to revoke API key", "key_id", req.KeyId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to revoke API key: %v", err)
+	}
+
+	s.logger.Info("API key revoked successfully", "key_id", req.KeyId)
+
+	return &pb.RevokeAPIKeyResponse{
+		Success: true,
+		Message: "API key revoked successfully",
+	}, nil
+}
+
+// CreateNamespace registers a namespace
+func (s *WebhookServer) CreateNamespace(ctx context.Context, req *pb.CreateNamespaceRequest) (*pb.CreateNamespaceResponse, error) {
+	s.logger.Info("Received namespace creation request", "namespace", req.Name)
+
+	if req.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+
+	namespace := &webhooks.Namespace{
+		Name:        req.Name,
+		Description: req.Description,
+	}
+	if err := s.webhookRepo.CreateNamespace(ctx, namespace); err != nil {
+		if errors.Is(err, webhooks.ErrNamespaceExists) {
+			return nil, status.Errorf(codes.AlreadyExists, "namespace %q already exists", req.Name)
+		}
+		s.logger.Error("Failed to create namespace", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to create namespace: %v", err)
+	}
+
+	s.logger.Info("Namespace created successfully", "namespace", namespace.Name)
+
+	return &pb.CreateNamespaceResponse{
+		Name:      namespace.Name,
+		CreatedAt: namespace.CreatedAt.Unix(),
+		Success:   true,
+		Message:   "Namespace created successfully",
+	}, nil
+}
+
+// SetNamespaceDefaults sets the headers and timeout a namespace's webhooks inherit
+func (s *WebhookServer) SetNamespaceDefaults(ctx context.Context, req *pb.SetNamespaceDefaultsRequest) (*pb.SetNamespaceDefaultsResponse, error) {
+	s.logger.Info("Received namespace defaults update request",
+		"namespace", req.Namespace,
+		"timeout", req.Timeout,
+	)
+
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+	maxTimeout := int32(s.maxDeliveryTimeout / time.Second)
+	if req.Timeout < 0 || req.Timeout > maxTimeout {
+		return nil, status.Errorf(codes.InvalidArgument, "timeout must be between 0 and %d seconds", maxTimeout)
+	}
+	if err := webhooks.ValidateHeaderTemplates(req.Headers); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.checkNamespace(ctx, req.Namespace); err != nil {
+		return nil, err
+	}
+
+	defaults := &webhooks.NamespaceDefaults{
+		Headers: req.Headers,
+		Timeout: int(req.Timeout),
+	}
+	if err := s.webhookRepo.SetNamespaceDefaults(ctx, req.Namespace, defaults); err != nil {
+		if errors.Is(err, webhooks.ErrNamespaceNotFound) {
+			return nil, status.Errorf(codes.FailedPrecondition, "namespace %q does not exist", req.Namespace)
+		}
+		s.logger.Error("Failed to set namespace defaults", "namespace", req.Namespace, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to set namespace defaults: %v", err)
+	}
+
+	s.logger.Info("Namespace defaults updated successfully", "namespace", req.Namespace)
+
+	return &pb.SetNamespaceDefaultsResponse{
+		Success: true,
+		Message: "Namespace defaults updated successfully",
+	}, nil
+}
+
+// GetNamespaceDefaults returns the headers and timeout a namespace's webhooks inherit
+func (s *WebhookServer) GetNamespaceDefaults(ctx context.Context, req *pb.GetNamespaceDefaultsRequest) (*pb.GetNamespaceDefaultsResponse, error) {
+	if req.Namespace == "" {
+		return nil, status.Error(codes.InvalidArgument, "namespace is required")
+	}
+
+	defaults, err := s.webhookRepo.GetNamespaceDefaults(ctx, req.Namespace)
+	if err != nil {
+		s.logger.Error("Failed to get namespace defaults", "namespace", req.Namespace, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to get namespace defaults: %v", err)
+	}
+
+	return &pb.GetNamespaceDefaultsResponse{
+		Namespace: req.Namespace,
+		Headers:   defaults.Headers,
+		Timeout:   int32(defaults.Timeout),
+		Success:   true,
+		Message:   "Namespace defaults retrieved successfully",
+	}, nil
+}
+
+// ExtendDeliveryTTL gives an expired delivery a new expiry and schedules it again
+func (s *WebhookServer) ExtendDeliveryTTL(ctx context.Context, req *pb.ExtendDeliveryTTLRequest) (*pb.ExtendDeliveryTTLResponse, error) {
+	s.logger.Info("Received delivery TTL extension request", "delivery_id", req.DeliveryId, "ttl_seconds", req.TtlSeconds)
+
+	if req.DeliveryId == "" {
+		return nil, status.Error(codes.InvalidArgument, "delivery_id is required")
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl <= 0 || ttl > maxDeliveryTTLExtension {
+		return nil, status.Errorf(codes.InvalidArgument, "ttl_seconds must be between 1 and %d", int64(maxDeliveryTTLExtension.Seconds()))
+	}
+
+	delivery, err := s.queueManager.ExtendDeliveryTTL(ctx, req.DeliveryId, time.Now().Add(ttl))
+	switch {
+	case errors.Is(err, webhooks.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "delivery %q not found", req.DeliveryId)
+	case errors.Is(err, webhooks.ErrDeliveryNotExpired), errors.Is(err, webhooks.ErrDeliveryJobNotFound):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		s.logger.Error("Failed to extend delivery TTL", "delivery_id", req.DeliveryId, "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to extend delivery TTL: %v", err)
+	}
+
+	s.logger.Info("Delivery rescheduled with extended TTL", "delivery_id", delivery.ID, "expires_at", delivery.ExpiresAt)
+
+	return &pb.ExtendDeliveryTTLResponse{
+		Delivery: convertDelivery(delivery),
+		Success:  true,
+		Message:  "Delivery rescheduled",
+	}, nil
+}
+
+// ListAllWebhooks pages through webhooks across all namespaces
+func (s *WebhookServer) ListAllWebhooks(ctx context.Context, req *pb.ListAllWebhooksRequest) (*pb.ListAllWebhooksResponse, error) {
+	s.logger.Info("Received list all webhooks request",
+		"active_only", req.ActiveOnly,
+		"url_contains", req.UrlContains,
+	)
+
+	filter, err := webhookListFilter(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	registrations, err := s.webhookRepo.ListAllWebhooks(ctx, filter)
+	if err != nil {
+		s.logger.Error("Failed to list all webhooks", "error", err)
+		return nil, status.Errorf(codes.Internal, "failed to list webhooks: %v", err)
+	}
+
+	pbWebhooks := make([]*pb.RegisteredWebhook, len(registrations))
+	for i, reg := range registrations {
+		pbWebhooks[i] = convertRegisteredWebhook(reg)
+	}
+
+	var nextPageToken string
+	if len(registrations) == filter.Limit {
+		nextPageToken = webhooks.EncodeWebhookCursor(registrations[len(registrations)-1])
+	}
+
+	return &pb.ListAllWebhooksResponse{
+		Webhooks:      pbWebhooks,
+		NextPageToken: nextPageToken,
+		Success:       true,
+		Message:       fmt.Sprintf("Found %d webhooks", len(pbWebhooks)),
+	}, nil
+}
+
+// defaultTimeout returns the timeout of a webhook registered without one: its namespace's
+// default timeout if set, otherwise the server's, within the server's ceiling
+func (s *WebhookServer) defaultTimeout(ctx context.Context, namespace string) (int32, error) {
+	defaults, err := s.webhookRepo.GetNamespaceDefaults(ctx, namespace)
+	if err != nil {
+		return 0, err
+	}
+	maxTimeout := int32(s.maxDeliveryTimeout / time.Second)
+	if defaults.Timeout > 0 {
+		return min(int32(defaults.Timeout), maxTimeout), nil
+	}
+	return min(defaultWebhookTimeoutSeconds, maxTimeout), nil
+}
+
+// checkNamespace rejects namespaces that haven't been created when strict namespaces are
+// enabled, and registers new ones otherwise
+func (s *WebhookServer) checkNamespace(ctx context.Context, namespace string) error {
+	err := s.webhookRepo.EnsureNamespace(ctx, namespace)
+	if errors.Is(err, webhooks.ErrNamespaceNotFound) {
+		return status.Errorf(codes.FailedPrecondition, "namespace %q does not exist", namespace)
+	}
+	if err != nil {
+		return status.Errorf(codes.Internal, "failed to check namespace: %v", err)
+	}
+	return nil
+}
+
+// eventDeliverAt returns when an event should be delivered; zero means immediately
+func eventDeliverAt(req *pb.PushEventRequest) time.Time {
+	if req.DeliverAt <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(req.DeliverAt, 0)
+}
+
+// eventInsertOpts returns the insert options for an event's processing job, scheduling it
+// for the event's deliver_at when that's in the future
+func eventInsertOpts(req *pb.PushEventRequest) *river.InsertOpts {
+	opts := &river.InsertOpts{
+		Queue:    "events",
+		Priority: webhooks.ResolvePriority(int(req.Priority), 0),
+	}
+	if deliverAt := eventDeliverAt(req); deliverAt.After(time.Now()) {
+		opts.ScheduledAt = deliverAt
+	}
+	return opts
+}
+
+// convertRegisteredWebhook converts a webhook registration to its protobuf form
+func convertRegisteredWebhook(reg *webhooks.WebhookRegistration) *pb.RegisteredWebhook {
+	webhook := &pb.RegisteredWebhook{
+		WebhookId:   reg.ID,
+		Namespace:   reg.Namespace,
+		Events:      reg.Events,
+		Url:         reg.URL,
+		Headers:     reg.Headers,
+		Timeout:     int32(reg.Timeout),
+		Active:      reg.Active,
+		Description: reg.Description,
+		CreatedAt:   reg.CreatedAt.Unix(),
+		UpdatedAt:   reg.UpdatedAt.Unix(),
+		MaxAttempts: int32(reg.MaxAttempts),
+		ContentType: reg.ContentType,
+
+		MaxStoredResponseBytes:  int32(reg.MaxStoredResponseBytes),
+		DisableTracePropagation: reg.DisableTracePropagation,
+		Ordered:                 reg.Ordered,
+		IncludeFields:           reg.IncludeFields,
+		ExcludeFields:           reg.ExcludeFields,
+		FallbackUrls:            reg.FallbackURLs,
+		SuccessBodyMatcher:      convertSuccessBodyMatcher(reg.SuccessBodyMatcher),
+		AuthType:                convertAuthType(reg.AuthType),
+		MaxInFlight:             int32(reg.MaxInFlight),
+		CompressBody:            reg.CompressBody,
+		BatchWindowMs:           int32(reg.BatchWindowMs),
+		BatchMaxSize:            int32(reg.BatchMaxSize),
+		RetryScheduleSeconds:    retryScheduleToProto(reg.RetrySchedule),
+		QueryParams:             reg.QueryParams,
+		MetadataFilter:          reg.MetadataFilter,
+		Signed:                  reg.Signed,
+		BypassProxy:             reg.BypassProxy,
+		Priority:                int32(reg.Priority),
+	}
+	if reg.ExpiresAt != nil {
+		webhook.ExpiresAt = reg.ExpiresAt.Unix()
+	}
+	return webhook
+}
+
+// convertWebhookHealth converts a webhook's health score to protobuf format
+func convertWebhookHealth(score webhooks.HealthScore) *pb.WebhookHealth {
+	health := &pb.WebhookHealth{
+		SuccessRate: score.SuccessRate,
+		Attempts:    score.Attempts,
+	}
+	if score.DisabledAt != nil {
+		health.DisabledAt = score.DisabledAt.Unix()
+	}
+	return health
+}
+
+// retryScheduleToProto converts a webhook's retry delays to protobuf format
+func retryScheduleToProto(schedule []int) []int32 {
+	if len(schedule) == 0 {
+		return nil
+	}
+	seconds := make([]int32, len(schedule))
+	for i, s := range schedule {
+		seconds[i] = int32(s)
+	}
+	return seconds
+}
+
+// convertDeliveryGroups converts grouped deliveries to protobuf, one group per ID in order
+func convertDeliveryGroups(ids []string, groups map[string][]*webhooks.WebhookDelivery, includeHeaders bool) []*pb.DeliveryStatusGroup {
+	pbGroups := make([]*pb.DeliveryStatusGroup, len(ids))
+	for i, id := range ids {
+		deliveries := groups[id]
+		pbDeliveries := make([]*pb.WebhookDelivery, len(deliveries))
+		for j, d := range deliveries {
+			pbDeliveries[j] = convertDelivery(d)
+			if includeHeaders {
+				pbDeliveries[j].RequestHeaders = d.RequestHeaders
+				pbDeliveries[j].ResponseHeaders = d.ResponseHeaders
+			}
+		}
+		pbGroups[i] = &pb.DeliveryStatusGroup{Id: id, Deliveries: pbDeliveries}
+	}
+	return pbGroups
+}
+
+// convertDelivery converts a webhook delivery to its protobuf form
+func convertDelivery(d *webhooks.WebhookDelivery) *pb.WebhookDelivery {
+	delivery := &pb.WebhookDelivery{
+		DeliveryId:   d.ID,
+		WebhookId:    d.WebhookID,
+		EventId:      d.EventID,
+		Status:       convertDeliveryStatus(d.Status),
+		AttemptCount: int32(d.AttemptCount),
+		MaxAttempts:  int32(d.MaxAttempts),
+		CreatedAt:    d.CreatedAt.Unix(),
+		ExpiresAt:    d.ExpiresAt.Unix(),
+		ResponseCode: int32(d.ResponseCode),
+		ResponseBody: d.ResponseBody,
+		ErrorMessage: d.ErrorMessage,
+
+		ResponseContentType: d.ResponseContentType,
+		ResponseUrl:         d.ResponseURL,
+		FailureReason:       convertFailureReason(d.FailureReason),
+		ResponseTruncated:   d.ResponseTruncated,
+	}
+
+	if d.LastAttemptedAt != nil {
+		delivery.LastAttemptedAt = d.LastAttemptedAt.Unix()
+	}
+	if d.NextRetryAt != nil {
+		delivery.NextRetryAt = d.NextRetryAt.Unix()
+	}
+
+	return delivery
+}
+
+// convertStoredEvent converts a listed event record to its protobuf form
+func convertStoredEvent(e *webhooks.ListedEvent) *pb.StoredEvent {
+	event := &pb.StoredEvent{
+		EventId:      e.ID,
+		Namespace:    e.Namespace,
+		Event:        e.Event,
+		ContentType:  e.ContentType,
+		Metadata:     e.Metadata,
+		CreatedAt:    e.CreatedAt.Unix(),
+		ExpiresAt:    e.ExpiresAt.Unix(),
+		WebhookCount: int32(e.WebhookCount),
+		DeliverySummary: &pb.EventDeliverySummary{
+			TotalDeliveries: int32(e.Deliveries.Total),
+			Succeeded:       int32(e.Deliveries.Succeeded),
+			Failed:          int32(e.Deliveries.Failed),
+			Pending:         int32(e.Deliveries.Pending),
+		},
+	}
+	// A protobuf string must be UTF-8, so other payloads are returned as bytes
+	if webhooks.IsTextPayload(e.Payload) {
+		event.Payload = e.Payload
+	} else {
+		event.RawPayload = []byte(e.Payload)
+	}
+	return event
+}
+
+// deliveryStatsRange returns the [since, until) range of a GetDeliveryStats request
+func deliveryStatsRange(req *pb.GetDeliveryStatsRequest) (time.Time, time.Time, error) {
+	until := time.Now()
+	if req.Until > 0 {
+		until = time.Unix(req.Until, 0)
+	}
+	since := until.Add(-defaultDeliveryStatsRange)
+	if req.Since > 0 {
+		since = time.Unix(req.Since, 0)
+	}
+	if !since.Before(until) {
+		return since, until, fmt.Errorf("since must be before until")
+	}
+	return since, until, nil
+}
+
+// eventTypeListFilter builds a repository filter from a ListEventTypes request
+func eventTypeListFilter(req *pb.ListEventTypesRequest) webhooks.EventTypeListFilter {
+	filter := webhooks.EventTypeListFilter{Namespace: req.Namespace}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	return filter
+}
+
+// convertEventTypes converts a namespace's event names to protobuf
+func convertEventTypes(types []*webhooks.EventType) []*pb.EventType {
+	pbTypes := make([]*pb.EventType, len(types))
+	for i, t := range types {
+		pbTypes[i] = &pb.EventType{
+			Event:      t.Event,
+			Count:      t.Count,
+			Registered: t.Registered,
+		}
+		if t.LastSeenAt != nil {
+			pbTypes[i].LastSeenAt = t.LastSeenAt.Unix()
+		}
+	}
+	return pbTypes
+}
+
+// convertDeliveryStats converts aggregated delivery statistics to protobuf
+func convertDeliveryStats(stats *webhooks.DeliveryStats) *pb.DeliveryStats {
+	return &pb.DeliveryStats{
+		Total:        stats.Total,
+		Pending:      stats.Pending,
+		Sending:      stats.Sending,
+		Success:      stats.Success,
+		Failed:       stats.Failed,
+		Retrying:     stats.Retrying,
+		Expired:      stats.Expired,
+		SuccessRate:  stats.SuccessRate(),
+		LatencyP50Ms: stats.LatencyP50,
+		LatencyP95Ms: stats.LatencyP95,
+	}
+}
+
+// eventListFilter builds a repository filter from a ListEvents request
+func eventListFilter(req *pb.ListEventsRequest) (webhooks.EventListFilter, error) {
+	filter := webhooks.EventListFilter{
+		Namespace: req.Namespace,
+		Event:     req.Event,
+		Limit:     int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListEventsLimit
+	}
+	if filter.Limit > maxListEventsLimit {
+		filter.Limit = maxListEventsLimit
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	if req.PageToken != "" {
+		cursor, err := webhooks.DecodeEventCursor(req.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.Cursor = cursor
+	}
+	return filter, nil
+}
+
+// deliveryListFilter builds a repository filter from a ListDeliveries request
+func deliveryListFilter(req *pb.ListDeliveriesRequest) (webhooks.DeliveryListFilter, error) {
+	filter := webhooks.DeliveryListFilter{
+		Namespace: req.Namespace,
+		Status:    deliveryStatusFromProto(req.Status),
+		Limit:     int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListEventsLimit
+	}
+	if filter.Limit > maxListEventsLimit {
+		filter.Limit = maxListEventsLimit
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	if req.PageToken != "" {
+		cursor, err := webhooks.DecodeEventCursor(req.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.Cursor = cursor
+	}
+	return filter, nil
+}
+
+// eventFailureListFilter builds a repository filter from a ListEventFailures request
+func eventFailureListFilter(req *pb.ListEventFailuresRequest) (webhooks.EventFailureListFilter, error) {
+	filter := webhooks.EventFailureListFilter{
+		Namespace: req.Namespace,
+		Limit:     int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListEventsLimit
+	}
+	if filter.Limit > maxListEventsLimit {
+		filter.Limit = maxListEventsLimit
+	}
+	if req.PageToken != "" {
+		cursor, err := webhooks.DecodeEventCursor(req.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.Cursor = cursor
+	}
+	return filter, nil
+}
+
+// convertEventFailure converts an event processing failure to protobuf
+func convertEventFailure(f *webhooks.EventProcessingFailure) *pb.EventProcessingFailure {
+	return &pb.EventProcessingFailure{
+		Id:        f.ID,
+		EventId:   f.EventID,
+		Namespace: f.Namespace,
+		Event:     f.Event,
+		Error:     f.Error,
+		Attempts:  int32(f.Attempts),
+		FailedAt:  f.FailedAt.Unix(),
+	}
+}
+
+// replayFilter builds a repository filter selecting the events a ReplayEvents request replays
+func replayFilter(req *pb.ReplayEventsRequest) webhooks.EventListFilter {
+	filter := webhooks.EventListFilter{
+		Namespace: req.Namespace,
+		Event:     req.Event,
+	}
+	if req.Since > 0 {
+		filter.Since = time.Unix(req.Since, 0)
+	}
+	if req.Until > 0 {
+		filter.Until = time.Unix(req.Until, 0)
+	}
+	return filter
+}
+
+// webhookListFilter builds a repository filter from a ListAllWebhooks request
+func webhookListFilter(req *pb.ListAllWebhooksRequest) (webhooks.WebhookListFilter, error) {
+	filter := webhooks.WebhookListFilter{
+		ActiveOnly:  req.ActiveOnly,
+		URLContains: req.UrlContains,
+		Limit:       int(req.Limit),
+	}
+	if filter.Limit <= 0 {
+		filter.Limit = defaultListAllWebhooksLimit
+	}
+	if filter.Limit > maxListAllWebhooksLimit {
+		filter.Limit = maxListAllWebhooksLimit
+	}
+	if req.PageToken != "" {
+		afterID, err := webhooks.DecodeWebhookCursor(req.PageToken)
+		if err != nil {
+			return filter, err
+		}
+		filter.AfterID = afterID
+	}
+	return filter, nil
+}
+
+// convertSuccessBodyMatcher converts a stored success body matcher to protobuf format
+func convertSuccessBodyMatcher(m *webhooks.BodyMatcher) *pb.SuccessBodyMatcher {
+	if m == nil {
+		return nil
+	}
+	return &pb.SuccessBodyMatcher{
+		JsonPath:      m.JSONPath,
+		ExpectedValue: m.Expected,
+		Regex:         m.Regex,
+	}
+}
+
+// convertAuthType converts a stored auth type to protobuf format
+func convertAuthType(authType string) pb.WebhookAuthType {
+	switch authType {
+	case webhooks.AuthTypeBasic:
+		return pb.WebhookAuthType_AUTH_BASIC
+	case webhooks.AuthTypeBearer:
+		return pb.WebhookAuthType_AUTH_BEARER
+	default:
+		return pb.WebhookAuthType_AUTH_NONE
+	}
+}
+
+// failureReasons maps stored failure reasons to their protobuf values
+var failureReasons = map[webhooks.FailureReason]pb.DeliveryFailureReason{
+	webhooks.FailureDNS:               pb.DeliveryFailureReason_FAILURE_DNS_ERROR,
+	webhooks.FailureConnectionRefused: pb.DeliveryFailureReason_FAILURE_CONNECTION_REFUSED,
+	webhooks.FailureTLS:               pb.DeliveryFailureReason_FAILURE_TLS_ERROR,
+	webhooks.FailureTimeout:           pb.DeliveryFailureReason_FAILURE_TIMEOUT,
+	webhooks.FailureHTTP4xx:           pb.DeliveryFailureReason_FAILURE_HTTP_4XX,
+	webhooks.FailureHTTP5xx:           pb.DeliveryFailureReason_FAILURE_HTTP_5XX,
+	webhooks.FailureBodyMatch:         pb.DeliveryFailureReason_FAILURE_BODY_MATCH_FAILED,
+	webhooks.FailureHeaderTemplate:    pb.DeliveryFailureReason_FAILURE_HEADER_TEMPLATE_ERROR,
+	webhooks.FailurePortNotAllowed:    pb.DeliveryFailureReason_FAILURE_PORT_NOT_ALLOWED,
+	webhooks.FailureOther:             pb.DeliveryFailureReason_FAILURE_OTHER,
+}
+
+// convertFailureReason converts a stored failure reason to protobuf
+func convertFailureReason(reason webhooks.FailureReason) pb.DeliveryFailureReason {
+	return failureReasons[reason]
+}
+
+// failureReasonFromProto converts a protobuf failure reason to its stored form
+func failureReasonFromProto(reason pb.DeliveryFailureReason) webhooks.FailureReason {
+	for stored, value := range failureReasons {
+		if value == reason {
+			return stored
+		}
+	}
+	return webhooks.FailureNone
+}
+
 // Helper function to convert delivery status
 func convertDeliveryStatus(status webhooks.WebhookDeliveryStatus) pb.WebhookDeliveryStatus {
 	switch status {
@@ -445,3 +1939,21 @@ func convertDeliveryStatus(status webhooks.WebhookDeliveryStatus) pb.WebhookDeli
 		return pb.WebhookDeliveryStatus_DELIVERY_UNKNOWN
 	}
 }
+
+// deliveryStatusFromProto converts a protobuf status to the stored status; DELIVERY_UNKNOWN
+// maps to no status
+func deliveryStatusFromProto(status pb.WebhookDeliveryStatus) webhooks.WebhookDeliveryStatus {
+	for _, stored := range []webhooks.WebhookDeliveryStatus{
+		webhooks.StatusPending,
+		webhooks.StatusSending,
+		webhooks.StatusSuccess,
+		webhooks.StatusFailed,
+		webhooks.StatusRetrying,
+		webhooks.StatusExpired,
+	} {
+		if convertDeliveryStatus(stored) == status {
+			return stored
+		}
+	}
+	return ""
+}
@@ -0,0 +1,210 @@
+package grpc
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/sarathsp06/sparrow/internal/config"
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+	"github.com/sarathsp06/sparrow/internal/workers"
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+func TestRegisterPushStatus(t *testing.T) {
+	ctx := context.Background()
+	store := webhooks.NewMemoryStore()
+	server := NewWebhookServer(nil, store, 30*time.Second)
+
+	var received string
+	target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("X-Event")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer target.Close()
+
+	// Register
+	if _, err := server.RegisterWebhook(ctx, &pb.RegisterWebhookRequest{Namespace: "orders", Url: target.URL}); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("registering without events: %v, want InvalidArgument", err)
+	}
+	registered, err := server.RegisterWebhook(ctx, &pb.RegisterWebhookRequest{
+		Namespace: "orders",
+		Events:    []string{"order.created"},
+		Url:       target.URL,
+		Headers:   map[string]string{"X-Event": "{{.event}}"},
+		Active:    true,
+	})
+	if err != nil {
+		t.Fatalf("RegisterWebhook: %v", err)
+	}
+	listed, err := server.ListWebhooks(ctx, &pb.ListWebhooksRequest{Namespace: "orders"})
+	if err != nil || len(listed.Webhooks) != 1 || listed.Webhooks[0].WebhookId != registered.WebhookId {
+		t.Fatalf("ListWebhooks = %v, %v", listed, err)
+	}
+
+	// Push: process the event and run its delivery job as River would
+	cfg := &config.Config{
+		MaxStoredResponseBytes: 1024,
+		MaxDeliveryTimeout:     30 * time.Second,
+		HTTPBodyReadTimeout:    5 * time.Second,
+		WebhookHealthWindow:    time.Hour,
+	}
+	event := jobs.EventArgs{
+		EventID:    "evt-1",
+		Namespace:  "orders",
+		Event:      "order.created",
+		Payload:    `{"id": 1}`,
+		TTLSeconds: 3600,
+		CreatedAt:  time.Now(),
+	}
+	if err := store.StoreEvent(ctx, &webhooks.EventRecord{ID: event.EventID, Namespace: event.Namespace, Event: event.Event, Payload: string(event.Payload), TTL: event.TTLSeconds}); err != nil {
+		t.Fatal(err)
+	}
+	targets, err := store.GetWebhooksByEvent(ctx, "orders", "order.created")
+	if err != nil || len(targets) != 1 {
+		t.Fatalf("GetWebhooksByEvent = %v, %v", targets, err)
+	}
+	eventWorker := workers.NewEventProcessingWorker(store, nil, cfg.MaxDeliveryTimeout, 100, "")
+	delivery, args, _ := eventWorker.NewDelivery(ctx, event, targets[0], nil)
+	if created, err := store.CreateDelivery(ctx, delivery); !created || err != nil {
+		t.Fatalf("CreateDelivery = %v, %v", created, err)
+	}
+
+	deliveryWorker := workers.NewWebhookWorker(store, nil, cfg, workers.NewHTTPDeliverer(target.Client(), "sparrow-test"))
+	job := &river.Job[jobs.WebhookArgs]{JobRow: &rivertype.JobRow{ID: 1, Attempt: 1, MaxAttempts: 3}, Args: args}
+	if err := deliveryWorker.Work(ctx, job); err != nil {
+		t.Fatalf("Work: %v", err)
+	}
+	if received != "order.created" {
+		t.Errorf("target received X-Event %q", received)
+	}
+
+	// Status
+	resp, err := server.GetWebhookStatus(ctx, &pb.GetWebhookStatusRequest{
+		Identifier: &pb.GetWebhookStatusRequest_EventId{EventId: "evt-1"},
+	})
+	if err != nil {
+		t.Fatalf("GetWebhookStatus: %v", err)
+	}
+	if len(resp.Deliveries) != 1 {
+		t.Fatalf("got %d deliveries, want 1", len(resp.Deliveries))
+	}
+	if d := resp.Deliveries[0]; d.Status != pb.WebhookDeliveryStatus_DELIVERY_SUCCESS || d.ResponseCode != http.StatusNoContent || d.AttemptCount != 1 {
+		t.Errorf("delivery = %v", d)
+	}
+
+	// A single delivery by ID
+	got, err := server.GetDelivery(ctx, &pb.GetDeliveryRequest{DeliveryId: delivery.ID})
+	if err != nil {
+		t.Fatalf("GetDelivery: %v", err)
+	}
+	if got.Delivery.DeliveryId != delivery.ID || got.Delivery.Status != pb.WebhookDeliveryStatus_DELIVERY_SUCCESS {
+		t.Errorf("GetDelivery = %v", got.Delivery)
+	}
+	if _, err := server.GetDelivery(ctx, &pb.GetDeliveryRequest{DeliveryId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetDelivery of a missing delivery: %v, want NotFound", err)
+	}
+
+	// The event, with its delivery summary
+	gotEvent, err := server.GetEvent(ctx, &pb.GetEventRequest{EventId: "evt-1"})
+	if err != nil {
+		t.Fatalf("GetEvent: %v", err)
+	}
+	if s := gotEvent.Event.DeliverySummary; gotEvent.Event.Payload != `{"id": 1}` || s.TotalDeliveries != 1 || s.Succeeded != 1 || s.Failed != 0 || s.Pending != 0 {
+		t.Errorf("GetEvent = %v", gotEvent.Event)
+	}
+	if _, err := server.GetEvent(ctx, &pb.GetEventRequest{EventId: "missing"}); status.Code(err) != codes.NotFound {
+		t.Errorf("GetEvent of a missing event: %v, want NotFound", err)
+	}
+}
+
+func TestRegisterWebhookQuota(t *testing.T) {
+	ctx := context.Background()
+	store := webhooks.NewMemoryStore()
+	store.LimitWebhooksPerNamespace(1)
+	server := NewWebhookServer(nil, store, 30*time.Second)
+
+	register := func(namespace string) error {
+		_, err := server.RegisterWebhook(ctx, &pb.RegisterWebhookRequest{
+			Namespace: namespace,
+			Events:    []string{"order.created"},
+			Url:       "https://example.com/hook",
+			Active:    true,
+		})
+		return err
+	}
+
+	if err := register("free"); err != nil {
+		t.Fatalf("first registration: %v", err)
+	}
+	if err := register("free"); status.Code(err) != codes.ResourceExhausted {
+		t.Fatalf("registration over the quota: %v, want ResourceExhausted", err)
+	}
+
+	// A namespace's override replaces the server's limit
+	if err := register("paid"); err != nil {
+		t.Fatalf("first registration: %v", err)
+	}
+	if err := store.SetNamespaceMaxWebhooks("paid", 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := register("paid"); err != nil {
+		t.Errorf("registration within the override: %v", err)
+	}
+	if count, err := store.CountWebhooks(ctx, "paid"); err != nil || count != 2 {
+		t.Errorf("CountWebhooks = %d, %v, want 2", count, err)
+	}
+}
+
+func TestRegisterWebhookQuotaConcurrently(t *testing.T) {
+	ctx := context.Background()
+	store := webhooks.NewMemoryStore()
+	store.LimitWebhooksPerNamespace(3)
+	server := NewWebhookServer(nil, store, 30*time.Second)
+
+	// The namespace has no settings of its own, so nothing exists to lock but the quota itself
+	const attempts = 20
+	codesSeen := make(chan codes.Code, attempts)
+	var wg sync.WaitGroup
+	for range attempts {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := server.RegisterWebhook(ctx, &pb.RegisterWebhookRequest{
+				Namespace: "new",
+				Events:    []string{"order.created"},
+				Url:       "https://example.com/hook",
+				Active:    true,
+			})
+			codesSeen <- status.Code(err)
+		}()
+	}
+	wg.Wait()
+	close(codesSeen)
+
+	registered := 0
+	for code := range codesSeen {
+		switch code {
+		case codes.OK:
+			registered++
+		case codes.ResourceExhausted:
+		default:
+			t.Errorf("unexpected code %v", code)
+		}
+	}
+	if registered != 3 {
+		t.Errorf("%d concurrent registrations succeeded, want 3", registered)
+	}
+	if count, err := store.CountWebhooks(ctx, "new"); err != nil || count != 3 {
+		t.Errorf("CountWebhooks = %d, %v, want 3", count, err)
+	}
+}
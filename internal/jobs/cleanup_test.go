@@ -0,0 +1,11 @@
+package jobs
+
+import "testing"
+
+func TestCleanupArgsKind(t *testing.T) {
+	args := CleanupArgs{}
+
+	if args.Kind() != "cleanup" {
+		t.Errorf("Expected Kind() to return 'cleanup', got '%s'", args.Kind())
+	}
+}
@@ -0,0 +1,11 @@
+package jobs
+
+import "testing"
+
+func TestClearPreviousSecretArgsKind(t *testing.T) {
+	args := ClearPreviousSecretArgs{}
+
+	if args.Kind() != "clear_previous_secret" {
+		t.Errorf("Expected Kind() to return 'clear_previous_secret', got '%s'", args.Kind())
+	}
+}
@@ -0,0 +1,11 @@
+package jobs
+
+import "testing"
+
+func TestDeliveryCallbackArgsKind(t *testing.T) {
+	args := DeliveryCallbackArgs{}
+
+	if args.Kind() != "delivery_callback" {
+		t.Errorf("Expected Kind() to return 'delivery_callback', got '%s'", args.Kind())
+	}
+}
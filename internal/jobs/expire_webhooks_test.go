@@ -0,0 +1,11 @@
+package jobs
+
+import "testing"
+
+func TestExpireWebhooksArgsKind(t *testing.T) {
+	args := ExpireWebhooksArgs{}
+
+	if args.Kind() != "expire_webhooks" {
+		t.Errorf("Expected Kind() to return 'expire_webhooks', got '%s'", args.Kind())
+	}
+}
@@ -2,17 +2,25 @@ package jobs
 
 import (
 	"time"
+
+	"github.com/sarathsp06/sparrow/internal/webhooks"
 )
 
 // EventArgs represents an event processing job
 type EventArgs struct {
-	EventID    string            `json:"event_id"`
-	Namespace  string            `json:"namespace"`
-	Event      string            `json:"event"`
-	Payload    string            `json:"payload"`
-	TTLSeconds int64             `json:"ttl_seconds"`
-	Metadata   map[string]string `json:"metadata"`
-	CreatedAt  time.Time         `json:"created_at"`
+	EventID         string            `json:"event_id"`
+	Namespace       string            `json:"namespace"`
+	Event           string            `json:"event"`
+	Payload         Payload           `json:"payload"`
+	ContentType     string            `json:"content_type,omitempty"`
+	TTLSeconds      int64             `json:"ttl_seconds"`
+	TimeoutOverride int               `json:"timeout_override,omitempty"` // Seconds; 0 = use the webhook timeout
+	Priority        int               `json:"priority,omitempty"`         // River priority 1-4; 0 = default
+	Metadata        map[string]string `json:"metadata"`
+	CreatedAt       time.Time         `json:"created_at"`
+	DeliverAt       time.Time         `json:"deliver_at,omitzero"`     // Zero = deliver immediately
+	FanoutCursor    string            `json:"fanout_cursor,omitempty"` // Last webhook ID handled by an earlier fan-out batch
+	TraceContext    map[string]string `json:"trace_context,omitempty"` // Trace context of the push, so processing joins its trace
 }
 
 // Kind returns the job kind for River queue
@@ -22,16 +30,33 @@ func (EventArgs) Kind() string {
 
 // WebhookArgs represents a webhook delivery job
 type WebhookArgs struct {
-	DeliveryID string            `json:"delivery_id"`
-	WebhookID  string            `json:"webhook_id"`
-	EventID    string            `json:"event_id"`
-	URL        string            `json:"url"`
-	Headers    map[string]string `json:"headers"`
-	Payload    string            `json:"payload"`
-	Timeout    int               `json:"timeout"`
-	ExpiresAt  time.Time         `json:"expires_at"`
-	Namespace  string            `json:"namespace"`
-	Event      string            `json:"event"`
+	DeliveryID              string                `json:"delivery_id"`
+	WebhookID               string                `json:"webhook_id"`
+	EventID                 string                `json:"event_id"`
+	URL                     string                `json:"url"`
+	FallbackURLs            []string              `json:"fallback_urls,omitempty"`
+	Headers                 map[string]string     `json:"headers"`            // Values may be templates, rendered at delivery time
+	Metadata                map[string]string     `json:"metadata,omitempty"` // Event metadata, for header templates
+	Payload                 Payload               `json:"payload"`
+	ContentType             string                `json:"content_type,omitempty"`
+	Timeout                 int                   `json:"timeout"`
+	MaxResponseBytes        int                   `json:"max_response_bytes,omitempty"` // 0 = worker default
+	DisableTracePropagation bool                  `json:"disable_trace_propagation,omitempty"`
+	Ordered                 bool                  `json:"ordered,omitempty"`
+	IncludeFields           []string              `json:"include_fields,omitempty"`
+	ExcludeFields           []string              `json:"exclude_fields,omitempty"`
+	SuccessBodyMatcher      *webhooks.BodyMatcher `json:"success_body_matcher,omitempty"`
+	AuthType                string                `json:"auth_type,omitempty"`     // Credentials are loaded at delivery time, never stored in the job
+	Signed                  bool                  `json:"signed,omitempty"`        // Signing secrets are loaded at delivery time, like credentials
+	MaxInFlight             int                   `json:"max_in_flight,omitempty"` // 0 = unlimited
+	CompressBody            bool                  `json:"compress_body,omitempty"`
+	BypassProxy             bool                  `json:"bypass_proxy,omitempty"`
+	RetrySchedule           []int                 `json:"retry_schedule,omitempty"` // Seconds before each retry; default backoff after the last
+	QueryParams             map[string]string     `json:"query_params,omitempty"`   // Added to each delivery URL; values may be templates
+	ExpiresAt               time.Time             `json:"expires_at"`
+	Namespace               string                `json:"namespace"`
+	Event                   string                `json:"event"`
+	TraceContext            map[string]string     `json:"trace_context,omitempty"` // Trace context of the event processing that scheduled the delivery
 }
 
 // Kind returns the job kind for River queue
@@ -39,6 +64,71 @@ func (WebhookArgs) Kind() string {
 	return "webhook_delivery"
 }
 
+// DeliveryCallbackArgs represents a notification that a delivery reached a terminal state
+type DeliveryCallbackArgs struct {
+	URL        string `json:"url"`
+	DeliveryID string `json:"delivery_id"`
+	WebhookID  string `json:"webhook_id"`
+	EventID    string `json:"event_id"`
+	Namespace  string `json:"namespace"`
+	Event      string `json:"event"`
+	Status     string `json:"status"`
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Kind returns the job kind for River queue
+func (DeliveryCallbackArgs) Kind() string {
+	return "delivery_callback"
+}
+
+// CleanupArgs represents a periodic cleanup of expired events and old deliveries
+type CleanupArgs struct{}
+
+// Kind returns the job kind for River queue
+func (CleanupArgs) Kind() string {
+	return "cleanup"
+}
+
+// ReconcileDeliveriesArgs represents a periodic sweep for deliveries stuck in "sending"
+type ReconcileDeliveriesArgs struct{}
+
+// Kind returns the job kind for River queue
+func (ReconcileDeliveriesArgs) Kind() string {
+	return "reconcile_deliveries"
+}
+
+// ExpireWebhooksArgs represents a periodic sweep that deactivates expired webhooks
+type ExpireWebhooksArgs struct{}
+
+// Kind returns the job kind for River queue
+func (ExpireWebhooksArgs) Kind() string {
+	return "expire_webhooks"
+}
+
+// ClearPreviousSecretArgs represents a job, scheduled for the end of a secret rotation's
+// grace period, that removes the webhook's previous signing secret
+type ClearPreviousSecretArgs struct {
+	WebhookID string `json:"webhook_id"`
+}
+
+// Kind returns the job kind for River queue
+func (ClearPreviousSecretArgs) Kind() string {
+	return "clear_previous_secret"
+}
+
+// WebhookBatchArgs represents a job that sends a batching webhook's waiting deliveries as
+// one request
+type WebhookBatchArgs struct {
+	WebhookID string    `json:"webhook_id"`
+	FlushAt   time.Time `json:"flush_at"` // End of the batch window; jobs are unique per webhook and window
+}
+
+// Kind returns the job kind for River queue
+func (WebhookBatchArgs) Kind() string {
+	return "webhook_batch"
+}
+
 // DataProcessingArgs represents a data processing job (for compatibility)
 type DataProcessingArgs struct {
 	DataID   int    `json:"data_id"`
@@ -0,0 +1,39 @@
+package jobs
+
+import (
+	"encoding/json"
+
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+// Payload is an event payload carried in job args byte-for-byte. Text payloads are encoded
+// as JSON strings; others, which a JSON string can't hold exactly, as {"base64": "..."}.
+type Payload string
+
+// binaryPayload is the JSON form of a payload that isn't text
+type binaryPayload struct {
+	Base64 []byte `json:"base64"`
+}
+
+// MarshalJSON implements json.Marshaler
+func (p Payload) MarshalJSON() ([]byte, error) {
+	if webhooks.IsTextPayload(string(p)) {
+		return json.Marshal(string(p))
+	}
+	return json.Marshal(binaryPayload{Base64: []byte(p)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (p *Payload) UnmarshalJSON(data []byte) error {
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		*p = Payload(text)
+		return nil
+	}
+	var binary binaryPayload
+	if err := json.Unmarshal(data, &binary); err != nil {
+		return err
+	}
+	*p = Payload(binary.Base64)
+	return nil
+}
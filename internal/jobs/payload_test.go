@@ -0,0 +1,34 @@
+package jobs
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPayloadRoundTrip(t *testing.T) {
+	for _, payload := range []Payload{"", `{"id": 1}`, "plain text", "\xff\xfe binary", "nul\x00byte"} {
+		encoded, err := json.Marshal(EventArgs{Payload: payload})
+		if err != nil {
+			t.Fatalf("marshal %q: %v", payload, err)
+		}
+		var decoded EventArgs
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			t.Fatalf("unmarshal %s: %v", encoded, err)
+		}
+		if decoded.Payload != payload {
+			t.Errorf("got %q, want %q (encoded as %s)", decoded.Payload, payload, encoded)
+		}
+	}
+}
+
+func TestPayloadEncoding(t *testing.T) {
+	text, _ := json.Marshal(Payload(`{"id": 1}`))
+	if string(text) != `"{\"id\": 1}"` {
+		t.Errorf("text payload encoded as %s", text)
+	}
+
+	binary, _ := json.Marshal(Payload("\xff\x00"))
+	if string(binary) != `{"base64":"/wA="}` {
+		t.Errorf("binary payload encoded as %s", binary)
+	}
+}
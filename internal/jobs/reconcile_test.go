@@ -0,0 +1,11 @@
+package jobs
+
+import "testing"
+
+func TestReconcileDeliveriesArgsKind(t *testing.T) {
+	args := ReconcileDeliveriesArgs{}
+
+	if args.Kind() != "reconcile_deliveries" {
+		t.Errorf("Expected Kind() to return 'reconcile_deliveries', got '%s'", args.Kind())
+	}
+}
@@ -0,0 +1,11 @@
+package jobs
+
+import "testing"
+
+func TestWebhookBatchArgsKind(t *testing.T) {
+	args := WebhookBatchArgs{WebhookID: "wh-1"}
+
+	if args.Kind() != "webhook_batch" {
+		t.Errorf("Expected Kind() to return 'webhook_batch', got '%s'", args.Kind())
+	}
+}
@@ -1,6 +1,7 @@
 package logger
 
 import (
+	"fmt"
 	"log/slog"
 	"os"
 )
@@ -8,16 +9,41 @@ import (
 // Logger provides structured logging using slog
 var Logger *slog.Logger
 
+// level is shared by every handler, so SetLevel also affects loggers that already exist
+var level = new(slog.LevelVar)
+
 func init() {
-	// Create a structured logger with JSON output
+	// Default to JSON output at Info level until Configure is called
+	Logger = slog.New(newHandler("json"))
+}
+
+// newHandler builds a handler writing to stdout in the given format ("json" or "text")
+func newHandler(format string) slog.Handler {
 	opts := &slog.HandlerOptions{
-		Level:     slog.LevelInfo,
+		Level:     level,
 		AddSource: true,
 	}
 
-	// Use JSON handler for structured logging
-	handler := slog.NewJSONHandler(os.Stdout, opts)
-	Logger = slog.New(handler)
+	if format == "text" {
+		return slog.NewTextHandler(os.Stdout, opts)
+	}
+	return slog.NewJSONHandler(os.Stdout, opts)
+}
+
+// Configure sets the log level (debug, info, warn or error) and format (json or text).
+// Call it at startup: loggers created earlier keep the previous format.
+func Configure(levelName, format string) error {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(levelName)); err != nil {
+		return fmt.Errorf("invalid log level %q", levelName)
+	}
+	if format != "json" && format != "text" {
+		return fmt.Errorf("invalid log format %q, expected json or text", format)
+	}
+
+	level.Set(l)
+	Logger = slog.New(newHandler(format))
+	return nil
 }
 
 // NewLogger creates a new logger with the given name
@@ -26,10 +52,6 @@ func NewLogger(name string) *slog.Logger {
 }
 
 // SetLevel sets the logging level
-func SetLevel(level slog.Level) {
-	opts := &slog.HandlerOptions{
-		Level: level,
-	}
-	handler := slog.NewJSONHandler(os.Stdout, opts)
-	Logger = slog.New(handler)
+func SetLevel(l slog.Level) {
+	level.Set(l)
 }
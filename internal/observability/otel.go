@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"go.opentelemetry.io/otel"
@@ -23,12 +24,16 @@ type Config struct {
 	ServiceName    string
 	ServiceVersion string
 	Environment    string
-	OTLPEndpoint   string
+	OTLPEndpoint   string // host:port, or a URL whose scheme decides whether TLS is used
 	OTLPHeaders    map[string]string
+	OTLPInsecure   bool // Export over plain HTTP to a host:port endpoint
 	EnableTracing  bool
 	EnableMetrics  bool
 	SampleRate     float64 // 0.0 to 1.0
-	MetricInterval time.Duration
+
+	// NamespaceSampleRates overrides SampleRate for spans started with a namespace attribute
+	NamespaceSampleRates map[string]float64
+	MetricInterval       time.Duration
 }
 
 // DefaultConfig returns a default OpenTelemetry configuration
@@ -38,6 +43,7 @@ func DefaultConfig() *Config {
 		ServiceVersion: "1.0.0",
 		Environment:    "development",
 		OTLPEndpoint:   "localhost:4318", // Default OTLP HTTP endpoint
+		OTLPInsecure:   true,             // Local collectors usually don't serve TLS
 		EnableTracing:  true,
 		EnableMetrics:  true,
 		SampleRate:     1.0, // Sample all traces in development
@@ -45,7 +51,9 @@ func DefaultConfig() *Config {
 	}
 }
 
-// Setup initializes OpenTelemetry with the provided configuration
+// Setup initializes OpenTelemetry with the provided configuration. Disabled signals keep the
+// global no-op providers. The returned function flushes and shuts down the providers that
+// were set up; it is never nil, even when Setup fails.
 func Setup(ctx context.Context, config *Config) (func(context.Context) error, error) {
 	// Create resource with service information
 	res, err := resource.New(ctx,
@@ -56,16 +64,28 @@ func Setup(ctx context.Context, config *Config) (func(context.Context) error, er
 		),
 	)
 	if err != nil {
-		return nil, fmt.Errorf("failed to create resource: %w", err)
+		return noopShutdown, fmt.Errorf("failed to create resource: %w", err)
 	}
 
 	var shutdownFuncs []func(context.Context) error
+	shutdown := func(ctx context.Context) error {
+		var errs []error
+		for _, fn := range shutdownFuncs {
+			if err := fn(ctx); err != nil {
+				errs = append(errs, err)
+			}
+		}
+		if len(errs) > 0 {
+			return fmt.Errorf("failed to shutdown OpenTelemetry: %w", errors.Join(errs...))
+		}
+		return nil
+	}
 
 	// Setup tracing
 	if config.EnableTracing {
 		tracerProvider, err := setupTracing(ctx, res, config)
 		if err != nil {
-			return nil, fmt.Errorf("failed to setup tracing: %w", err)
+			return noopShutdown, fmt.Errorf("failed to setup tracing: %w", err)
 		}
 		shutdownFuncs = append(shutdownFuncs, tracerProvider.Shutdown)
 		otel.SetTracerProvider(tracerProvider)
@@ -75,7 +95,9 @@ func Setup(ctx context.Context, config *Config) (func(context.Context) error, er
 	if config.EnableMetrics {
 		meterProvider, err := setupMetrics(ctx, res, config)
 		if err != nil {
-			return nil, fmt.Errorf("failed to setup metrics: %w", err)
+			// Don't leave the tracer provider's exporter running
+			shutdown(ctx)
+			return noopShutdown, fmt.Errorf("failed to setup metrics: %w", err)
 		}
 		shutdownFuncs = append(shutdownFuncs, meterProvider.Shutdown)
 		otel.SetMeterProvider(meterProvider)
@@ -87,27 +109,23 @@ func Setup(ctx context.Context, config *Config) (func(context.Context) error, er
 		propagation.Baggage{},
 	))
 
-	// Return shutdown function
-	return func(ctx context.Context) error {
-		var errs []error
-		for _, fn := range shutdownFuncs {
-			if err := fn(ctx); err != nil {
-				errs = append(errs, err)
-			}
-		}
-		if len(errs) > 0 {
-			return fmt.Errorf("failed to shutdown OpenTelemetry: %w", errors.Join(errs...))
-		}
-		return nil
-	}, nil
+	return shutdown, nil
 }
 
+// noopShutdown is the shutdown function when nothing was set up
+func noopShutdown(context.Context) error { return nil }
+
 // setupTracing configures OpenTelemetry tracing
 func setupTracing(ctx context.Context, res *resource.Resource, config *Config) (*sdktrace.TracerProvider, error) {
 	// Create OTLP trace exporter
-	opts := []otlptracehttp.Option{
-		otlptracehttp.WithEndpoint("localhost:4318"),
-		otlptracehttp.WithInsecure(), // Use HTTP instead of HTTPS for local development
+	var opts []otlptracehttp.Option
+	if isEndpointURL(config.OTLPEndpoint) {
+		opts = append(opts, otlptracehttp.WithEndpointURL(config.OTLPEndpoint))
+	} else {
+		opts = append(opts, otlptracehttp.WithEndpoint(config.OTLPEndpoint))
+		if config.OTLPInsecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
 	}
 
 	if len(config.OTLPHeaders) > 0 {
@@ -119,15 +137,8 @@ func setupTracing(ctx context.Context, res *resource.Resource, config *Config) (
 		return nil, fmt.Errorf("failed to create OTLP trace exporter: %w", err)
 	}
 
-	// Configure sampler based on sample rate
-	var sampler sdktrace.Sampler
-	if config.SampleRate >= 1.0 {
-		sampler = sdktrace.AlwaysSample()
-	} else if config.SampleRate <= 0.0 {
-		sampler = sdktrace.NeverSample()
-	} else {
-		sampler = sdktrace.TraceIDRatioBased(config.SampleRate)
-	}
+	// Configure sampler based on the sample rates
+	sampler := newSampler(config.SampleRate, config.NamespaceSampleRates)
 
 	// Create tracer provider
 	tracerProvider := sdktrace.NewTracerProvider(
@@ -142,9 +153,14 @@ func setupTracing(ctx context.Context, res *resource.Resource, config *Config) (
 // setupMetrics configures OpenTelemetry metrics
 func setupMetrics(ctx context.Context, res *resource.Resource, config *Config) (*sdkmetric.MeterProvider, error) {
 	// Create OTLP metric exporter
-	opts := []otlpmetrichttp.Option{
-		otlpmetrichttp.WithEndpoint("localhost:4318"),
-		otlpmetrichttp.WithInsecure(), // Use HTTP instead of HTTPS for local development
+	var opts []otlpmetrichttp.Option
+	if isEndpointURL(config.OTLPEndpoint) {
+		opts = append(opts, otlpmetrichttp.WithEndpointURL(config.OTLPEndpoint))
+	} else {
+		opts = append(opts, otlpmetrichttp.WithEndpoint(config.OTLPEndpoint))
+		if config.OTLPInsecure {
+			opts = append(opts, otlpmetrichttp.WithInsecure())
+		}
 	}
 
 	if len(config.OTLPHeaders) > 0 {
@@ -166,11 +182,36 @@ func setupMetrics(ctx context.Context, res *resource.Resource, config *Config) (
 	return meterProvider, nil
 }
 
+// isEndpointURL reports whether an OTLP endpoint is a URL rather than a host:port
+func isEndpointURL(endpoint string) bool {
+	return strings.Contains(endpoint, "://")
+}
+
 // GetTracer returns a tracer for the given name
 func GetTracer(name string) trace.Tracer {
 	return otel.Tracer(name, trace.WithInstrumentationVersion("1.0.0"))
 }
 
+// InjectTraceContext returns the trace context of ctx in W3C form, for storing in job args
+// so the job's spans join the trace. It returns nil when ctx carries no span.
+func InjectTraceContext(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	if len(carrier) == 0 {
+		return nil
+	}
+	return carrier
+}
+
+// ExtractTraceContext returns ctx with the trace context stored by InjectTraceContext as
+// the remote parent of spans started from it
+func ExtractTraceContext(ctx context.Context, carrier map[string]string) context.Context {
+	if len(carrier) == 0 {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(carrier))
+}
+
 // GetMeter returns a meter for the given name
 func GetMeter(name string) metric.Meter {
 	return otel.Meter(name, metric.WithInstrumentationVersion("1.0.0"))
@@ -182,7 +223,10 @@ type SparrowMetrics struct {
 	EventsPushed         metric.Int64Counter
 	WebhookDeliveries    metric.Int64Counter
 	DeliveryDuration     metric.Float64Histogram
-	QueueDepth           metric.Int64UpDownCounter
+	ResponseStatusCode   metric.Int64Histogram
+	ResponseTruncated    metric.Int64Counter
+	SLOViolations        metric.Int64Counter
+	QueueDepth           metric.Int64Gauge
 	ActiveWebhooks       metric.Int64UpDownCounter
 }
 
@@ -223,9 +267,34 @@ func NewSparrowMetrics() (*SparrowMetrics, error) {
 		return nil, err
 	}
 
-	queueDepth, err := meter.Int64UpDownCounter(
+	responseStatusCode, err := meter.Int64Histogram(
+		"sparrow_webhook_response_status_code",
+		metric.WithDescription("HTTP status codes returned by webhook receivers"),
+		metric.WithExplicitBucketBoundaries(199, 299, 399, 499, 599),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	responseTruncated, err := meter.Int64Counter(
+		"sparrow_response_truncated_total",
+		metric.WithDescription("Total number of webhook responses whose body exceeded the capture limit"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	sloViolations, err := meter.Int64Counter(
+		"sparrow_webhook_slo_violations_total",
+		metric.WithDescription("Total number of webhook deliveries that took longer than the delivery SLO"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	queueDepth, err := meter.Int64Gauge(
 		"sparrow_queue_depth",
-		metric.WithDescription("Current depth of job queues"),
+		metric.WithDescription("Current number of jobs waiting to run per queue"),
 	)
 	if err != nil {
 		return nil, err
@@ -244,6 +313,9 @@ func NewSparrowMetrics() (*SparrowMetrics, error) {
 		EventsPushed:         eventsPushed,
 		WebhookDeliveries:    webhookDeliveries,
 		DeliveryDuration:     deliveryDuration,
+		ResponseStatusCode:   responseStatusCode,
+		ResponseTruncated:    responseTruncated,
+		SLOViolations:        sloViolations,
 		QueueDepth:           queueDepth,
 		ActiveWebhooks:       activeWebhooks,
 	}, nil
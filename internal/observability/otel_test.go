@@ -0,0 +1,51 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContextRoundTrip(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if carrier := InjectTraceContext(context.Background()); carrier != nil {
+		t.Errorf("Expected no trace context without a span, got %v", carrier)
+	}
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x4b, 0xf9, 0x2f, 0x35, 0x77, 0xb3, 0x4d, 0xa6, 0xa3, 0xce, 0x92, 0x9d, 0x0e, 0x0e, 0x47, 0x36},
+		SpanID:     trace.SpanID{0x00, 0xf0, 0x67, 0xaa, 0x0b, 0xa9, 0x02, 0xb7},
+		TraceFlags: trace.FlagsSampled,
+	})
+	carrier := InjectTraceContext(trace.ContextWithSpanContext(context.Background(), sc))
+	if carrier["traceparent"] == "" {
+		t.Fatalf("Expected a traceparent, got %v", carrier)
+	}
+
+	got := trace.SpanContextFromContext(ExtractTraceContext(context.Background(), carrier))
+	if got.TraceID() != sc.TraceID() || got.SpanID() != sc.SpanID() || !got.IsRemote() {
+		t.Errorf("Extracted span context %v, want remote %v", got, sc)
+	}
+}
+
+func TestSetupDisabledSignals(t *testing.T) {
+	tracerProvider, meterProvider := otel.GetTracerProvider(), otel.GetMeterProvider()
+
+	config := DefaultConfig()
+	config.EnableTracing = false
+	config.EnableMetrics = false
+	shutdown, err := Setup(context.Background(), config)
+	if err != nil {
+		t.Fatalf("Setup failed: %v", err)
+	}
+	if otel.GetTracerProvider() != tracerProvider || otel.GetMeterProvider() != meterProvider {
+		t.Error("Expected disabled signals to keep the global providers")
+	}
+	if err := shutdown(context.Background()); err != nil {
+		t.Errorf("shutdown() = %v, want nil", err)
+	}
+}
@@ -0,0 +1,77 @@
+package observability
+
+import (
+	"fmt"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// namespaceAttribute is the span attribute API spans record the request's namespace in
+const namespaceAttribute = "namespace"
+
+// newSampler returns the sampler for a global sample rate and per-namespace overrides.
+// Spans continuing a remote trace follow the caller's decision. Spans started with a
+// namespace attribute whose namespace has its own rate are sampled at that rate, even
+// under a local parent such as the server's request span; other spans follow their local
+// parent, and root spans are sampled at the global rate.
+func newSampler(rate float64, namespaceRates map[string]float64) sdktrace.Sampler {
+	sampler := &namespaceSampler{
+		fallback: ratioSampler(rate),
+		rates:    make(map[string]sdktrace.Sampler, len(namespaceRates)),
+	}
+	for namespace, rate := range namespaceRates {
+		sampler.rates[namespace] = ratioSampler(rate)
+	}
+	return sdktrace.ParentBased(sampler,
+		sdktrace.WithLocalParentSampled(sampler),
+		sdktrace.WithLocalParentNotSampled(sampler),
+	)
+}
+
+// ratioSampler samples the given fraction of traces
+func ratioSampler(rate float64) sdktrace.Sampler {
+	switch {
+	case rate >= 1.0:
+		return sdktrace.AlwaysSample()
+	case rate <= 0.0:
+		return sdktrace.NeverSample()
+	default:
+		return sdktrace.TraceIDRatioBased(rate)
+	}
+}
+
+// namespaceSampler samples spans by the namespace they were started with
+type namespaceSampler struct {
+	fallback sdktrace.Sampler            // Root spans without a namespace of their own rate
+	rates    map[string]sdktrace.Sampler // By namespace
+}
+
+// ShouldSample samples by the span's namespace if it has its own rate, otherwise as its
+// parent was, or at the global rate for a root span
+func (s *namespaceSampler) ShouldSample(p sdktrace.SamplingParameters) sdktrace.SamplingResult {
+	for _, attr := range p.Attributes {
+		if string(attr.Key) != namespaceAttribute {
+			continue
+		}
+		if sampler, ok := s.rates[attr.Value.AsString()]; ok {
+			return sampler.ShouldSample(p)
+		}
+		break
+	}
+
+	parent := trace.SpanContextFromContext(p.ParentContext)
+	if !parent.IsValid() {
+		return s.fallback.ShouldSample(p)
+	}
+	decision := sdktrace.Drop
+	if parent.IsSampled() {
+		decision = sdktrace.RecordAndSample
+	}
+	return sdktrace.SamplingResult{Decision: decision, Tracestate: parent.TraceState()}
+}
+
+// Description describes the sampler for debugging
+func (s *namespaceSampler) Description() string {
+	return fmt.Sprintf("NamespaceSampler{fallback:%s,namespaces:%d}", s.fallback.Description(), len(s.rates))
+}
@@ -0,0 +1,59 @@
+package observability
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestNamespaceSampler(t *testing.T) {
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSampler(newSampler(0, map[string]float64{
+		"payment":   1,
+		"analytics": 0,
+	})))
+	defer provider.Shutdown(context.Background())
+	tracer := provider.Tracer("test")
+
+	start := func(ctx context.Context, namespace string) (context.Context, bool) {
+		var opts []trace.SpanStartOption
+		if namespace != "" {
+			opts = append(opts, trace.WithAttributes(attribute.String("namespace", namespace)))
+		}
+		ctx, span := tracer.Start(ctx, "span", opts...)
+		defer span.End()
+		return ctx, span.SpanContext().IsSampled()
+	}
+
+	// Root spans: by namespace, otherwise the global rate
+	if _, sampled := start(context.Background(), ""); sampled {
+		t.Error("Expected a root span without a namespace to use the global rate of 0")
+	}
+	unsampled, _ := start(context.Background(), "")
+	paymentCtx, sampled := start(context.Background(), "payment")
+	if !sampled {
+		t.Error("Expected a root payment span to be sampled")
+	}
+
+	// Local children: a namespace with its own rate decides, otherwise the parent does
+	if _, sampled := start(unsampled, "payment"); !sampled {
+		t.Error("Expected a payment span under an unsampled request span to be sampled")
+	}
+	if _, sampled := start(paymentCtx, "analytics"); sampled {
+		t.Error("Expected an analytics span to be dropped even under a sampled parent")
+	}
+	if _, sampled := start(paymentCtx, "orders"); !sampled {
+		t.Error("Expected a span of a namespace without its own rate to follow its sampled parent")
+	}
+	if _, sampled := start(unsampled, "orders"); sampled {
+		t.Error("Expected a span of a namespace without its own rate to follow its unsampled parent")
+	}
+
+	// Remote parents: the caller decides
+	remote := trace.ContextWithRemoteSpanContext(context.Background(), trace.SpanContextFromContext(paymentCtx))
+	if _, sampled := start(remote, "analytics"); !sampled {
+		t.Error("Expected a span continuing a sampled remote trace to be sampled")
+	}
+}
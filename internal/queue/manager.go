@@ -2,15 +2,25 @@ package queue
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/riverqueue/river"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/riverqueue/river/rivertype"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	"github.com/sarathsp06/sparrow/internal/config"
 	"github.com/sarathsp06/sparrow/internal/jobs"
 	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/observability"
 	"github.com/sarathsp06/sparrow/internal/webhooks"
 	"github.com/sarathsp06/sparrow/internal/workers"
 )
@@ -20,12 +30,46 @@ type Manager struct {
 	client      *river.Client[pgx.Tx]
 	dbPool      *pgxpool.Pool
 	webhookRepo *webhooks.Repository
+	eventWorker *workers.EventProcessingWorker
+	metrics     *observability.SparrowMetrics
+	queues      []string
+
+	// webhookWorker makes synchronous deliveries, up to maxSynchronousWebhooks per event;
+	// a synchronous push waits up to synchronousPushTimeout for them
+	webhookWorker          *workers.WebhookWorker
+	maxSynchronousWebhooks int
+	synchronousPushTimeout time.Duration
+
+	// healthWindow is the rolling window webhook health is scored over
+	healthWindow time.Duration
+
+	// defaultEventTTL applies to events pushed without a TTL; maxEventTTL caps it (0 = unlimited)
+	defaultEventTTL int64
+	maxEventTTL     int64
+
+	// running is set while the River client is started, for readiness checks
+	running atomic.Bool
+
+	depthPollInterval time.Duration
+	stopDepthPoller   context.CancelFunc
+	pollerWG          sync.WaitGroup
 }
 
 // NewManager creates a new queue manager
-func NewManager(ctx context.Context, databaseURL string) (*Manager, error) {
+func NewManager(ctx context.Context, cfg *config.Config) (*Manager, error) {
 	// Create database connection pool
-	dbPool, err := pgxpool.New(ctx, databaseURL)
+	poolConfig, err := cfg.PoolConfig()
+	if err != nil {
+		return nil, fmt.Errorf("invalid database pool configuration: %w", err)
+	}
+
+	logger.NewLogger("queue-manager").Info("Database pool configured",
+		"max_conns", poolConfig.MaxConns,
+		"min_conns", poolConfig.MinConns,
+		"max_conn_lifetime", poolConfig.MaxConnLifetime,
+	)
+
+	dbPool, err := pgxpool.NewWithConfig(ctx, poolConfig)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create database pool: %w", err)
 	}
@@ -38,18 +82,84 @@ func NewManager(ctx context.Context, databaseURL string) (*Manager, error) {
 
 	// Create webhook repository
 	webhookRepo := webhooks.NewRepository(dbPool)
+	if cfg.CompressEventPayloads {
+		webhookRepo.EnablePayloadCompression(cfg.EventPayloadCompressionThreshold)
+	}
+	if cfg.PayloadEncryptionKeys != "" {
+		keys, latest, err := webhooks.ParseEncryptionKeys(cfg.PayloadEncryptionKeys)
+		if err != nil {
+			dbPool.Close()
+			return nil, fmt.Errorf("invalid PAYLOAD_ENCRYPTION_KEYS: %w", err)
+		}
+		version := cfg.PayloadEncryptionKeyVersion
+		if version == 0 {
+			version = latest
+		}
+		payloadCipher, err := webhooks.NewPayloadCipher(keys, version)
+		if err != nil {
+			dbPool.Close()
+			return nil, fmt.Errorf("invalid payload encryption configuration: %w", err)
+		}
+		webhookRepo.EnablePayloadEncryption(payloadCipher)
+	}
+	if cfg.StrictNamespaces {
+		webhookRepo.EnableStrictNamespaces()
+	}
+	webhookRepo.LimitEventTTL(int64(cfg.MaxEventTTLSeconds))
+	webhookRepo.LimitWebhooksPerNamespace(cfg.MaxWebhooksPerNamespace)
 
 	// Initialize River workers
 	riverWorkers := river.NewWorkers()
 
+	queues := map[string]river.QueueConfig{
+		river.QueueDefault: {MaxWorkers: cfg.QueueDefaultWorkers},
+		"events":           {MaxWorkers: cfg.QueueEventsWorkers},   // Event processing queue
+		"webhooks":         {MaxWorkers: cfg.QueueWebhooksWorkers}, // Webhook delivery queue
+		"webhooks_ordered": {MaxWorkers: 1},                        // FIFO delivery for ordered webhooks
+	}
+
+	workerCount := 0
+	for _, queue := range queues {
+		workerCount += queue.MaxWorkers
+	}
+	if err := cfg.CheckPoolCapacity(workerCount); err != nil {
+		dbPool.Close()
+		return nil, err
+	}
+
+	logger.NewLogger("queue-manager").Info("Queue concurrency configured",
+		"default_workers", cfg.QueueDefaultWorkers,
+		"events_workers", cfg.QueueEventsWorkers,
+		"webhooks_workers", cfg.QueueWebhooksWorkers,
+	)
+
 	// Create River client first (needed for workers)
 	riverClient, err := river.NewClient(riverpgxv5.New(dbPool), &river.Config{
-		Queues: map[string]river.QueueConfig{
-			river.QueueDefault: {MaxWorkers: 10},
-			"events":           {MaxWorkers: 5}, // Event processing queue
-			"webhooks":         {MaxWorkers: 8}, // Webhook delivery queue
-		},
+		Queues:  queues,
 		Workers: riverWorkers,
+		PeriodicJobs: []*river.PeriodicJob{
+			river.NewPeriodicJob(
+				river.PeriodicInterval(cfg.CleanupInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.CleanupArgs{}, nil
+				},
+				&river.PeriodicJobOpts{RunOnStart: true},
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(cfg.StuckDeliverySweepInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.ReconcileDeliveriesArgs{}, nil
+				},
+				nil,
+			),
+			river.NewPeriodicJob(
+				river.PeriodicInterval(cfg.WebhookExpirySweepInterval),
+				func() (river.JobArgs, *river.InsertOpts) {
+					return jobs.ExpireWebhooksArgs{}, nil
+				},
+				nil,
+			),
+		},
 	})
 	if err != nil {
 		dbPool.Close()
@@ -57,16 +167,59 @@ func NewManager(ctx context.Context, databaseURL string) (*Manager, error) {
 	}
 
 	// Add workers that need dependencies
-	river.AddWorker(riverWorkers, workers.NewWebhookWorker(webhookRepo))
-	river.AddWorker(riverWorkers, workers.NewEventProcessingWorker(webhookRepo, riverClient))
+	webhookWorker := workers.NewWebhookWorker(webhookRepo, riverClient, cfg, workers.NewDeliverer(cfg))
+	river.AddWorker(riverWorkers, webhookWorker)
+	river.AddWorker(riverWorkers, workers.NewWebhookBatchWorker(webhookWorker, cfg.StuckDeliveryThreshold))
+	river.AddWorker(riverWorkers, workers.NewDeliveryCallbackWorker())
+	eventWorker := workers.NewEventProcessingWorker(webhookRepo, riverClient, cfg.MaxDeliveryTimeout, cfg.MaxFanoutPerEvent, cfg.DeliveryCallbackURL)
+	river.AddWorker(riverWorkers, eventWorker)
+	river.AddWorker(riverWorkers, workers.NewCleanupWorker(webhookRepo, cfg.DeliveryRetention, cfg.CleanupBatchSize))
+	river.AddWorker(riverWorkers, workers.NewDeliveryReconcileWorker(webhookRepo, riverClient, cfg.StuckDeliveryThreshold))
+	river.AddWorker(riverWorkers, workers.NewWebhookExpiryWorker(webhookRepo))
+	river.AddWorker(riverWorkers, workers.NewSecretRotationWorker(webhookRepo))
+
+	metrics, err := observability.NewSparrowMetrics()
+	if err != nil {
+		// Log error but continue without queue depth metrics
+		log := logger.NewLogger("queue-manager")
+		log.Error("Failed to initialize metrics", "error", err)
+	}
+
+	queueNames := make([]string, 0, len(queues))
+	for name := range queues {
+		queueNames = append(queueNames, name)
+	}
 
 	return &Manager{
-		client:      riverClient,
-		dbPool:      dbPool,
-		webhookRepo: webhookRepo,
+		client:            riverClient,
+		dbPool:            dbPool,
+		webhookRepo:       webhookRepo,
+		eventWorker:       eventWorker,
+		webhookWorker:     webhookWorker,
+		metrics:           metrics,
+		queues:            queueNames,
+		depthPollInterval: cfg.QueueDepthPollInterval,
+
+		maxSynchronousWebhooks: cfg.MaxSynchronousWebhooks,
+		synchronousPushTimeout: cfg.SynchronousPushTimeout,
+		healthWindow:           cfg.WebhookHealthWindow,
+		defaultEventTTL:        int64(cfg.DefaultEventTTLSeconds),
+		maxEventTTL:            int64(cfg.MaxEventTTLSeconds),
 	}, nil
 }
 
+// EventTTL returns the TTL in seconds of an event pushed with ttlSeconds, the default when
+// it is unset. It fails if ttlSeconds exceeds the maximum.
+func (m *Manager) EventTTL(ttlSeconds int64) (int64, error) {
+	if ttlSeconds <= 0 {
+		return m.defaultEventTTL, nil
+	}
+	if m.maxEventTTL > 0 && ttlSeconds > m.maxEventTTL {
+		return 0, fmt.Errorf("ttl_seconds must be at most %d", m.maxEventTTL)
+	}
+	return ttlSeconds, nil
+}
+
 // Start starts the queue processing
 func (m *Manager) Start(ctx context.Context) error {
 	log := logger.NewLogger("queue-manager")
@@ -75,16 +228,99 @@ func (m *Manager) Start(ctx context.Context) error {
 		log.Error("Failed to start River client", "error", err)
 		return fmt.Errorf("failed to start River client: %w", err)
 	}
+	m.running.Store(true)
+
+	if m.metrics != nil && m.depthPollInterval > 0 {
+		pollCtx, cancel := context.WithCancel(context.Background())
+		m.stopDepthPoller = cancel
+		m.pollerWG.Add(1)
+		go m.pollQueueDepth(pollCtx)
+	}
 
 	log.Info("Connected to database")
 	log.Info("River queue started successfully")
 	return nil
 }
 
-// Stop stops the queue processing
+// Stop stops the queue processing, waiting until ctx is done for running jobs to finish
 func (m *Manager) Stop(ctx context.Context) error {
-	m.client.Stop(ctx)
+	m.running.Store(false)
+	if m.stopDepthPoller != nil {
+		m.stopDepthPoller()
+		m.pollerWG.Wait()
+		m.stopDepthPoller = nil
+	}
+	err := m.client.Stop(ctx)
 	m.dbPool.Close()
+	return err
+}
+
+// Ready reports whether the queue is running and the database is reachable
+func (m *Manager) Ready(ctx context.Context) error {
+	if !m.running.Load() {
+		return fmt.Errorf("queue is not running")
+	}
+	if err := m.dbPool.Ping(ctx); err != nil {
+		return fmt.Errorf("database is unreachable: %w", err)
+	}
+	return nil
+}
+
+// pollQueueDepth periodically records the number of waiting jobs per queue
+func (m *Manager) pollQueueDepth(ctx context.Context) {
+	defer m.pollerWG.Done()
+
+	log := logger.NewLogger("queue-manager")
+	ticker := time.NewTicker(m.depthPollInterval)
+	defer ticker.Stop()
+
+	for {
+		if err := m.recordQueueDepth(ctx); err != nil && ctx.Err() == nil {
+			log.Warn("Failed to record queue depth", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// recordQueueDepth counts jobs waiting to run in each queue and records them to the QueueDepth gauge
+func (m *Manager) recordQueueDepth(ctx context.Context) error {
+	query := `
+		SELECT queue, count(*)
+		FROM river_job
+		WHERE state IN ('available', 'pending', 'scheduled', 'retryable')
+		GROUP BY queue
+	`
+
+	rows, err := m.dbPool.Query(ctx, query)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	depths := make(map[string]int64, len(m.queues))
+	for _, name := range m.queues {
+		depths[name] = 0
+	}
+	for rows.Next() {
+		var name string
+		var count int64
+		if err := rows.Scan(&name, &count); err != nil {
+			return err
+		}
+		depths[name] = count
+	}
+	if err := rows.Err(); err != nil {
+		return err
+	}
+
+	for name, count := range depths {
+		m.metrics.QueueDepth.Record(ctx, count, metric.WithAttributes(attribute.String("queue", name)))
+	}
 	return nil
 }
 
@@ -108,6 +344,264 @@ func (m *Manager) InsertManyJobs(ctx context.Context, params []river.InsertManyP
 	return m.client.InsertMany(ctx, params)
 }
 
+// ExtendDeliveryTTL gives an expired delivery until expiresAt and schedules it again, with
+// the job of its last run and its remaining attempts (at least one)
+func (m *Manager) ExtendDeliveryTTL(ctx context.Context, deliveryID string, expiresAt time.Time) (*webhooks.WebhookDelivery, error) {
+	d, err := m.webhookRepo.GetDeliveryJob(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if d.Status != webhooks.StatusExpired {
+		return nil, webhooks.ErrDeliveryNotExpired
+	}
+	if d.JobArgs == nil {
+		return nil, webhooks.ErrDeliveryJobNotFound
+	}
+
+	var args jobs.WebhookArgs
+	if err := json.Unmarshal(d.JobArgs, &args); err != nil {
+		return nil, fmt.Errorf("failed to decode delivery job args: %w", err)
+	}
+	args.ExpiresAt = expiresAt
+
+	tx, err := m.webhookRepo.BeginTx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	ok, err := m.webhookRepo.ExtendExpiredDeliveryTx(ctx, tx, deliveryID, expiresAt)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, webhooks.ErrDeliveryNotExpired
+	}
+
+	attempts := d.MaxAttempts - d.AttemptCount
+	if attempts < 1 {
+		attempts = 1
+	}
+	_, err = m.client.InsertTx(ctx, tx, args, &river.InsertOpts{
+		Queue:       d.JobQueue,
+		Priority:    d.JobPriority,
+		MaxAttempts: attempts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to insert delivery job: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, err
+	}
+
+	d.Status = webhooks.StatusRetrying
+	d.ExpiresAt = expiresAt
+	d.MaxAttempts = max(d.MaxAttempts, d.AttemptCount+1)
+	return d.WebhookDelivery, nil
+}
+
+// RotateSigningSecret makes secret a signed webhook's signing secret. The current secret
+// keeps signing deliveries until previousExpiresAt, when a job scheduled in the same
+// transaction removes it. It returns webhooks.ErrNotFound if the webhook isn't signed.
+func (m *Manager) RotateSigningSecret(ctx context.Context, webhookID, secret string, previousExpiresAt time.Time) error {
+	tx, err := m.webhookRepo.BeginTx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := m.webhookRepo.RotateSigningSecretTx(ctx, tx, webhookID, secret, previousExpiresAt); err != nil {
+		return err
+	}
+
+	_, err = m.client.InsertTx(ctx, tx, jobs.ClearPreviousSecretArgs{WebhookID: webhookID}, &river.InsertOpts{
+		ScheduledAt: previousExpiresAt,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to schedule previous secret removal: %w", err)
+	}
+
+	return tx.Commit(ctx)
+}
+
+// ReplayResult summarizes a ReplayEvents run
+type ReplayResult struct {
+	Events    int // Stored events replayed
+	Expired   int // Matching events skipped because their TTL has passed
+	Scheduled int // Deliveries scheduled; webhooks that already had a delivery are skipped
+}
+
+// replayPageSize is how many stored events ReplayEvents loads at a time
+const replayPageSize = 100
+
+// ReplayEvents schedules deliveries of the stored events matching filter to the webhooks
+// currently registered for them, for example to backfill a new webhook. Events whose TTL
+// has passed are skipped. Each webhook gets at most one delivery per event, so replaying
+// the same range again only schedules deliveries for webhooks registered since.
+func (m *Manager) ReplayEvents(ctx context.Context, filter webhooks.EventListFilter) (ReplayResult, error) {
+	var result ReplayResult
+	filter.Limit = replayPageSize
+	for {
+		events, err := m.webhookRepo.ListEvents(ctx, filter)
+		if err != nil {
+			return result, fmt.Errorf("failed to list events: %w", err)
+		}
+
+		now := time.Now()
+		for _, event := range events {
+			if !event.ExpiresAt.After(now) {
+				result.Expired++
+				continue
+			}
+			scheduled, err := m.eventWorker.ReplayEvent(ctx, event.EventRecord)
+			result.Scheduled += scheduled
+			if err != nil {
+				return result, fmt.Errorf("failed to replay event %s: %w", event.ID, err)
+			}
+			result.Events++
+		}
+
+		if len(events) < filter.Limit {
+			return result, nil
+		}
+		last := events[len(events)-1]
+		filter.Cursor = &webhooks.EventCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
+
+// WebhookHealth scores a webhook's recent delivery attempts over the configured health
+// window. A webhook without attempts since it was registered or resumed scores 1.
+func (m *Manager) WebhookHealth(ctx context.Context, webhookID string) (webhooks.HealthScore, error) {
+	health, err := m.webhookRepo.GetWebhookHealth(ctx, webhookID)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return webhooks.HealthScore{SuccessRate: 1}, nil
+	}
+	if err != nil {
+		return webhooks.HealthScore{}, err
+	}
+	return health.ScoreAt(time.Now(), m.healthWindow), nil
+}
+
+// SynchronousPushResult is the outcome of PushEventSynchronously
+type SynchronousPushResult struct {
+	Webhooks   []*webhooks.WebhookRegistration // Webhooks registered for the event whose metadata filters match it
+	Deliveries []workers.SyncDelivery          // First attempts made inline, in webhook order
+}
+
+// PushEventSynchronously stores an event and makes the first delivery attempt to its
+// webhooks inline, returning their results. Only webhooks whose deliveries don't wait on
+// others (not ordered, batched or limited in flight) are attempted inline, up to the
+// configured maximum; the event's processing job schedules the rest through the queue as
+// usual. Failed inline attempts are retried through the queue.
+//
+// The event, its inline deliveries and its processing job are stored in one transaction,
+// and the attempts start once it commits. Attempts still running after the synchronous push
+// timeout are reported as sending; they finish and are recorded in the background.
+func (m *Manager) PushEventSynchronously(ctx context.Context, args jobs.EventArgs, opts *river.InsertOpts) (SynchronousPushResult, error) {
+	var result SynchronousPushResult
+
+	registered, err := m.webhookRepo.GetWebhooksByEvent(ctx, args.Namespace, args.Event)
+	if err != nil {
+		return result, fmt.Errorf("failed to get registered webhooks: %w", err)
+	}
+	result.Webhooks = webhooks.FilterByMetadata(registered, args.Metadata)
+
+	defaults, err := m.webhookRepo.GetNamespaceDefaults(ctx, args.Namespace)
+	if err != nil {
+		return result, fmt.Errorf("failed to load namespace defaults: %w", err)
+	}
+
+	tx, err := m.webhookRepo.BeginTx(ctx)
+	if err != nil {
+		return result, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Deliveries reference the event, so it's stored before the processing job runs
+	err = m.webhookRepo.StoreEventTx(ctx, tx, &webhooks.EventRecord{
+		ID:          args.EventID,
+		Namespace:   args.Namespace,
+		Event:       args.Event,
+		Payload:     string(args.Payload),
+		ContentType: args.ContentType,
+		TTL:         args.TTLSeconds,
+		Metadata:    args.Metadata,
+		CreatedAt:   args.CreatedAt,
+	})
+	if err != nil {
+		return result, fmt.Errorf("failed to store event: %w", err)
+	}
+
+	// Creating the deliveries before the processing job makes its fan-out skip them
+	type pending struct {
+		args jobs.WebhookArgs
+		opts *river.InsertOpts
+	}
+	var inline []pending
+	for _, webhook := range result.Webhooks {
+		if len(inline) == m.maxSynchronousWebhooks {
+			break
+		}
+		if webhook.Ordered || webhook.BatchWindowMs > 0 || webhook.MaxInFlight > 0 {
+			continue
+		}
+		delivery, webhookArgs, webhookOpts := m.eventWorker.NewDelivery(ctx, args, webhook, defaults.Headers)
+		if _, err := m.webhookRepo.CreateDeliveryTx(ctx, tx, delivery); err != nil {
+			return result, fmt.Errorf("failed to create delivery record: %w", err)
+		}
+		inline = append(inline, pending{args: webhookArgs, opts: webhookOpts})
+	}
+
+	if _, err := m.client.InsertTx(ctx, tx, args, opts); err != nil {
+		return result, fmt.Errorf("failed to insert event processing job: %w", err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return result, fmt.Errorf("failed to commit event: %w", err)
+	}
+
+	// The attempts finish and get recorded even if the caller gives up waiting
+	deliverCtx := context.WithoutCancel(ctx)
+	type attempted struct {
+		i        int
+		delivery workers.SyncDelivery
+	}
+	done := make(chan attempted, len(inline))
+	result.Deliveries = make([]workers.SyncDelivery, len(inline))
+	for i, p := range inline {
+		// Until its attempt finishes, a delivery is reported as still sending
+		result.Deliveries[i] = workers.SyncDelivery{
+			WebhookID:  p.args.WebhookID,
+			DeliveryID: p.args.DeliveryID,
+			Status:     webhooks.StatusSending,
+			Error:      "attempt still in progress",
+		}
+		go func() {
+			done <- attempted{i, m.webhookWorker.DeliverNow(deliverCtx, p.args, p.opts)}
+		}()
+	}
+
+	// Answer before the HTTP server's write timeout cuts the response off
+	var timeout <-chan time.Time
+	if m.synchronousPushTimeout > 0 {
+		timer := time.NewTimer(m.synchronousPushTimeout)
+		defer timer.Stop()
+		timeout = timer.C
+	}
+	for range inline {
+		select {
+		case a := <-done:
+			result.Deliveries[a.i] = a.delivery
+		case <-timeout:
+			return result, nil
+		case <-ctx.Done():
+			return result, nil
+		}
+	}
+
+	return result, nil
+}
+
 // JobInserter provides methods to insert jobs with examples
 type JobInserter struct {
 	manager *Manager
@@ -0,0 +1,316 @@
+// Package rest serves the webhook service as a JSON REST API, for clients that don't speak
+// gRPC or Connect. Requests are translated to the service's RPC methods, so validation and
+// authorization are the same as for the other protocols.
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+// maxRequestBytes caps request bodies, matching gRPC's default message size limit
+const maxRequestBytes = 4 << 20
+
+// pathWildcard matches the wildcards of a route pattern, such as {webhook_id}
+var pathWildcard = regexp.MustCompile(`\{(\w+)\}`)
+
+// Gateway routes REST requests under /v1/ to a webhook service
+type Gateway struct {
+	server       pb.WebhookServiceServer
+	interceptors []grpc.UnaryServerInterceptor
+	mux          *http.ServeMux
+}
+
+// NewGateway creates a gateway calling server. Each call passes through interceptors, such as
+// authentication, as if it had been made over gRPC; the Authorization header is forwarded as
+// gRPC metadata.
+func NewGateway(server pb.WebhookServiceServer, interceptors ...grpc.UnaryServerInterceptor) *Gateway {
+	g := &Gateway{
+		server:       server,
+		interceptors: interceptors,
+		mux:          http.NewServeMux(),
+	}
+
+	// Path wildcards are named after the request fields they set, and take precedence over the
+	// body. GET and DELETE requests take the remaining fields as query parameters.
+	handle(g, "POST /v1/webhooks", pb.WebhookService_RegisterWebhook_FullMethodName, http.StatusCreated, server.RegisterWebhook)
+	handle(g, "GET /v1/webhooks", pb.WebhookService_ListWebhooks_FullMethodName, http.StatusOK, server.ListWebhooks)
+	handle(g, "GET /v1/webhooks/{webhook_id}", pb.WebhookService_GetWebhook_FullMethodName, http.StatusOK, server.GetWebhook)
+	handle(g, "DELETE /v1/webhooks/{webhook_id}", pb.WebhookService_UnregisterWebhook_FullMethodName, http.StatusOK, server.UnregisterWebhook)
+	handle(g, "POST /v1/webhooks/{webhook_id}/pause", pb.WebhookService_PauseWebhook_FullMethodName, http.StatusOK, server.PauseWebhook)
+	handle(g, "POST /v1/webhooks/{webhook_id}/resume", pb.WebhookService_ResumeWebhook_FullMethodName, http.StatusOK, server.ResumeWebhook)
+	handle(g, "POST /v1/webhooks/{webhook_id}/rotate-secret", pb.WebhookService_RotateSecret_FullMethodName, http.StatusOK, server.RotateSecret)
+	handle(g, "GET /v1/webhooks/{webhook_id}/deliveries", pb.WebhookService_GetWebhookStatus_FullMethodName, http.StatusOK, server.GetWebhookStatus)
+	handle(g, "GET /v1/deliveries/{delivery_id}", pb.WebhookService_GetDelivery_FullMethodName, http.StatusOK, server.GetDelivery)
+	handle(g, "GET /v1/events/{event_id}", pb.WebhookService_GetEvent_FullMethodName, http.StatusOK, server.GetEvent)
+	handle(g, "GET /v1/events/{event_id}/deliveries", pb.WebhookService_GetWebhookStatus_FullMethodName, http.StatusOK, server.GetWebhookStatus)
+	handle(g, "POST /v1/events/batch", pb.WebhookService_PushEvents_FullMethodName, http.StatusOK, server.PushEvents)
+
+	handle(g, "POST /v1/namespaces", pb.WebhookService_CreateNamespace_FullMethodName, http.StatusCreated, server.CreateNamespace)
+	handle(g, "GET /v1/namespaces/{namespace}/defaults", pb.WebhookService_GetNamespaceDefaults_FullMethodName, http.StatusOK, server.GetNamespaceDefaults)
+	handle(g, "PUT /v1/namespaces/{namespace}/defaults", pb.WebhookService_SetNamespaceDefaults_FullMethodName, http.StatusOK, server.SetNamespaceDefaults)
+	handle(g, "POST /v1/namespaces/{namespace}/events", pb.WebhookService_PushEvent_FullMethodName, http.StatusAccepted, server.PushEvent)
+	handle(g, "GET /v1/namespaces/{namespace}/events", pb.WebhookService_ListEvents_FullMethodName, http.StatusOK, server.ListEvents)
+	handle(g, "POST /v1/namespaces/{namespace}/events/replay", pb.WebhookService_ReplayEvents_FullMethodName, http.StatusAccepted, server.ReplayEvents)
+	handle(g, "GET /v1/namespaces/{namespace}/event-types", pb.WebhookService_ListEventTypes_FullMethodName, http.StatusOK, server.ListEventTypes)
+	handle(g, "GET /v1/namespaces/{namespace}/event-failures", pb.WebhookService_ListEventFailures_FullMethodName, http.StatusOK, server.ListEventFailures)
+	handle(g, "GET /v1/namespaces/{namespace}/deliveries", pb.WebhookService_ListDeliveries_FullMethodName, http.StatusOK, server.ListDeliveries)
+	handle(g, "GET /v1/namespaces/{namespace}/delivery-stats", pb.WebhookService_GetDeliveryStats_FullMethodName, http.StatusOK, server.GetDeliveryStats)
+
+	return g
+}
+
+// ServeHTTP implements http.Handler
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	g.mux.ServeHTTP(w, r)
+}
+
+// handle registers a route calling method, which answers with successStatus
+func handle[Req, Resp proto.Message](g *Gateway, pattern, method string, successStatus int, call func(context.Context, Req) (Resp, error)) {
+	var wildcards []string
+	for _, match := range pathWildcard.FindAllStringSubmatch(pattern, -1) {
+		wildcards = append(wildcards, match[1])
+	}
+
+	g.mux.HandleFunc(pattern, func(w http.ResponseWriter, r *http.Request) {
+		var zero Req
+		req := zero.ProtoReflect().New().Interface().(Req)
+
+		if err := decodeRequest(w, r, req, wildcards); err != nil {
+			writeError(w, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		ctx := r.Context()
+		if authorization := r.Header.Get("Authorization"); authorization != "" {
+			ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", authorization))
+		}
+
+		resp, err := g.invoke(ctx, method, req, func(ctx context.Context, req any) (any, error) {
+			return call(ctx, req.(Req))
+		})
+		if err != nil {
+			writeError(w, err)
+			return
+		}
+
+		body, err := protojson.Marshal(resp.(proto.Message))
+		if err != nil {
+			writeError(w, status.Errorf(codes.Internal, "failed to encode response: %v", err))
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(successStatus)
+		w.Write(body)
+	})
+}
+
+// invoke calls handler through the gateway's interceptors, first to last
+func (g *Gateway) invoke(ctx context.Context, method string, req any, handler grpc.UnaryHandler) (any, error) {
+	info := &grpc.UnaryServerInfo{Server: g.server, FullMethod: method}
+	for i := len(g.interceptors) - 1; i >= 0; i-- {
+		interceptor, next := g.interceptors[i], handler
+		handler = func(ctx context.Context, req any) (any, error) {
+			return interceptor(ctx, req, info, next)
+		}
+	}
+	return handler(ctx, req)
+}
+
+// decodeRequest fills req from the JSON body, or the query string of GET and DELETE requests,
+// then from the path wildcards
+func decodeRequest(w http.ResponseWriter, r *http.Request, req proto.Message, wildcards []string) error {
+	msg := req.ProtoReflect()
+
+	if r.Method == http.MethodGet || r.Method == http.MethodDelete {
+		for name, values := range r.URL.Query() {
+			if err := setField(msg, name, values); err != nil {
+				return err
+			}
+		}
+	} else {
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+		if err != nil {
+			return fmt.Errorf("failed to read request body: %w", err)
+		}
+		if len(body) > 0 {
+			if err := protojson.Unmarshal(body, req); err != nil {
+				return fmt.Errorf("invalid request body: %w", err)
+			}
+		}
+	}
+
+	for _, name := range wildcards {
+		if err := setField(msg, name, []string{r.PathValue(name)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setField sets the field called name, by its proto or JSON name, to values parsed as the
+// field's type. Only scalar and enum fields, or lists of them, can be set this way.
+func setField(msg protoreflect.Message, name string, values []string) error {
+	fields := msg.Descriptor().Fields()
+	field := fields.ByName(protoreflect.Name(name))
+	if field == nil {
+		field = fields.ByJSONName(name)
+	}
+	if field == nil {
+		return fmt.Errorf("unknown parameter %q", name)
+	}
+	if field.IsMap() || field.Kind() == protoreflect.MessageKind || field.Kind() == protoreflect.GroupKind {
+		return fmt.Errorf("parameter %q can't be set in the query string", name)
+	}
+
+	if field.IsList() {
+		list := msg.Mutable(field).List()
+		for _, value := range values {
+			v, err := parseScalar(field, value)
+			if err != nil {
+				return err
+			}
+			list.Append(v)
+		}
+		return nil
+	}
+
+	v, err := parseScalar(field, values[len(values)-1])
+	if err != nil {
+		return err
+	}
+	msg.Set(field, v)
+	return nil
+}
+
+// parseScalar parses value as the type of field
+func parseScalar(field protoreflect.FieldDescriptor, value string) (protoreflect.Value, error) {
+	invalid := func(err error) (protoreflect.Value, error) {
+		return protoreflect.Value{}, fmt.Errorf("invalid value %q for parameter %q: %w", value, field.Name(), err)
+	}
+
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(value), nil
+	case protoreflect.BytesKind:
+		return protoreflect.ValueOfBytes([]byte(value)), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(value)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	case protoreflect.FloatKind:
+		f, err := strconv.ParseFloat(value, 32)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfFloat32(float32(f)), nil
+	case protoreflect.DoubleKind:
+		f, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return invalid(err)
+		}
+		return protoreflect.ValueOfFloat64(f), nil
+	case protoreflect.EnumKind:
+		// Enums are given by name, like in JSON bodies, or by number
+		if enumValue := field.Enum().Values().ByName(protoreflect.Name(value)); enumValue != nil {
+			return protoreflect.ValueOfEnum(enumValue.Number()), nil
+		}
+		n, err := strconv.ParseInt(value, 10, 32)
+		if err != nil {
+			return invalid(fmt.Errorf("unknown %s", field.Enum().Name()))
+		}
+		return protoreflect.ValueOfEnum(protoreflect.EnumNumber(n)), nil
+	default:
+		return invalid(fmt.Errorf("unsupported type %s", field.Kind()))
+	}
+}
+
+// errorBody is the JSON body of an error response, shaped like Connect's JSON errors
+type errorBody struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// writeError writes err, a gRPC status error, with the matching HTTP status code
+func writeError(w http.ResponseWriter, err error) {
+	st := status.Convert(err)
+	body, _ := json.Marshal(errorBody{
+		Code:    connect.Code(st.Code()).String(),
+		Message: st.Message(),
+	})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus(st.Code()))
+	w.Write(body)
+}
+
+// httpStatus maps a gRPC status code to an HTTP status code
+func httpStatus(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.AlreadyExists, codes.Aborted:
+		return http.StatusConflict
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Canceled:
+		return 499 // Client closed request
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
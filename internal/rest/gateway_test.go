@@ -0,0 +1,122 @@
+package rest
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+// fakeServer records the requests it receives
+type fakeServer struct {
+	pb.UnimplementedWebhookServiceServer
+	pushed *pb.PushEventRequest
+	listed *pb.ListDeliveriesRequest
+}
+
+func (s *fakeServer) PushEvent(ctx context.Context, req *pb.PushEventRequest) (*pb.PushEventResponse, error) {
+	s.pushed = req
+	return &pb.PushEventResponse{EventId: "evt-1", Success: true}, nil
+}
+
+func (s *fakeServer) ListDeliveries(ctx context.Context, req *pb.ListDeliveriesRequest) (*pb.ListDeliveriesResponse, error) {
+	s.listed = req
+	return &pb.ListDeliveriesResponse{Success: true}, nil
+}
+
+func (s *fakeServer) GetWebhook(ctx context.Context, req *pb.GetWebhookRequest) (*pb.GetWebhookResponse, error) {
+	return nil, status.Errorf(codes.NotFound, "webhook %s not found", req.WebhookId)
+}
+
+func serve(t *testing.T, handler http.Handler, method, target, body string) *httptest.ResponseRecorder {
+	t.Helper()
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+func TestGatewayPushEvent(t *testing.T) {
+	server := &fakeServer{}
+	gateway := NewGateway(server)
+
+	rec := serve(t, gateway, http.MethodPost, "/v1/namespaces/orders/events",
+		`{"namespace": "ignored", "event": "order.created", "payload": "{}", "ttlSeconds": "60"}`)
+	if rec.Code != http.StatusAccepted {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, http.StatusAccepted, rec.Body)
+	}
+	if server.pushed.Namespace != "orders" || server.pushed.Event != "order.created" || server.pushed.TtlSeconds != 60 {
+		t.Errorf("pushed %v", server.pushed)
+	}
+
+	var resp map[string]any
+	if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil || resp["eventId"] != "evt-1" {
+		t.Errorf("response %s, err %v", rec.Body, err)
+	}
+
+	rec = serve(t, gateway, http.MethodPost, "/v1/namespaces/orders/events", `{"event": 1}`)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("status for an invalid body = %d, want %d", rec.Code, http.StatusBadRequest)
+	}
+}
+
+func TestGatewayQueryParameters(t *testing.T) {
+	server := &fakeServer{}
+	gateway := NewGateway(server)
+
+	rec := serve(t, gateway, http.MethodGet, "/v1/namespaces/orders/deliveries?status=DELIVERY_FAILED&limit=10&page_token=abc", "")
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d: %s", rec.Code, rec.Body)
+	}
+	if server.listed.Namespace != "orders" || server.listed.Status != pb.WebhookDeliveryStatus_DELIVERY_FAILED ||
+		server.listed.Limit != 10 || server.listed.PageToken != "abc" {
+		t.Errorf("listed %v", server.listed)
+	}
+
+	for _, query := range []string{"limit=many", "unknown=1", "status=BOGUS"} {
+		if rec := serve(t, gateway, http.MethodGet, "/v1/namespaces/orders/deliveries?"+query, ""); rec.Code != http.StatusBadRequest {
+			t.Errorf("%s: status = %d, want %d", query, rec.Code, http.StatusBadRequest)
+		}
+	}
+}
+
+func TestGatewayErrors(t *testing.T) {
+	rec := serve(t, NewGateway(&fakeServer{}), http.MethodGet, "/v1/webhooks/wh-1", "")
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("status = %d, want %d", rec.Code, http.StatusNotFound)
+	}
+	var body errorBody
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil || body.Code != "not_found" || body.Message != "webhook wh-1 not found" {
+		t.Errorf("error body %s, err %v", rec.Body, err)
+	}
+
+	// Interceptors see the method and the Authorization header as gRPC metadata
+	deny := func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		if info.FullMethod != pb.WebhookService_PushEvent_FullMethodName || len(md.Get("authorization")) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "missing or invalid API key")
+		}
+		return handler(ctx, req)
+	}
+	gateway := NewGateway(&fakeServer{}, deny)
+
+	if rec := serve(t, gateway, http.MethodPost, "/v1/namespaces/orders/events", `{}`); rec.Code != http.StatusUnauthorized {
+		t.Errorf("status without credentials = %d, want %d", rec.Code, http.StatusUnauthorized)
+	}
+	req := httptest.NewRequest(http.MethodPost, "/v1/namespaces/orders/events", strings.NewReader(`{}`))
+	req.Header.Set("Authorization", "Bearer key")
+	rec = httptest.NewRecorder()
+	gateway.ServeHTTP(rec, req)
+	if rec.Code != http.StatusAccepted {
+		t.Errorf("status with credentials = %d, want %d", rec.Code, http.StatusAccepted)
+	}
+}
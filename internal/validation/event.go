@@ -0,0 +1,64 @@
+package validation
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+// EventPayload returns an event push's payload: raw_payload when set, payload otherwise
+func EventPayload(req *pb.PushEventRequest) string {
+	if len(req.RawPayload) > 0 {
+		return string(req.RawPayload)
+	}
+	return req.Payload
+}
+
+// ValidatePushEvent checks the required fields and payload of an event push. eventTTL
+// resolves the TTL the event is stored with from its ttl_seconds, which is returned.
+func ValidatePushEvent(req *pb.PushEventRequest, eventTTL func(ttlSeconds int64) (int64, error)) (int64, error) {
+	var v violations
+
+	if req.Namespace == "" {
+		v.add("namespace", "namespace is required")
+	}
+	if req.Event == "" {
+		v.add("event", "event is required")
+	}
+	if req.DeliveryTimeoutOverride < 0 {
+		v.add("delivery_timeout_override", "delivery_timeout_override cannot be negative")
+	}
+	if !webhooks.IsValidPriority(int(req.Priority)) {
+		v.add("priority", "priority must be between 1 and 4")
+	}
+
+	ttl, err := eventTTL(req.TtlSeconds)
+	v.check("ttl_seconds", err)
+
+	if req.DeliverAt < 0 {
+		v.add("deliver_at", "deliver_at cannot be negative")
+	} else if req.DeliverAt > 0 {
+		deliverAt := time.Unix(req.DeliverAt, 0)
+		if req.Synchronous && deliverAt.After(time.Now()) {
+			v.add("synchronous", "synchronous can't be combined with a future deliver_at")
+		}
+		if err == nil && deliverAt.After(time.Now().Add(time.Duration(ttl)*time.Second)) {
+			v.add("deliver_at", "deliver_at is after the event's TTL expires")
+		}
+	}
+
+	// Validate JSON payloads without decoding them; other content types are passed through
+	// as-is. The payload is never re-encoded, so it's delivered exactly as pushed.
+	if req.Payload != "" && len(req.RawPayload) > 0 {
+		v.add("raw_payload", "payload and raw_payload can't both be set")
+	}
+	if payload := EventPayload(req); payload != "" && webhooks.IsJSONContentType(req.ContentType) {
+		if !json.Valid([]byte(payload)) {
+			v.add("payload", "invalid JSON payload")
+		}
+	}
+
+	return ttl, v.err()
+}
@@ -0,0 +1,112 @@
+// Package validation checks API requests the same way for the gRPC and Connect servers. A
+// request's violations are collected together and reported with an Error, so clients can fix
+// every field at once.
+package validation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errorDomain and errorReason identify validation failures in ErrorInfo details
+const (
+	errorDomain = "sparrow"
+	errorReason = "INVALID_ARGUMENT"
+)
+
+// Violation is a problem with one field of a request
+type Violation struct {
+	Field       string // Field path, such as "url" or "fallback_urls[1]"
+	Description string
+}
+
+// Error lists every violation found in a request
+type Error struct {
+	Violations []Violation
+}
+
+// Error joins the violations into one message
+func (e *Error) Error() string {
+	parts := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		parts[i] = v.Field + ": " + v.Description
+	}
+	return strings.Join(parts, "; ")
+}
+
+// GRPCStatus returns an InvalidArgument status with BadRequest and ErrorInfo details. gRPC
+// servers use it for an Error returned as is.
+func (e *Error) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+	if detailed, err := st.WithDetails(e.badRequest(), e.errorInfo()); err == nil {
+		return detailed
+	}
+	return st
+}
+
+// ConnectError converts err to a Connect error: CodeInvalidArgument, with BadRequest and
+// ErrorInfo details when err is an *Error
+func ConnectError(err error) *connect.Error {
+	connectErr := connect.NewError(connect.CodeInvalidArgument, err)
+	var validationErr *Error
+	if !errors.As(err, &validationErr) {
+		return connectErr
+	}
+	if detail, err := connect.NewErrorDetail(validationErr.badRequest()); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	if detail, err := connect.NewErrorDetail(validationErr.errorInfo()); err == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+func (e *Error) badRequest() *errdetails.BadRequest {
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       v.Field,
+			Description: v.Description,
+		})
+	}
+	return badRequest
+}
+
+func (e *Error) errorInfo() *errdetails.ErrorInfo {
+	return &errdetails.ErrorInfo{
+		Reason: errorReason,
+		Domain: errorDomain,
+		Metadata: map[string]string{
+			"violations": fmt.Sprint(len(e.Violations)),
+		},
+	}
+}
+
+// violations collects a request's violations
+type violations []Violation
+
+// add records a violation of field
+func (v *violations) add(field, format string, args ...any) {
+	*v = append(*v, Violation{Field: field, Description: fmt.Sprintf(format, args...)})
+}
+
+// check records err, if any, as a violation of field
+func (v *violations) check(field string, err error) {
+	if err != nil {
+		v.add(field, "%s", err.Error())
+	}
+}
+
+// err returns the collected violations as an *Error, or nil if there are none
+func (v violations) err() error {
+	if len(v) == 0 {
+		return nil
+	}
+	return &Error{Violations: v}
+}
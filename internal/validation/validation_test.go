@@ -0,0 +1,143 @@
+package validation
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+func fields(err error) []string {
+	var validationErr *Error
+	if !errors.As(err, &validationErr) {
+		return nil
+	}
+	var fields []string
+	for _, v := range validationErr.Violations {
+		fields = append(fields, v.Field)
+	}
+	return fields
+}
+
+func TestValidateRegistration(t *testing.T) {
+	registration, err := ValidateRegistration(&pb.RegisterWebhookRequest{
+		Namespace: "orders",
+		Events:    []string{"order.created"},
+		Url:       "https://example.com/hook",
+	}, 300, []int{80, 443})
+	if err != nil {
+		t.Fatalf("valid registration: %v", err)
+	}
+	if registration.MaxAttempts == 0 || registration.Timeout != 0 {
+		t.Errorf("defaults not applied: %+v", registration)
+	}
+
+	_, err = ValidateRegistration(&pb.RegisterWebhookRequest{
+		Events:       []string{"order.created", ""},
+		Url:          "ftp://example.com",
+		FallbackUrls: []string{"https://example.com/a", "not a url"},
+		Timeout:      301,
+		MaxAttempts:  MaxWebhookAttempts + 1,
+	}, 300, nil)
+	got := strings.Join(fields(err), ",")
+	want := "namespace,events[1],timeout,max_attempts,url,fallback_urls[1]"
+	if got != want {
+		t.Errorf("violations = %s, want %s (%v)", got, want, err)
+	}
+
+	// URLs must use an allowed port, when ports are restricted
+	_, err = ValidateRegistration(&pb.RegisterWebhookRequest{
+		Namespace:    "orders",
+		Events:       []string{"order.created"},
+		Url:          "https://example.com:8443/hook",
+		FallbackUrls: []string{"http://example.com/a", "sqs://orders"},
+	}, 300, []int{80, 443})
+	if got := strings.Join(fields(err), ","); got != "url" {
+		t.Errorf("violations = %s, want url (%v)", got, err)
+	}
+}
+
+func TestValidatePushEvent(t *testing.T) {
+	eventTTL := func(ttlSeconds int64) (int64, error) {
+		if ttlSeconds > 60 {
+			return 0, errors.New("ttl_seconds must be at most 60")
+		}
+		return 60, nil
+	}
+
+	ttl, err := ValidatePushEvent(&pb.PushEventRequest{Namespace: "orders", Event: "order.created", Payload: "{}"}, eventTTL)
+	if err != nil || ttl != 60 {
+		t.Fatalf("ttl = %d, err = %v", ttl, err)
+	}
+
+	_, err = ValidatePushEvent(&pb.PushEventRequest{Payload: "{", TtlSeconds: 61, Priority: 9}, eventTTL)
+	got := strings.Join(fields(err), ",")
+	if want := "namespace,event,priority,ttl_seconds,payload"; got != want {
+		t.Errorf("violations = %s, want %s (%v)", got, want, err)
+	}
+
+	// Raw payloads are only checked as JSON when declared as JSON
+	binary := &pb.PushEventRequest{Namespace: "orders", Event: "order.created", RawPayload: []byte{0xff, 0x00}, ContentType: "application/octet-stream"}
+	if _, err := ValidatePushEvent(binary, eventTTL); err != nil {
+		t.Errorf("binary payload: %v", err)
+	}
+	if EventPayload(binary) != "\xff\x00" {
+		t.Errorf("EventPayload = %q", EventPayload(binary))
+	}
+	binary.ContentType = ""
+	binary.Payload = "{}"
+	_, err = ValidatePushEvent(binary, eventTTL)
+	if got, want := strings.Join(fields(err), ","), "raw_payload,payload"; got != want {
+		t.Errorf("violations = %s, want %s (%v)", got, want, err)
+	}
+}
+
+func TestErrorDetails(t *testing.T) {
+	err := &Error{Violations: []Violation{
+		{Field: "url", Description: "URL is required"},
+		{Field: "events", Description: "at least one event is required"},
+	}}
+	if want := "url: URL is required; events: at least one event is required"; err.Error() != want {
+		t.Errorf("message = %q, want %q", err.Error(), want)
+	}
+
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Errorf("gRPC code = %v, want %v", st.Code(), codes.InvalidArgument)
+	}
+	var badRequest *errdetails.BadRequest
+	var errorInfo *errdetails.ErrorInfo
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.BadRequest:
+			badRequest = d
+		case *errdetails.ErrorInfo:
+			errorInfo = d
+		}
+	}
+	if badRequest == nil || len(badRequest.FieldViolations) != 2 || badRequest.FieldViolations[1].Field != "events" {
+		t.Errorf("BadRequest detail = %v", badRequest)
+	}
+	if errorInfo == nil || errorInfo.Reason != errorReason || errorInfo.Domain != errorDomain {
+		t.Errorf("ErrorInfo detail = %v", errorInfo)
+	}
+
+	connectErr := ConnectError(err)
+	if connectErr.Code() != connect.CodeInvalidArgument || len(connectErr.Details()) != 2 {
+		t.Errorf("Connect error %v has %d details", connectErr, len(connectErr.Details()))
+	}
+	value, detailErr := connectErr.Details()[0].Value()
+	if fieldViolations, ok := value.(*errdetails.BadRequest); detailErr != nil || !ok || len(fieldViolations.FieldViolations) != 2 {
+		t.Errorf("Connect BadRequest detail = %v, err %v", value, detailErr)
+	}
+
+	if plain := ConnectError(errors.New("bad")); len(plain.Details()) != 0 || plain.Code() != connect.CodeInvalidArgument {
+		t.Errorf("plain error converted to %v", plain)
+	}
+}
@@ -0,0 +1,257 @@
+package validation
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+	pb "github.com/sarathsp06/sparrow/proto"
+)
+
+// MaxWebhookAttempts is the upper bound for a webhook's max_attempts
+const MaxWebhookAttempts = 25
+
+// ValidateRegistration checks a webhook registration and converts it to the registration to
+// store, with defaults applied. maxTimeout is the longest timeout allowed, in seconds, and
+// allowedPorts the ports http(s) URLs may use (empty = any). A zero Timeout is left for the
+// caller to fill in from the namespace defaults.
+func ValidateRegistration(req *pb.RegisterWebhookRequest, maxTimeout int32, allowedPorts []int) (*webhooks.WebhookRegistration, error) {
+	var v violations
+
+	if req.Namespace == "" {
+		v.add("namespace", "namespace is required")
+	}
+	if len(req.Events) == 0 {
+		v.add("events", "at least one event is required")
+	}
+	for i, event := range req.Events {
+		if event == "" {
+			v.add(fmt.Sprintf("events[%d]", i), "event names cannot be empty")
+		}
+	}
+
+	if req.Timeout > maxTimeout {
+		v.add("timeout", "timeout cannot exceed %d seconds", maxTimeout)
+	}
+
+	maxAttempts := req.MaxAttempts
+	if maxAttempts == 0 {
+		maxAttempts = webhooks.DefaultMaxAttempts
+	}
+	if maxAttempts < 1 || maxAttempts > MaxWebhookAttempts {
+		v.add("max_attempts", "max_attempts must be between 1 and %d", MaxWebhookAttempts)
+	}
+
+	if req.MaxStoredResponseBytes < 0 || req.MaxStoredResponseBytes > webhooks.MaxStoredResponseBytesLimit {
+		v.add("max_stored_response_bytes", "max_stored_response_bytes must be between 0 and %d", webhooks.MaxStoredResponseBytesLimit)
+	}
+	if !webhooks.IsValidPriority(int(req.Priority)) {
+		v.add("priority", "priority must be between 1 and 4")
+	}
+	if req.MaxInFlight < 0 {
+		v.add("max_in_flight", "max_in_flight cannot be negative")
+	}
+
+	expiresAt, err := webhookExpiresAt(req.ExpiresAt)
+	v.check("expires_at", err)
+
+	validateFieldSelection(&v, req.IncludeFields, req.ExcludeFields, req.ContentType)
+
+	// The remaining URL-dependent checks only make sense for a usable URL
+	urlsValid := validateWebhookURLs(&v, req.Url, req.FallbackUrls, allowedPorts)
+
+	matcher := convertBodyMatcher(req.SuccessBodyMatcher)
+	if matcher != nil {
+		v.check("success_body_matcher", matcher.Validate())
+	}
+
+	if urlsValid {
+		v.check("batch_window_ms", webhooks.ValidateBatching(int(req.BatchWindowMs), int(req.BatchMaxSize), req.Url, req.FallbackUrls, req.Ordered))
+		v.check("query_params", webhooks.ValidateQueryParams(req.QueryParams, req.Url, req.FallbackUrls))
+	}
+	batchMaxSize := int(req.BatchMaxSize)
+	if req.BatchWindowMs > 0 && batchMaxSize == 0 {
+		batchMaxSize = webhooks.DefaultBatchMaxSize
+	}
+
+	retrySchedule := retryScheduleFromProto(req.RetryScheduleSeconds)
+	v.check("retry_schedule_seconds", webhooks.ValidateRetrySchedule(retrySchedule))
+
+	v.check("headers", webhooks.ValidateHeaderTemplates(req.Headers))
+	v.check("metadata_filter", webhooks.ValidateMetadataFilter(req.MetadataFilter))
+
+	authType, credentials, err := convertWebhookAuth(req.Auth, req.Headers)
+	v.check("auth", err)
+
+	if req.SigningSecret != "" {
+		v.check("signing_secret", webhooks.ValidateSigningSecret(req.SigningSecret))
+	}
+
+	if err := v.err(); err != nil {
+		return nil, err
+	}
+
+	// Webhooks are always delivered with POST
+	return &webhooks.WebhookRegistration{
+		Namespace:   req.Namespace,
+		Events:      req.Events,
+		URL:         req.Url,
+		Headers:     req.Headers,
+		Timeout:     int(max(req.Timeout, 0)),
+		MaxAttempts: int(maxAttempts),
+		ContentType: req.ContentType,
+		Active:      req.Active,
+		Description: req.Description,
+
+		MaxStoredResponseBytes:  int(req.MaxStoredResponseBytes),
+		DisableTracePropagation: req.DisableTracePropagation,
+		Ordered:                 req.Ordered,
+		IncludeFields:           req.IncludeFields,
+		ExcludeFields:           req.ExcludeFields,
+		FallbackURLs:            req.FallbackUrls,
+		SuccessBodyMatcher:      matcher,
+		AuthType:                authType,
+		Credentials:             credentials,
+		Signed:                  req.SigningSecret != "",
+		SigningSecret:           req.SigningSecret,
+		MaxInFlight:             int(req.MaxInFlight),
+		ExpiresAt:               expiresAt,
+		CompressBody:            req.CompressBody,
+		BatchWindowMs:           int(req.BatchWindowMs),
+		BatchMaxSize:            batchMaxSize,
+		RetrySchedule:           retrySchedule,
+		QueryParams:             req.QueryParams,
+		MetadataFilter:          req.MetadataFilter,
+		BypassProxy:             req.BypassProxy,
+		Priority:                int(req.Priority),
+	}, nil
+}
+
+// validateWebhookURLs checks the primary URL and each fallback URL of a registration,
+// including their ports, reporting whether they're all valid
+func validateWebhookURLs(v *violations, primary string, fallbacks []string, allowedPorts []int) bool {
+	before := len(*v)
+	if primary == "" {
+		v.add("url", "URL is required")
+	} else {
+		v.check("url", validateWebhookURL(primary, allowedPorts))
+	}
+	if len(fallbacks) > webhooks.MaxFallbackURLs {
+		v.add("fallback_urls", "at most %d fallback_urls are allowed", webhooks.MaxFallbackURLs)
+	}
+	for i, u := range fallbacks {
+		v.check(fmt.Sprintf("fallback_urls[%d]", i), validateWebhookURL(u, allowedPorts))
+	}
+	return len(*v) == before
+}
+
+// validateWebhookURL checks one webhook URL and its port
+func validateWebhookURL(raw string, allowedPorts []int) error {
+	if err := webhooks.ValidateWebhookURL(raw); err != nil {
+		return err
+	}
+	return webhooks.CheckWebhookPort(raw, allowedPorts)
+}
+
+// validateFieldSelection checks a registration's include/exclude field paths
+func validateFieldSelection(v *violations, include, exclude []string, contentType string) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return
+	}
+	if !webhooks.IsJSONContentType(contentType) {
+		v.add("content_type", "include_fields and exclude_fields require a JSON content_type")
+		return
+	}
+	v.check("include_fields", webhooks.ValidateFieldPaths(include))
+	v.check("exclude_fields", webhooks.ValidateFieldPaths(exclude))
+}
+
+// retryScheduleFromProto converts a registration's retry delays; nil when there are none
+func retryScheduleFromProto(seconds []int32) []int {
+	if len(seconds) == 0 {
+		return nil
+	}
+	schedule := make([]int, len(seconds))
+	for i, s := range seconds {
+		schedule[i] = int(s)
+	}
+	return schedule
+}
+
+// webhookExpiresAt converts a registration's expires_at; zero means the webhook never expires
+func webhookExpiresAt(unix int64) (*time.Time, error) {
+	if unix == 0 {
+		return nil, nil
+	}
+	expiresAt := time.Unix(unix, 0)
+	if !expiresAt.After(time.Now()) {
+		return nil, fmt.Errorf("expires_at must be in the future")
+	}
+	return &expiresAt, nil
+}
+
+// convertBodyMatcher converts a protobuf success body matcher to the stored form
+func convertBodyMatcher(m *pb.SuccessBodyMatcher) *webhooks.BodyMatcher {
+	if m == nil {
+		return nil
+	}
+	return &webhooks.BodyMatcher{
+		JSONPath: m.JsonPath,
+		Expected: m.ExpectedValue,
+		Regex:    m.Regex,
+	}
+}
+
+// convertWebhookAuth converts a registration's auth settings to an auth type and the
+// credentials to store, rejecting an Authorization header that would conflict
+func convertWebhookAuth(auth *pb.WebhookAuth, headers map[string]string) (string, *webhooks.WebhookCredentials, error) {
+	if auth == nil || auth.Type == pb.WebhookAuthType_AUTH_NONE {
+		return "", nil, nil
+	}
+
+	for name := range headers {
+		if strings.EqualFold(name, "Authorization") {
+			return "", nil, fmt.Errorf("auth cannot be combined with an Authorization header")
+		}
+	}
+
+	var authType string
+	var credentials *webhooks.WebhookCredentials
+	switch auth.Type {
+	case pb.WebhookAuthType_AUTH_BASIC:
+		authType = webhooks.AuthTypeBasic
+		credentials = &webhooks.WebhookCredentials{Username: auth.Username, Secret: auth.Password}
+	case pb.WebhookAuthType_AUTH_BEARER:
+		authType = webhooks.AuthTypeBearer
+		credentials = &webhooks.WebhookCredentials{Secret: auth.Token}
+	default:
+		return "", nil, fmt.Errorf("unsupported auth type %v", auth.Type)
+	}
+
+	if err := webhooks.ValidateWebhookAuth(authType, credentials); err != nil {
+		return "", nil, err
+	}
+	return authType, credentials, nil
+}
+
+// ValidateRotateSecret checks a signing secret rotation and returns its grace period, with
+// the default applied
+func ValidateRotateSecret(req *pb.RotateSecretRequest) (time.Duration, error) {
+	var v violations
+
+	if req.WebhookId == "" {
+		v.add("webhook_id", "webhook_id is required")
+	}
+	v.check("new_secret", webhooks.ValidateSigningSecret(req.NewSecret))
+
+	grace := time.Duration(req.GracePeriodSeconds) * time.Second
+	if req.GracePeriodSeconds == 0 {
+		grace = webhooks.DefaultSecretGracePeriod
+	}
+	if grace < 0 || grace > webhooks.MaxSecretGracePeriod {
+		v.add("grace_period_seconds", "grace_period_seconds must be between 0 and %d", int64(webhooks.MaxSecretGracePeriod.Seconds()))
+	}
+
+	return grace, v.err()
+}
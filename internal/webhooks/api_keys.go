@@ -0,0 +1,129 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// APIKey represents a key authorized to act on a set of namespaces
+type APIKey struct {
+	ID          string     `json:"id" db:"id"`
+	KeyHash     string     `json:"-" db:"key_hash"`
+	Namespaces  []string   `json:"namespaces" db:"namespaces"`
+	Description string     `json:"description" db:"description"`
+	CreatedAt   time.Time  `json:"created_at" db:"created_at"`
+	RevokedAt   *time.Time `json:"revoked_at" db:"revoked_at"`
+}
+
+// CreateAPIKey stores a new API key by its hash
+func (r *Repository) CreateAPIKey(ctx context.Context, key *APIKey) error {
+	key.ID = uuid.New().String()
+	key.CreatedAt = time.Now()
+
+	namespacesJSON, err := json.Marshal(key.Namespaces)
+	if err != nil {
+		return fmt.Errorf("failed to marshal namespaces: %w", err)
+	}
+
+	query := `
+		INSERT INTO api_keys (id, key_hash, namespaces, description, created_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err = r.db.Exec(ctx, query, key.ID, key.KeyHash, namespacesJSON, key.Description, key.CreatedAt)
+	return err
+}
+
+// RevokeAPIKey marks an API key as revoked, returning ErrNotFound if no active key matches
+func (r *Repository) RevokeAPIKey(ctx context.Context, keyID string) error {
+	query := `UPDATE api_keys SET revoked_at = $2 WHERE id = $1 AND revoked_at IS NULL`
+
+	tag, err := r.db.Exec(ctx, query, keyID, time.Now())
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// GetActiveAPIKeyByHash returns the non-revoked API key with the given hash
+func (r *Repository) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error) {
+	query := `
+		SELECT id, key_hash, namespaces, description, created_at, revoked_at
+		FROM api_keys
+		WHERE key_hash = $1 AND revoked_at IS NULL
+	`
+
+	var key APIKey
+	var namespacesJSON []byte
+	var description *string
+
+	err := r.db.QueryRow(ctx, query, keyHash).Scan(
+		&key.ID,
+		&key.KeyHash,
+		&namespacesJSON,
+		&description,
+		&key.CreatedAt,
+		&key.RevokedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if description != nil {
+		key.Description = *description
+	}
+	if err := json.Unmarshal(namespacesJSON, &key.Namespaces); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal namespaces: %w", err)
+	}
+
+	return &key, nil
+}
+
+// GetWebhookNamespace returns the namespace a webhook is registered in
+func (r *Repository) GetWebhookNamespace(ctx context.Context, webhookID string) (string, error) {
+	var namespace string
+	err := r.db.QueryRow(ctx, `SELECT namespace FROM webhook_registrations WHERE id = $1`, webhookID).Scan(&namespace)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return namespace, err
+}
+
+// GetEventNamespace returns the namespace an event was pushed to
+func (r *Repository) GetEventNamespace(ctx context.Context, eventID string) (string, error) {
+	var namespace string
+	err := r.db.QueryRow(ctx, `SELECT namespace FROM event_records WHERE id = $1`, eventID).Scan(&namespace)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return namespace, err
+}
+
+// GetDeliveryNamespace returns the namespace of the webhook a delivery is for
+func (r *Repository) GetDeliveryNamespace(ctx context.Context, deliveryID string) (string, error) {
+	query := `
+		SELECT w.namespace
+		FROM webhook_deliveries d
+		JOIN webhook_registrations w ON w.id = d.webhook_id
+		WHERE d.id = $1
+	`
+
+	var namespace string
+	err := r.db.QueryRow(ctx, query, deliveryID).Scan(&namespace)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", ErrNotFound
+	}
+	return namespace, err
+}
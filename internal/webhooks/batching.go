@@ -0,0 +1,139 @@
+package webhooks
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"time"
+)
+
+const (
+	// MaxBatchWindow caps how long a webhook's deliveries may wait to be batched
+	MaxBatchWindow = time.Minute
+
+	// DefaultBatchMaxSize applies to batching webhooks registered without a batch size
+	DefaultBatchMaxSize = 100
+
+	// MaxBatchMaxSize caps the deliveries sent in one batch
+	MaxBatchMaxSize = 1000
+
+	// BatchContentType is the Content-Type of batch request bodies
+	BatchContentType = "application/x-ndjson"
+)
+
+// ValidateBatching checks a webhook's batch settings. A batch is a single request to one
+// http(s) URL, so batching can't be combined with other targets, fallback URLs or ordered
+// delivery.
+func ValidateBatching(windowMs, maxSize int, url string, fallbackURLs []string, ordered bool) error {
+	if windowMs < 0 || time.Duration(windowMs)*time.Millisecond > MaxBatchWindow {
+		return fmt.Errorf("batch_window_ms must be between 0 and %d", MaxBatchWindow.Milliseconds())
+	}
+	if maxSize < 0 || maxSize > MaxBatchMaxSize {
+		return fmt.Errorf("batch_max_size must be between 0 and %d", MaxBatchMaxSize)
+	}
+	if windowMs == 0 {
+		if maxSize > 0 {
+			return fmt.Errorf("batch_max_size requires batch_window_ms")
+		}
+		return nil
+	}
+
+	switch {
+	case TargetType(url) != TargetHTTP:
+		return fmt.Errorf("batching is only supported for http(s) URLs")
+	case len(fallbackURLs) > 0:
+		return fmt.Errorf("batching can't be combined with fallback_urls")
+	case ordered:
+		return fmt.Errorf("batching can't be combined with ordered delivery")
+	}
+	return nil
+}
+
+// BatchFlushAt returns when deliveries created at now are sent: at the end of the current
+// batch window, so deliveries within one window share a batch
+func BatchFlushAt(now time.Time, window time.Duration) time.Time {
+	return now.Truncate(window).Add(window)
+}
+
+// BatchLine is one delivery in an NDJSON batch body
+type BatchLine struct {
+	DeliveryID    string          `json:"delivery_id"`
+	EventID       string          `json:"event_id"`
+	Event         string          `json:"event"`
+	Payload       json.RawMessage `json:"payload,omitempty"`
+	PayloadBase64 []byte          `json:"payload_base64,omitempty"` // Payloads that aren't text
+}
+
+// EncodeBatchLine encodes a delivery as an NDJSON line, including the trailing newline.
+// JSON payloads are embedded as they are, compacted onto the line; other text is embedded
+// as a JSON string, and payloads that aren't text as base64 in payload_base64.
+func EncodeBatchLine(deliveryID, eventID, event, payload string) ([]byte, error) {
+	batchLine := BatchLine{DeliveryID: deliveryID, EventID: eventID, Event: event}
+	switch {
+	case json.Valid([]byte(payload)):
+		batchLine.Payload = json.RawMessage(payload)
+	case IsTextPayload(payload):
+		quoted, err := json.Marshal(payload)
+		if err != nil {
+			return nil, err
+		}
+		batchLine.Payload = quoted
+	default:
+		batchLine.PayloadBase64 = []byte(payload)
+	}
+
+	line, err := json.Marshal(batchLine)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// ClaimBatchDeliveries marks up to limit of a webhook's batched deliveries as sending,
+// counting an attempt, and returns them oldest first. It claims unexpired deliveries that
+// are pending or retrying, plus deliveries left sending since before staleBefore by an
+// interrupted batch, unless they were already delivered. Concurrent batch jobs claim disjoint deliveries.
+func (r *Repository) ClaimBatchDeliveries(ctx context.Context, webhookID string, limit int, staleBefore time.Time) ([]*WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'sending', attempt_count = attempt_count + 1, last_attempted_at = NOW()
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE webhook_id = $1 AND batched AND delivered_at IS NULL
+			  AND expires_at > NOW() AND attempt_count < max_attempts
+			  AND (status IN ('pending', 'retrying') OR (status = 'sending' AND last_attempted_at < $2))
+			ORDER BY created_at, id
+			LIMIT $3
+			FOR UPDATE SKIP LOCKED
+		)
+		RETURNING ` + deliveryColumns
+
+	deliveries, err := r.getDeliveries(ctx, query, webhookID, staleBefore, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	slices.SortFunc(deliveries, func(a, b *WebhookDelivery) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.ID, b.ID)
+	})
+	return deliveries, nil
+}
+
+// ExpireBatchDeliveries marks a webhook's batched deliveries that expired while waiting as
+// expired and returns them. The status change stamps updated_at, so watchers see the expiry.
+func (r *Repository) ExpireBatchDeliveries(ctx context.Context, webhookID string) ([]*WebhookDelivery, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'expired', error_message = 'Delivery expired'
+		WHERE webhook_id = $1 AND batched
+		  AND expires_at <= NOW() AND attempt_count < max_attempts
+		  AND status IN ('pending', 'retrying')
+		RETURNING ` + deliveryColumns
+
+	return r.getDeliveries(ctx, query, webhookID)
+}
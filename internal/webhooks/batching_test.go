@@ -0,0 +1,79 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateBatching(t *testing.T) {
+	tests := []struct {
+		name         string
+		windowMs     int
+		maxSize      int
+		url          string
+		fallbackURLs []string
+		ordered      bool
+		wantErr      bool
+	}{
+		{name: "disabled", url: "https://example.com/hook"},
+		{name: "enabled", windowMs: 500, maxSize: 50, url: "https://example.com/hook"},
+		{name: "default size", windowMs: 500, url: "https://example.com/hook"},
+		{name: "window too long", windowMs: 61000, url: "https://example.com/hook", wantErr: true},
+		{name: "size too large", windowMs: 500, maxSize: 1001, url: "https://example.com/hook", wantErr: true},
+		{name: "size without window", maxSize: 10, url: "https://example.com/hook", wantErr: true},
+		{name: "sqs target", windowMs: 500, url: "sqs://orders", wantErr: true},
+		{name: "fallback URLs", windowMs: 500, url: "https://example.com/hook", fallbackURLs: []string{"https://backup.example.com"}, wantErr: true},
+		{name: "ordered", windowMs: 500, url: "https://example.com/hook", ordered: true, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateBatching(tt.windowMs, tt.maxSize, tt.url, tt.fallbackURLs, tt.ordered)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateBatching() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBatchFlushAt(t *testing.T) {
+	window := 500 * time.Millisecond
+	first := BatchFlushAt(time.Unix(100, 100*int64(time.Millisecond)), window)
+	second := BatchFlushAt(time.Unix(100, 400*int64(time.Millisecond)), window)
+
+	if !first.Equal(second) {
+		t.Errorf("deliveries in one window flush at %v and %v", first, second)
+	}
+	if want := time.Unix(100, 500*int64(time.Millisecond)); !first.Equal(want) {
+		t.Errorf("BatchFlushAt = %v, want %v", first, want)
+	}
+}
+
+func TestEncodeBatchLine(t *testing.T) {
+	line, err := EncodeBatchLine("dlv-1", "evt-1", "order.created", `{"id": 1}`)
+	if err != nil {
+		t.Fatalf("EncodeBatchLine failed: %v", err)
+	}
+	want := `{"delivery_id":"dlv-1","event_id":"evt-1","event":"order.created","payload":{"id":1}}` + "\n"
+	if string(line) != want {
+		t.Errorf("got %q, want %q", line, want)
+	}
+
+	line, err = EncodeBatchLine("dlv-2", "evt-2", "note", "plain text")
+	if err != nil {
+		t.Fatalf("EncodeBatchLine failed: %v", err)
+	}
+	want = `{"delivery_id":"dlv-2","event_id":"evt-2","event":"note","payload":"plain text"}` + "\n"
+	if string(line) != want {
+		t.Errorf("got %q, want %q", line, want)
+	}
+
+	line, err = EncodeBatchLine("dlv-3", "evt-3", "blob", "\xff\x00")
+	if err != nil {
+		t.Fatalf("EncodeBatchLine failed: %v", err)
+	}
+	want = `{"delivery_id":"dlv-3","event_id":"evt-3","event":"blob","payload_base64":"/wA="}` + "\n"
+	if string(line) != want {
+		t.Errorf("got %q, want %q", line, want)
+	}
+}
@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/tidwall/gjson"
+)
+
+// BodyMatcher decides whether a 2xx response body means a delivery succeeded, for receivers
+// that report errors in the body. Either JSONPath (with Expected) or Regex is set.
+type BodyMatcher struct {
+	JSONPath string `json:"json_path,omitempty"`
+	Expected string `json:"expected,omitempty"`
+	Regex    string `json:"regex,omitempty"`
+}
+
+// Validate checks that exactly one kind of match is configured and that it can be used
+func (m *BodyMatcher) Validate() error {
+	switch {
+	case m.JSONPath != "" && m.Regex != "":
+		return fmt.Errorf("set either json_path or regex, not both")
+	case m.JSONPath != "":
+		return ValidateFieldPaths([]string{m.JSONPath})
+	case m.Regex != "":
+		if _, err := regexp.Compile(m.Regex); err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+		return nil
+	default:
+		return fmt.Errorf("json_path or regex is required")
+	}
+}
+
+// Check returns an error describing why body doesn't match, or nil if it does
+func (m *BodyMatcher) Check(body []byte) error {
+	if m.Regex != "" {
+		re, err := regexp.Compile(m.Regex)
+		if err != nil {
+			return fmt.Errorf("invalid regex: %w", err)
+		}
+		if !re.Match(body) {
+			return fmt.Errorf("body does not match %q", m.Regex)
+		}
+		return nil
+	}
+
+	if !gjson.ValidBytes(body) {
+		return fmt.Errorf("body is not valid JSON")
+	}
+	value := gjson.GetBytes(body, m.JSONPath)
+	if !value.Exists() {
+		return fmt.Errorf("%s is missing", m.JSONPath)
+	}
+	if value.String() != m.Expected {
+		return fmt.Errorf("%s is %q, want %q", m.JSONPath, value.String(), m.Expected)
+	}
+	return nil
+}
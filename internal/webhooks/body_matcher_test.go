@@ -0,0 +1,52 @@
+package webhooks
+
+import "testing"
+
+func TestBodyMatcherCheck(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher BodyMatcher
+		body    string
+		wantErr bool
+	}{
+		{"json value matches", BodyMatcher{JSONPath: "status", Expected: "ok"}, `{"status":"ok"}`, false},
+		{"json value differs", BodyMatcher{JSONPath: "status", Expected: "ok"}, `{"status":"error"}`, true},
+		{"json path missing", BodyMatcher{JSONPath: "status", Expected: "ok"}, `{}`, true},
+		{"nested json bool", BodyMatcher{JSONPath: "result.accepted", Expected: "true"}, `{"result":{"accepted":true}}`, false},
+		{"invalid json", BodyMatcher{JSONPath: "status", Expected: "ok"}, `OK`, true},
+		{"regex matches", BodyMatcher{Regex: `^OK\b`}, "OK thanks", false},
+		{"regex differs", BodyMatcher{Regex: `^OK\b`}, "ERROR", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.matcher.Check([]byte(tt.body))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Check(%q) error = %v, wantErr %v", tt.body, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestBodyMatcherValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher BodyMatcher
+		wantErr bool
+	}{
+		{"json path", BodyMatcher{JSONPath: "status", Expected: "ok"}, false},
+		{"regex", BodyMatcher{Regex: "ok"}, false},
+		{"empty", BodyMatcher{}, true},
+		{"both", BodyMatcher{JSONPath: "status", Regex: "ok"}, true},
+		{"bad regex", BodyMatcher{Regex: "("}, true},
+		{"bad path", BodyMatcher{JSONPath: "items.#"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.matcher.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
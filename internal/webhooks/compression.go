@@ -0,0 +1,65 @@
+package webhooks
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// IsTextPayload reports whether a payload is text that a TEXT column and a JSON string can
+// hold exactly: valid UTF-8 without NUL characters
+func IsTextPayload(payload string) bool {
+	return utf8.ValidString(payload) && !strings.ContainsRune(payload, 0)
+}
+
+// splitStoredPayload places a stored payload in the column that holds it exactly: text in the
+// TEXT payload column, anything else in payload_bytes
+func splitStoredPayload(stored string) (text string, raw []byte) {
+	if IsTextPayload(stored) {
+		return stored, nil
+	}
+	return "", []byte(stored)
+}
+
+// joinStoredPayload reverses splitStoredPayload
+func joinStoredPayload(text string, raw []byte) string {
+	if raw != nil {
+		return string(raw)
+	}
+	return text
+}
+
+// compressPayload gzips a payload and base64-encodes it so it still fits the TEXT payload column
+func compressPayload(payload string) (string, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write([]byte(payload)); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+// decompressPayload reverses compressPayload
+func decompressPayload(stored string) (string, error) {
+	compressed, err := base64.StdEncoding.DecodeString(stored)
+	if err != nil {
+		return "", err
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return "", err
+	}
+	defer zr.Close()
+
+	payload, err := io.ReadAll(zr)
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
@@ -0,0 +1,38 @@
+package webhooks
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPayloadCompressionRoundTrip(t *testing.T) {
+	payload := `{"items":[` + strings.Repeat(`{"sku":"ABC-123","qty":1},`, 200) + `{}]}`
+
+	stored, err := compressPayload(payload)
+	if err != nil {
+		t.Fatalf("compressPayload failed: %v", err)
+	}
+	if len(stored) >= len(payload) {
+		t.Errorf("expected compressed payload to be smaller: %d >= %d", len(stored), len(payload))
+	}
+
+	got, err := decompressPayload(stored)
+	if err != nil {
+		t.Fatalf("decompressPayload failed: %v", err)
+	}
+	if got != payload {
+		t.Error("round-tripped payload does not match original")
+	}
+}
+
+func TestStoredPayloadColumns(t *testing.T) {
+	for _, payload := range []string{"", `{"id":1}`, "\x00\x01\xff binary", "caf\xe9"} {
+		text, raw := splitStoredPayload(payload)
+		if IsTextPayload(payload) != (raw == nil) {
+			t.Errorf("splitStoredPayload(%q) = %q, %v; want only payloads that aren't text as bytes", payload, text, raw)
+		}
+		if got := joinStoredPayload(text, raw); got != payload {
+			t.Errorf("joinStoredPayload(splitStoredPayload(%q)) = %q", payload, got)
+		}
+	}
+}
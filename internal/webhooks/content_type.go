@@ -0,0 +1,34 @@
+package webhooks
+
+import (
+	"mime"
+	"strings"
+)
+
+// DefaultContentType is used for payloads that don't declare a content type
+const DefaultContentType = "application/json"
+
+// IsJSONContentType reports whether the content type describes a JSON payload.
+// An empty content type is treated as JSON for backwards compatibility.
+func IsJSONContentType(contentType string) bool {
+	if contentType == "" {
+		return true
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+// ResolveContentType picks the content type for a delivery: the event's own type,
+// then the webhook's configured type, then DefaultContentType
+func ResolveContentType(eventContentType, webhookContentType string) string {
+	if eventContentType != "" {
+		return eventContentType
+	}
+	if webhookContentType != "" {
+		return webhookContentType
+	}
+	return DefaultContentType
+}
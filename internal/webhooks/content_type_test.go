@@ -0,0 +1,33 @@
+package webhooks
+
+import "testing"
+
+func TestIsJSONContentType(t *testing.T) {
+	tests := map[string]bool{
+		"":                                  true,
+		"application/json":                  true,
+		"application/json; charset=utf-8":   true,
+		"application/cloudevents+json":      true,
+		"text/plain":                        false,
+		"application/x-www-form-urlencoded": false,
+		"not a media type;;":                false,
+	}
+
+	for contentType, want := range tests {
+		if got := IsJSONContentType(contentType); got != want {
+			t.Errorf("IsJSONContentType(%q) = %v, want %v", contentType, got, want)
+		}
+	}
+}
+
+func TestResolveContentType(t *testing.T) {
+	if got := ResolveContentType("text/plain", "application/xml"); got != "text/plain" {
+		t.Errorf("Expected event content type to win, got '%s'", got)
+	}
+	if got := ResolveContentType("", "application/xml"); got != "application/xml" {
+		t.Errorf("Expected webhook content type, got '%s'", got)
+	}
+	if got := ResolveContentType("", ""); got != DefaultContentType {
+		t.Errorf("Expected default content type, got '%s'", got)
+	}
+}
@@ -0,0 +1,73 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// Authentication types for deliveries
+const (
+	AuthTypeBasic  = "basic"
+	AuthTypeBearer = "bearer"
+)
+
+// WebhookCredentials authenticate deliveries to a webhook. They're stored apart from the
+// registration and never returned by the API.
+type WebhookCredentials struct {
+	Username string // Basic auth only
+	Secret   string // Basic auth password or bearer token
+}
+
+// ValidateWebhookAuth checks that credentials suit the authentication type
+func ValidateWebhookAuth(authType string, creds *WebhookCredentials) error {
+	switch authType {
+	case AuthTypeBasic:
+		if creds == nil || creds.Username == "" {
+			return fmt.Errorf("basic auth requires a username")
+		}
+	case AuthTypeBearer:
+		if creds == nil || creds.Secret == "" {
+			return fmt.Errorf("bearer auth requires a token")
+		}
+	default:
+		return fmt.Errorf("unsupported auth type %q", authType)
+	}
+	return nil
+}
+
+// AuthorizationHeader builds the Authorization header value for a delivery
+func AuthorizationHeader(authType string, creds *WebhookCredentials) (string, error) {
+	switch authType {
+	case AuthTypeBasic:
+		return "Basic " + base64.StdEncoding.EncodeToString([]byte(creds.Username+":"+creds.Secret)), nil
+	case AuthTypeBearer:
+		return "Bearer " + creds.Secret, nil
+	default:
+		return "", fmt.Errorf("unsupported auth type %q", authType)
+	}
+}
+
+// GetWebhookCredentials returns a webhook's delivery credentials, or ErrNotFound
+func (r *Repository) GetWebhookCredentials(ctx context.Context, webhookID string) (*WebhookCredentials, error) {
+	query := `SELECT username, secret FROM webhook_credentials WHERE webhook_id = $1`
+
+	var creds WebhookCredentials
+	err := r.db.QueryRow(ctx, query, webhookID).Scan(&creds.Username, &creds.Secret)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return &creds, nil
+}
+
+func storeWebhookCredentials(ctx context.Context, db dbExecutor, webhookID string, creds *WebhookCredentials) error {
+	query := `INSERT INTO webhook_credentials (webhook_id, username, secret) VALUES ($1, $2, $3)`
+	_, err := db.Exec(ctx, query, webhookID, creds.Username, creds.Secret)
+	return err
+}
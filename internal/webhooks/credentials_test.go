@@ -0,0 +1,44 @@
+package webhooks
+
+import "testing"
+
+func TestAuthorizationHeader(t *testing.T) {
+	got, err := AuthorizationHeader(AuthTypeBasic, &WebhookCredentials{Username: "Aladdin", Secret: "open sesame"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "Basic QWxhZGRpbjpvcGVuIHNlc2FtZQ=="; got != want {
+		t.Errorf("basic header = %q, want %q", got, want)
+	}
+
+	got, err = AuthorizationHeader(AuthTypeBearer, &WebhookCredentials{Secret: "token"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := "Bearer token"; got != want {
+		t.Errorf("bearer header = %q, want %q", got, want)
+	}
+}
+
+func TestValidateWebhookAuth(t *testing.T) {
+	tests := []struct {
+		name     string
+		authType string
+		creds    *WebhookCredentials
+		wantErr  bool
+	}{
+		{"basic", AuthTypeBasic, &WebhookCredentials{Username: "user", Secret: "pass"}, false},
+		{"basic without username", AuthTypeBasic, &WebhookCredentials{Secret: "pass"}, true},
+		{"bearer", AuthTypeBearer, &WebhookCredentials{Secret: "token"}, false},
+		{"bearer without token", AuthTypeBearer, &WebhookCredentials{}, true},
+		{"unknown type", "digest", &WebhookCredentials{Secret: "x"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := ValidateWebhookAuth(tt.authType, tt.creds); (err != nil) != tt.wantErr {
+				t.Errorf("ValidateWebhookAuth() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
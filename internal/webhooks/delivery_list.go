@@ -0,0 +1,60 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// DeliveryListFilter selects the deliveries returned by ListDeliveries
+type DeliveryListFilter struct {
+	Namespace string                // Namespace of the deliveries' webhooks
+	Status    WebhookDeliveryStatus // Optional status
+	Since     time.Time             // Inclusive lower bound on created_at; zero = unbounded
+	Until     time.Time             // Exclusive upper bound on created_at; zero = unbounded
+	Limit     int
+
+	// Cursor continues a previous listing after the given delivery (see EncodeDeliveryCursor)
+	Cursor *EventCursor
+}
+
+// ListDeliveries returns deliveries to a namespace's webhooks matching filter, newest first
+func (r *Repository) ListDeliveries(ctx context.Context, filter DeliveryListFilter) ([]*WebhookDelivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id IN (SELECT id FROM webhook_registrations WHERE namespace = $1)
+	`
+	args := []any{filter.Namespace}
+
+	if filter.Status != "" {
+		args = append(args, filter.Status)
+		query += fmt.Sprintf(" AND status = $%d", len(args))
+	}
+	if !filter.Since.IsZero() {
+		args = append(args, filter.Since)
+		query += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if !filter.Until.IsZero() {
+		args = append(args, filter.Until)
+		query += fmt.Sprintf(" AND created_at < $%d", len(args))
+	}
+	if filter.Cursor != nil {
+		args = append(args, filter.Cursor.CreatedAt, filter.Cursor.ID)
+		query += fmt.Sprintf(" AND (created_at, id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args))
+
+	return r.getDeliveries(ctx, query, args...)
+}
+
+// EncodeDeliveryCursor returns an opaque page token that continues a listing after the
+// delivery. It is decoded with DecodeEventCursor.
+func EncodeDeliveryCursor(delivery *WebhookDelivery) string {
+	raw := strconv.FormatInt(delivery.CreatedAt.UnixNano(), 10) + ":" + delivery.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
@@ -0,0 +1,104 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+)
+
+// DeliveryStats aggregates deliveries by status. Latencies are request durations of each
+// delivery's last attempt, in milliseconds; they are zero when nothing was sent.
+type DeliveryStats struct {
+	Total      int64
+	Pending    int64
+	Sending    int64
+	Success    int64
+	Failed     int64
+	Retrying   int64
+	Expired    int64
+	LatencyP50 float64
+	LatencyP95 float64
+}
+
+// SuccessRate is the fraction of finished deliveries (succeeded, failed or expired) that
+// succeeded, or 0 if none have finished
+func (s *DeliveryStats) SuccessRate() float64 {
+	finished := s.Success + s.Failed + s.Expired
+	if finished == 0 {
+		return 0
+	}
+	return float64(s.Success) / float64(finished)
+}
+
+// EventDeliveryStats are the delivery statistics of a single event name
+type EventDeliveryStats struct {
+	Event string
+	DeliveryStats
+}
+
+// NamespaceDeliveryStats are the delivery statistics of a namespace over a time range
+type NamespaceDeliveryStats struct {
+	Totals DeliveryStats
+	Events []*EventDeliveryStats // Busiest first
+}
+
+// GetDeliveryStats aggregates the deliveries of a namespace created in [since, until).
+// The aggregation runs in Postgres; a single row per event name plus the totals comes back.
+func (r *Repository) GetDeliveryStats(ctx context.Context, namespace string, since, until time.Time) (*NamespaceDeliveryStats, error) {
+	query := `
+		SELECT
+			COALESCE(e.event, ''),
+			GROUPING(e.event) = 1,
+			COUNT(*),
+			COUNT(*) FILTER (WHERE d.status = 'pending'),
+			COUNT(*) FILTER (WHERE d.status = 'sending'),
+			COUNT(*) FILTER (WHERE d.status = 'success'),
+			COUNT(*) FILTER (WHERE d.status = 'failed'),
+			COUNT(*) FILTER (WHERE d.status = 'retrying'),
+			COUNT(*) FILTER (WHERE d.status = 'expired'),
+			COALESCE(percentile_cont(0.5) WITHIN GROUP (ORDER BY d.duration_ms), 0),
+			COALESCE(percentile_cont(0.95) WITHIN GROUP (ORDER BY d.duration_ms), 0)
+		FROM webhook_deliveries d
+		JOIN event_records e ON e.id = d.event_id
+		WHERE e.namespace = $1 AND d.created_at >= $2 AND d.created_at < $3
+		GROUP BY GROUPING SETS ((e.event), ())
+		ORDER BY COUNT(*) DESC, 1
+	`
+
+	rows, err := r.db.Query(ctx, query, namespace, since, until)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	stats := &NamespaceDeliveryStats{}
+	for rows.Next() {
+		var (
+			event   EventDeliveryStats
+			overall bool
+		)
+		err := rows.Scan(
+			&event.Event,
+			&overall,
+			&event.Total,
+			&event.Pending,
+			&event.Sending,
+			&event.Success,
+			&event.Failed,
+			&event.Retrying,
+			&event.Expired,
+			&event.LatencyP50,
+			&event.LatencyP95,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if overall {
+			stats.Totals = event.DeliveryStats
+		} else {
+			stats.Events = append(stats.Events, &event)
+		}
+	}
+
+	return stats, rows.Err()
+}
@@ -0,0 +1,24 @@
+package webhooks
+
+import "testing"
+
+func TestDeliveryStatsSuccessRate(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats DeliveryStats
+		want  float64
+	}{
+		{"nothing finished", DeliveryStats{Total: 3, Pending: 2, Retrying: 1}, 0},
+		{"all succeeded", DeliveryStats{Total: 4, Success: 4}, 1},
+		{"in-flight deliveries are ignored", DeliveryStats{Total: 6, Success: 3, Failed: 1, Sending: 2}, 0.75},
+		{"expired count as failures", DeliveryStats{Total: 4, Success: 1, Failed: 2, Expired: 1}, 0.25},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.stats.SuccessRate(); got != tt.want {
+				t.Errorf("SuccessRate() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
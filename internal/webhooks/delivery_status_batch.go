@@ -0,0 +1,74 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+)
+
+// MaxStatusBatchIDs caps the webhook and event IDs of a single batch status lookup
+const MaxStatusBatchIDs = 100
+
+// ValidateStatusBatch checks the IDs of a batch status lookup
+func ValidateStatusBatch(webhookIDs, eventIDs []string) error {
+	total := len(webhookIDs) + len(eventIDs)
+	if total == 0 {
+		return fmt.Errorf("at least one webhook_id or event_id is required")
+	}
+	if total > MaxStatusBatchIDs {
+		return fmt.Errorf("at most %d webhook_ids and event_ids can be looked up at once, got %d", MaxStatusBatchIDs, total)
+	}
+	for _, id := range webhookIDs {
+		if id == "" {
+			return fmt.Errorf("webhook_ids cannot be empty")
+		}
+	}
+	for _, id := range eventIDs {
+		if id == "" {
+			return fmt.Errorf("event_ids cannot be empty")
+		}
+	}
+	return nil
+}
+
+// GetDeliveriesBatch returns the deliveries of any of the webhooks or events, newest first,
+// in a single query
+func (r *Repository) GetDeliveriesBatch(ctx context.Context, webhookIDs, eventIDs []string) ([]*WebhookDelivery, error) {
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE webhook_id = ANY($1) OR event_id = ANY($2)
+		ORDER BY created_at DESC
+	`
+
+	// ANY needs arrays, not NULLs, for IDs not given
+	if webhookIDs == nil {
+		webhookIDs = []string{}
+	}
+	if eventIDs == nil {
+		eventIDs = []string{}
+	}
+	return r.getDeliveries(ctx, query, webhookIDs, eventIDs)
+}
+
+// GroupDeliveries splits deliveries by webhook and by event, keeping their order. A delivery
+// matching both a webhook and an event ID appears in both groups.
+func GroupDeliveries(deliveries []*WebhookDelivery, webhookIDs, eventIDs []string) (byWebhook, byEvent map[string][]*WebhookDelivery) {
+	byWebhook = make(map[string][]*WebhookDelivery, len(webhookIDs))
+	for _, id := range webhookIDs {
+		byWebhook[id] = nil
+	}
+	byEvent = make(map[string][]*WebhookDelivery, len(eventIDs))
+	for _, id := range eventIDs {
+		byEvent[id] = nil
+	}
+
+	for _, d := range deliveries {
+		if group, ok := byWebhook[d.WebhookID]; ok {
+			byWebhook[d.WebhookID] = append(group, d)
+		}
+		if group, ok := byEvent[d.EventID]; ok {
+			byEvent[d.EventID] = append(group, d)
+		}
+	}
+	return byWebhook, byEvent
+}
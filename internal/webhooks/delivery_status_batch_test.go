@@ -0,0 +1,56 @@
+package webhooks
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestValidateStatusBatch(t *testing.T) {
+	if err := ValidateStatusBatch([]string{"wh-1"}, []string{"ev-1"}); err != nil {
+		t.Errorf("Expected a valid batch, got %v", err)
+	}
+	if err := ValidateStatusBatch(nil, nil); err == nil {
+		t.Error("Expected an error for an empty batch")
+	}
+	if err := ValidateStatusBatch([]string{""}, nil); err == nil {
+		t.Error("Expected an error for an empty webhook ID")
+	}
+
+	ids := strings.Split(strings.Repeat("id,", MaxStatusBatchIDs), ",")
+	if err := ValidateStatusBatch(ids[:MaxStatusBatchIDs], nil); err != nil {
+		t.Errorf("Expected %d IDs to be allowed, got %v", MaxStatusBatchIDs, err)
+	}
+	if err := ValidateStatusBatch(ids[:MaxStatusBatchIDs], []string{"ev-1"}); err == nil {
+		t.Error("Expected an error for an oversized batch")
+	}
+}
+
+func TestGroupDeliveries(t *testing.T) {
+	deliveries := []*WebhookDelivery{
+		{ID: "d3", WebhookID: "wh-1", EventID: "ev-2"},
+		{ID: "d2", WebhookID: "wh-2", EventID: "ev-1"},
+		{ID: "d1", WebhookID: "wh-1", EventID: "ev-1"},
+	}
+
+	byWebhook, byEvent := GroupDeliveries(deliveries, []string{"wh-1", "wh-3"}, []string{"ev-1"})
+
+	ids := func(group []*WebhookDelivery) string {
+		var out []string
+		for _, d := range group {
+			out = append(out, d.ID)
+		}
+		return strings.Join(out, ",")
+	}
+	if got := ids(byWebhook["wh-1"]); got != "d3,d1" {
+		t.Errorf("wh-1 deliveries = %s, want d3,d1", got)
+	}
+	if group, ok := byWebhook["wh-3"]; !ok || len(group) != 0 {
+		t.Errorf("Expected an empty group for wh-3, got %v", group)
+	}
+	if _, ok := byWebhook["wh-2"]; ok {
+		t.Error("Expected no group for a webhook that wasn't requested")
+	}
+	if got := ids(byEvent["ev-1"]); got != "d2,d1" {
+		t.Errorf("ev-1 deliveries = %s, want d2,d1", got)
+	}
+}
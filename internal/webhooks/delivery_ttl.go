@@ -0,0 +1,66 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrDeliveryNotExpired is returned when extending the TTL of a delivery that isn't expired
+var ErrDeliveryNotExpired = errors.New("delivery is not expired")
+
+// ErrDeliveryJobNotFound is returned when a delivery's River job no longer exists to copy
+var ErrDeliveryJobNotFound = errors.New("delivery job no longer exists")
+
+// GetDeliveryJob returns a delivery with its latest River job
+func (r *Repository) GetDeliveryJob(ctx context.Context, deliveryID string) (*DeliveryJob, error) {
+	query := `
+		SELECT ` + deliveryColumns + `, j.args, COALESCE(j.queue, ''), COALESCE(j.priority, 0)
+		FROM webhook_deliveries d` + latestDeliveryJob + `
+		WHERE d.id = $1
+	`
+
+	d := &DeliveryJob{}
+	var err error
+	d.WebhookDelivery, err = scanDelivery(r.db.QueryRow(ctx, query, deliveryID), &d.JobArgs, &d.JobQueue, &d.JobPriority)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// ExtendExpiredDeliveryTx moves an expired delivery back to retrying with a new expiry,
+// allowing at least one more attempt, and reports whether it was still expired. The event's
+// expiry is extended to match, so cleanup doesn't delete the event and its deliveries with it.
+// The status change stamps updated_at, so watchers see the delivery rescheduled.
+func (r *Repository) ExtendExpiredDeliveryTx(ctx context.Context, tx pgx.Tx, deliveryID string, expiresAt time.Time) (bool, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = 'retrying', expires_at = $2, error_message = 'Delivery TTL extended; rescheduled',
+		    max_attempts = GREATEST(max_attempts, attempt_count + 1)
+		WHERE id = $1 AND status = 'expired'
+	`
+
+	tag, err := tx.Exec(ctx, query, deliveryID, expiresAt)
+	if err != nil {
+		return false, err
+	}
+	if tag.RowsAffected() != 1 {
+		return false, nil
+	}
+
+	query = `
+		UPDATE event_records
+		SET expires_at = GREATEST(expires_at, $2)
+		WHERE id = (SELECT event_id FROM webhook_deliveries WHERE id = $1)
+	`
+	if _, err := tx.Exec(ctx, query, deliveryID, expiresAt); err != nil {
+		return false, err
+	}
+	return true, nil
+}
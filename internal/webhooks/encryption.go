@@ -0,0 +1,111 @@
+package webhooks
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PayloadCipher encrypts stored event payloads with AES-GCM. Every stored payload records the
+// version of the key that encrypted it, so old keys can be kept for reading while new
+// payloads use the current one.
+type PayloadCipher struct {
+	keys    map[int]cipher.AEAD
+	current int
+}
+
+// NewPayloadCipher creates a cipher from keys by version. Keys must be 16, 24 or 32 bytes
+// (AES-128, AES-192 or AES-256); current is the version used to encrypt.
+func NewPayloadCipher(keys map[int][]byte, current int) (*PayloadCipher, error) {
+	if _, ok := keys[current]; !ok {
+		return nil, fmt.Errorf("no encryption key with version %d", current)
+	}
+
+	c := &PayloadCipher{keys: make(map[int]cipher.AEAD, len(keys)), current: current}
+	for version, key := range keys {
+		if version <= 0 {
+			return nil, fmt.Errorf("encryption key versions must be positive, got %d", version)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %d: %w", version, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("encryption key %d: %w", version, err)
+		}
+		c.keys[version] = aead
+	}
+	return c, nil
+}
+
+// ParseEncryptionKeys parses a comma-separated list of version:base64-key pairs, such as
+// "1:<key>,2:<key>", and returns the keys along with the highest version
+func ParseEncryptionKeys(spec string) (map[int][]byte, int, error) {
+	keys := make(map[int][]byte)
+	latest := 0
+	for _, entry := range strings.Split(spec, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		versionText, encoded, ok := strings.Cut(entry, ":")
+		if !ok {
+			return nil, 0, fmt.Errorf("invalid encryption key %q, expected version:base64-key", entry)
+		}
+		version, err := strconv.Atoi(versionText)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid encryption key version %q", versionText)
+		}
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			return nil, 0, fmt.Errorf("encryption key %d is not valid base64: %w", version, err)
+		}
+		if _, dup := keys[version]; dup {
+			return nil, 0, fmt.Errorf("duplicate encryption key version %d", version)
+		}
+		keys[version] = key
+		latest = max(latest, version)
+	}
+	if len(keys) == 0 {
+		return nil, 0, fmt.Errorf("no encryption keys given")
+	}
+	return keys, latest, nil
+}
+
+// encrypt seals a payload with the current key, bound to the event ID so a ciphertext can't
+// be moved to another event. It returns the base64 result and the key version used.
+func (c *PayloadCipher) encrypt(eventID, payload string) (string, int, error) {
+	aead := c.keys[c.current]
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", 0, err
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(payload), []byte(eventID))
+	return base64.StdEncoding.EncodeToString(sealed), c.current, nil
+}
+
+// decrypt reverses encrypt using the key with the given version
+func (c *PayloadCipher) decrypt(eventID, stored string, version int) (string, error) {
+	aead, ok := c.keys[version]
+	if !ok {
+		return "", fmt.Errorf("no encryption key with version %d", version)
+	}
+	sealed, err := base64.StdEncoding.DecodeString(stored)
+	if err != nil {
+		return "", err
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("encrypted payload is too short")
+	}
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	payload, err := aead.Open(nil, nonce, ciphertext, []byte(eventID))
+	if err != nil {
+		return "", err
+	}
+	return string(payload), nil
+}
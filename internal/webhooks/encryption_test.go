@@ -0,0 +1,78 @@
+package webhooks
+
+import (
+	"bytes"
+	"encoding/base64"
+	"strings"
+	"testing"
+)
+
+func TestPayloadCipherRoundTrip(t *testing.T) {
+	oldKey := bytes.Repeat([]byte{1}, 32)
+	newKey := bytes.Repeat([]byte{2}, 32)
+
+	old, err := NewPayloadCipher(map[int][]byte{1: oldKey}, 1)
+	if err != nil {
+		t.Fatalf("NewPayloadCipher failed: %v", err)
+	}
+	storedOld, _, err := old.encrypt("evt-1", `{"email":"a@example.com"}`)
+	if err != nil {
+		t.Fatalf("encrypt failed: %v", err)
+	}
+
+	// After rotation, new payloads use version 2 and old ones still decrypt
+	rotated, err := NewPayloadCipher(map[int][]byte{1: oldKey, 2: newKey}, 2)
+	if err != nil {
+		t.Fatalf("NewPayloadCipher failed: %v", err)
+	}
+	stored, version, err := rotated.encrypt("evt-2", `{"email":"b@example.com"}`)
+	if err != nil {
+		t.Fatalf("encrypt failed: %v", err)
+	}
+	if version != 2 {
+		t.Errorf("expected key version 2, got %d", version)
+	}
+	if strings.Contains(stored, "example.com") {
+		t.Error("stored payload contains plaintext")
+	}
+
+	if got, err := rotated.decrypt("evt-2", stored, 2); err != nil || got != `{"email":"b@example.com"}` {
+		t.Errorf("decrypt(new) = %q, %v", got, err)
+	}
+	if got, err := rotated.decrypt("evt-1", storedOld, 1); err != nil || got != `{"email":"a@example.com"}` {
+		t.Errorf("decrypt(old) = %q, %v", got, err)
+	}
+
+	// A ciphertext is bound to its event
+	if _, err := rotated.decrypt("evt-1", stored, 2); err == nil {
+		t.Error("expected decrypting with another event ID to fail")
+	}
+}
+
+func TestNewPayloadCipherRejectsBadKeys(t *testing.T) {
+	if _, err := NewPayloadCipher(map[int][]byte{1: []byte("short")}, 1); err == nil {
+		t.Error("expected error for invalid key length")
+	}
+	if _, err := NewPayloadCipher(map[int][]byte{1: bytes.Repeat([]byte{1}, 32)}, 2); err == nil {
+		t.Error("expected error for missing current key")
+	}
+}
+
+func TestParseEncryptionKeys(t *testing.T) {
+	k1 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{1}, 32))
+	k2 := base64.StdEncoding.EncodeToString(bytes.Repeat([]byte{2}, 32))
+
+	keys, latest, err := ParseEncryptionKeys("1:" + k1 + ", 2:" + k2)
+	if err != nil {
+		t.Fatalf("ParseEncryptionKeys failed: %v", err)
+	}
+	if len(keys) != 2 || latest != 2 {
+		t.Errorf("got %d keys, latest %d; want 2 keys, latest 2", len(keys), latest)
+	}
+
+	for _, spec := range []string{"", k1, "x:" + k1, "1:not-base64!", "1:" + k1 + ",1:" + k2} {
+		if _, _, err := ParseEncryptionKeys(spec); err == nil {
+			t.Errorf("ParseEncryptionKeys(%q): expected error", spec)
+		}
+	}
+}
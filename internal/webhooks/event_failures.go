@@ -0,0 +1,98 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventProcessingFailure records an event processing job that failed after exhausting its
+// attempts, so the event's deliveries may not all have been scheduled
+type EventProcessingFailure struct {
+	ID        string    `json:"id" db:"id"`
+	EventID   string    `json:"event_id" db:"event_id"`
+	Namespace string    `json:"namespace" db:"namespace"`
+	Event     string    `json:"event" db:"event"`
+	Error     string    `json:"error" db:"error"`
+	Attempts  int       `json:"attempts" db:"attempts"`
+	FailedAt  time.Time `json:"failed_at" db:"failed_at"`
+}
+
+// EventFailureListFilter selects the failures returned by ListEventFailures
+type EventFailureListFilter struct {
+	Namespace string
+	Limit     int
+
+	// Cursor continues a previous listing after the given failure (see EncodeEventFailureCursor)
+	Cursor *EventCursor
+}
+
+// RecordEventProcessingFailure stores a failed event processing job
+func (r *Repository) RecordEventProcessingFailure(ctx context.Context, failure *EventProcessingFailure) error {
+	if failure.ID == "" {
+		failure.ID = uuid.New().String()
+	}
+	failure.FailedAt = time.Now()
+
+	query := `
+		INSERT INTO event_processing_failures (id, event_id, namespace, event, error, attempts, failed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.Exec(ctx, query,
+		failure.ID,
+		failure.EventID,
+		failure.Namespace,
+		failure.Event,
+		failure.Error,
+		failure.Attempts,
+		failure.FailedAt,
+	)
+	return err
+}
+
+// ListEventFailures returns a namespace's event processing failures, newest first
+func (r *Repository) ListEventFailures(ctx context.Context, filter EventFailureListFilter) ([]*EventProcessingFailure, error) {
+	query := `
+		SELECT id, event_id, namespace, event, error, attempts, failed_at
+		FROM event_processing_failures
+		WHERE namespace = $1
+	`
+	args := []any{filter.Namespace}
+
+	if filter.Cursor != nil {
+		args = append(args, filter.Cursor.CreatedAt, filter.Cursor.ID)
+		query += fmt.Sprintf(" AND (failed_at, id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY failed_at DESC, id DESC LIMIT $%d", len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var failures []*EventProcessingFailure
+	for rows.Next() {
+		var f EventProcessingFailure
+		if err := rows.Scan(&f.ID, &f.EventID, &f.Namespace, &f.Event, &f.Error, &f.Attempts, &f.FailedAt); err != nil {
+			return nil, err
+		}
+		failures = append(failures, &f)
+	}
+
+	return failures, rows.Err()
+}
+
+// EncodeEventFailureCursor returns an opaque page token that continues a listing after the
+// failure. It is decoded with DecodeEventCursor.
+func EncodeEventFailureCursor(failure *EventProcessingFailure) string {
+	raw := strconv.FormatInt(failure.FailedAt.UnixNano(), 10) + ":" + failure.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
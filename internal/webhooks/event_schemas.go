@@ -0,0 +1,175 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// ErrSchemaValidation is returned when a payload doesn't match its event's registered schema
+var ErrSchemaValidation = errors.New("payload does not match event schema")
+
+// schemaCacheTTL is how long a schema lookup is reused before checking the database again
+const schemaCacheTTL = 10 * time.Second
+
+// EventSchema is a JSON Schema that payloads of a namespace/event must match
+type EventSchema struct {
+	Namespace string    `json:"namespace" db:"namespace"`
+	Event     string    `json:"event" db:"event"`
+	Schema    string    `json:"schema" db:"schema"`
+	CreatedAt time.Time `json:"created_at" db:"created_at"`
+	UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+}
+
+// UpsertEventSchema registers or replaces the schema for a namespace/event
+func (r *Repository) UpsertEventSchema(ctx context.Context, schema *EventSchema) error {
+	now := time.Now()
+	query := `
+		INSERT INTO event_schemas (namespace, event, schema, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $4)
+		ON CONFLICT (namespace, event) DO UPDATE SET schema = EXCLUDED.schema, updated_at = EXCLUDED.updated_at
+		RETURNING created_at, updated_at
+	`
+
+	return r.db.QueryRow(ctx, query, schema.Namespace, schema.Event, schema.Schema, now).
+		Scan(&schema.CreatedAt, &schema.UpdatedAt)
+}
+
+// GetEventSchema returns the schema registered for a namespace/event, or ErrNotFound
+func (r *Repository) GetEventSchema(ctx context.Context, namespace, event string) (*EventSchema, error) {
+	query := `
+		SELECT namespace, event, schema::text, created_at, updated_at
+		FROM event_schemas
+		WHERE namespace = $1 AND event = $2
+	`
+
+	var schema EventSchema
+	err := r.db.QueryRow(ctx, query, namespace, event).Scan(
+		&schema.Namespace,
+		&schema.Event,
+		&schema.Schema,
+		&schema.CreatedAt,
+		&schema.UpdatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &schema, nil
+}
+
+// CompileSchema parses and compiles a JSON Schema document
+func CompileSchema(schemaJSON string) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(strings.NewReader(schemaJSON))
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema JSON: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource("event.json", doc); err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	schema, err := compiler.Compile("event.json")
+	if err != nil {
+		return nil, fmt.Errorf("invalid schema: %w", err)
+	}
+	return schema, nil
+}
+
+// validateAgainstSchema checks a payload against a compiled schema
+func validateAgainstSchema(schema *jsonschema.Schema, contentType, payload string) error {
+	if !IsJSONContentType(contentType) {
+		return fmt.Errorf("%w: event schema requires a JSON payload", ErrSchemaValidation)
+	}
+
+	instance, err := jsonschema.UnmarshalJSON(strings.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("%w: invalid JSON payload: %v", ErrSchemaValidation, err)
+	}
+	if err := schema.Validate(instance); err != nil {
+		return fmt.Errorf("%w: %v", ErrSchemaValidation, err)
+	}
+	return nil
+}
+
+// cachedSchema is a compiled schema (nil when none is registered) and when it was looked up
+type cachedSchema struct {
+	schema    *jsonschema.Schema
+	checkedAt time.Time
+}
+
+// SchemaValidator validates event payloads against registered schemas, caching compiled
+// schemas briefly so pushes don't hit the database every time
+type SchemaValidator struct {
+	repo WebhookStore
+
+	mu    sync.Mutex
+	cache map[string]cachedSchema
+}
+
+// NewSchemaValidator creates a new schema validator
+func NewSchemaValidator(repo WebhookStore) *SchemaValidator {
+	return &SchemaValidator{
+		repo:  repo,
+		cache: make(map[string]cachedSchema),
+	}
+}
+
+// Validate checks a payload against the schema registered for namespace/event. It returns
+// nil when no schema is registered and an error wrapping ErrSchemaValidation on mismatch.
+func (v *SchemaValidator) Validate(ctx context.Context, namespace, event, contentType, payload string) error {
+	schema, err := v.lookup(ctx, namespace, event)
+	if err != nil {
+		return err
+	}
+	if schema == nil {
+		return nil
+	}
+	return validateAgainstSchema(schema, contentType, payload)
+}
+
+// Invalidate drops the cached schema for namespace/event after it changes
+func (v *SchemaValidator) Invalidate(namespace, event string) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.cache, namespace+"/"+event)
+}
+
+func (v *SchemaValidator) lookup(ctx context.Context, namespace, event string) (*jsonschema.Schema, error) {
+	key := namespace + "/" + event
+
+	v.mu.Lock()
+	cached, ok := v.cache[key]
+	v.mu.Unlock()
+	if ok && time.Since(cached.checkedAt) < schemaCacheTTL {
+		return cached.schema, nil
+	}
+
+	var schema *jsonschema.Schema
+	registered, err := v.repo.GetEventSchema(ctx, namespace, event)
+	switch {
+	case errors.Is(err, ErrNotFound):
+		// No schema registered; cache the miss too
+	case err != nil:
+		return nil, fmt.Errorf("failed to load event schema: %w", err)
+	default:
+		schema, err = CompileSchema(registered.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("failed to compile event schema: %w", err)
+		}
+	}
+
+	v.mu.Lock()
+	v.cache[key] = cachedSchema{schema: schema, checkedAt: time.Now()}
+	v.mu.Unlock()
+
+	return schema, nil
+}
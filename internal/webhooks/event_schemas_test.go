@@ -0,0 +1,41 @@
+package webhooks
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateAgainstSchema(t *testing.T) {
+	schema, err := CompileSchema(`{
+		"type": "object",
+		"required": ["order_id"],
+		"properties": {"order_id": {"type": "string"}, "total": {"type": "number"}}
+	}`)
+	if err != nil {
+		t.Fatalf("CompileSchema failed: %v", err)
+	}
+
+	if err := validateAgainstSchema(schema, "", `{"order_id": "o1", "total": 9.5}`); err != nil {
+		t.Errorf("expected valid payload, got %v", err)
+	}
+
+	for _, payload := range []string{`{"total": 1}`, `{"order_id": 5}`, `not json`} {
+		err := validateAgainstSchema(schema, "application/json", payload)
+		if !errors.Is(err, ErrSchemaValidation) {
+			t.Errorf("payload %s: expected ErrSchemaValidation, got %v", payload, err)
+		}
+	}
+
+	if err := validateAgainstSchema(schema, "text/plain", `{"order_id": "o1"}`); !errors.Is(err, ErrSchemaValidation) {
+		t.Errorf("expected non-JSON content type to be rejected, got %v", err)
+	}
+}
+
+func TestCompileSchemaRejectsInvalidSchema(t *testing.T) {
+	if _, err := CompileSchema(`{"type": 5}`); err == nil {
+		t.Error("expected error for invalid schema")
+	}
+	if _, err := CompileSchema(`{`); err == nil {
+		t.Error("expected error for malformed JSON")
+	}
+}
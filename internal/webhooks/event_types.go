@@ -0,0 +1,69 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// EventType is an event name used in a namespace
+type EventType struct {
+	Event      string
+	Count      int64      // Stored events with this name in the requested range
+	LastSeenAt *time.Time // When the latest of them was pushed; nil when there are none
+	Registered bool       // A webhook in the namespace subscribes to it
+}
+
+// EventTypeListFilter selects the events counted by ListEventTypes; a zero Since or Until
+// leaves the range open on that side
+type EventTypeListFilter struct {
+	Namespace string
+	Since     time.Time
+	Until     time.Time
+}
+
+// ListEventTypes returns the distinct event names of a namespace's stored events, with how
+// many were pushed in the filter's range and when the last one was, together with the event
+// names its webhooks subscribe to. Names are sorted alphabetically.
+func (r *Repository) ListEventTypes(ctx context.Context, filter EventTypeListFilter) ([]*EventType, error) {
+	seen := `SELECT event, COUNT(*) AS count, MAX(created_at) AS last_seen_at FROM event_records WHERE namespace = $1`
+	args := []any{filter.Namespace}
+	if !filter.Since.IsZero() {
+		args = append(args, filter.Since)
+		seen += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if !filter.Until.IsZero() {
+		args = append(args, filter.Until)
+		seen += fmt.Sprintf(" AND created_at < $%d", len(args))
+	}
+	seen += " GROUP BY event"
+
+	query := `
+		WITH seen AS (` + seen + `),
+		registered AS (
+			SELECT DISTINCT jsonb_array_elements_text(events) AS event
+			FROM webhook_registrations
+			WHERE namespace = $1
+		)
+		SELECT COALESCE(s.event, r.event), COALESCE(s.count, 0), s.last_seen_at, r.event IS NOT NULL
+		FROM seen s
+		FULL JOIN registered r ON r.event = s.event
+		ORDER BY 1
+	`
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var types []*EventType
+	for rows.Next() {
+		var t EventType
+		if err := rows.Scan(&t.Event, &t.Count, &t.LastSeenAt, &t.Registered); err != nil {
+			return nil, err
+		}
+		types = append(types, &t)
+	}
+	return types, rows.Err()
+}
@@ -0,0 +1,108 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// EventListFilter selects the events returned by ListEvents
+type EventListFilter struct {
+	Namespace string
+	Event     string    // Optional event name
+	Since     time.Time // Inclusive lower bound on created_at; zero = unbounded
+	Until     time.Time // Exclusive upper bound on created_at; zero = unbounded
+	Limit     int
+
+	// Cursor continues a previous listing after the given event (see EncodeEventCursor)
+	Cursor *EventCursor
+}
+
+// EventCursor identifies the last event of a page; events are ordered by (created_at, id) descending
+type EventCursor struct {
+	CreatedAt time.Time
+	ID        string
+}
+
+// ListedEvent is an event record with the number of webhook deliveries it triggered
+type ListedEvent struct {
+	*EventRecord
+	WebhookCount int `json:"webhook_count"`
+}
+
+// ListEvents returns events in a namespace matching filter, newest first
+func (r *Repository) ListEvents(ctx context.Context, filter EventListFilter) ([]*ListedEvent, error) {
+	query := `
+		SELECT ` + eventColumns + `,
+			(SELECT COUNT(*) FROM webhook_deliveries d WHERE d.event_id = e.id)
+		FROM event_records e
+		WHERE namespace = $1
+	`
+	args := []any{filter.Namespace}
+
+	if filter.Event != "" {
+		args = append(args, filter.Event)
+		query += fmt.Sprintf(" AND event = $%d", len(args))
+	}
+	if !filter.Since.IsZero() {
+		args = append(args, filter.Since)
+		query += fmt.Sprintf(" AND created_at >= $%d", len(args))
+	}
+	if !filter.Until.IsZero() {
+		args = append(args, filter.Until)
+		query += fmt.Sprintf(" AND created_at < $%d", len(args))
+	}
+	if filter.Cursor != nil {
+		args = append(args, filter.Cursor.CreatedAt, filter.Cursor.ID)
+		query += fmt.Sprintf(" AND (created_at, id) < ($%d, $%d)", len(args)-1, len(args))
+	}
+
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY created_at DESC, id DESC LIMIT $%d", len(args))
+
+	rows, err := r.db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var events []*ListedEvent
+	for rows.Next() {
+		var webhookCount int
+		event, err := r.scanEvent(rows, &webhookCount)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, &ListedEvent{EventRecord: event, WebhookCount: webhookCount})
+	}
+
+	return events, rows.Err()
+}
+
+// EncodeEventCursor returns an opaque page token that continues a listing after the event
+func EncodeEventCursor(event *EventRecord) string {
+	raw := strconv.FormatInt(event.CreatedAt.UnixNano(), 10) + ":" + event.ID
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeEventCursor parses a page token produced by EncodeEventCursor
+func DecodeEventCursor(token string) (*EventCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+
+	nanos, id, ok := strings.Cut(string(raw), ":")
+	if !ok || id == "" {
+		return nil, fmt.Errorf("invalid page token")
+	}
+	n, err := strconv.ParseInt(nanos, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid page token")
+	}
+
+	return &EventCursor{CreatedAt: time.Unix(0, n), ID: id}, nil
+}
@@ -0,0 +1,62 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEventCursorRoundTrip(t *testing.T) {
+	event := &EventRecord{ID: "evt-1", CreatedAt: time.Unix(1700000000, 123456000)}
+
+	cursor, err := DecodeEventCursor(EncodeEventCursor(event))
+	if err != nil {
+		t.Fatalf("DecodeEventCursor failed: %v", err)
+	}
+	if cursor.ID != event.ID || !cursor.CreatedAt.Equal(event.CreatedAt) {
+		t.Errorf("got %+v, want id=%s created_at=%v", cursor, event.ID, event.CreatedAt)
+	}
+
+	if _, err := DecodeEventCursor("not a token"); err == nil {
+		t.Error("expected error for invalid token")
+	}
+}
+
+func TestEventFailureCursorRoundTrip(t *testing.T) {
+	failure := &EventProcessingFailure{
+		ID:       "3c1e7a52-0f8e-4a51-a0c4-5b4a8d0f9e21",
+		FailedAt: time.Date(2024, 5, 1, 12, 30, 0, 123456789, time.UTC),
+	}
+
+	cursor, err := DecodeEventCursor(EncodeEventFailureCursor(failure))
+	if err != nil {
+		t.Fatalf("DecodeEventCursor failed: %v", err)
+	}
+	if cursor.ID != failure.ID || !cursor.CreatedAt.Equal(failure.FailedAt) {
+		t.Errorf("got %+v, want id %q at %v", cursor, failure.ID, failure.FailedAt)
+	}
+}
+
+func TestDeliveryCursorRoundTrip(t *testing.T) {
+	delivery := &WebhookDelivery{
+		ID:        "9b2f6c1d-4e7a-4c3b-8f0d-2a6e5b1c7d90",
+		CreatedAt: time.Date(2024, 6, 3, 8, 15, 0, 987654321, time.UTC),
+	}
+
+	cursor, err := DecodeEventCursor(EncodeDeliveryCursor(delivery))
+	if err != nil {
+		t.Fatalf("DecodeEventCursor failed: %v", err)
+	}
+	if cursor.ID != delivery.ID || !cursor.CreatedAt.Equal(delivery.CreatedAt) {
+		t.Errorf("got %+v, want id %q at %v", cursor, delivery.ID, delivery.CreatedAt)
+	}
+}
+
+func TestDeliverySummaryAdd(t *testing.T) {
+	var summary DeliverySummary
+	for _, status := range []WebhookDeliveryStatus{StatusPending, StatusSending, StatusRetrying, StatusSuccess, StatusSuccess, StatusFailed, StatusExpired} {
+		summary.Add(status)
+	}
+	if want := (DeliverySummary{Total: 7, Succeeded: 2, Failed: 2, Pending: 3}); summary != want {
+		t.Errorf("summary = %+v, want %+v", summary, want)
+	}
+}
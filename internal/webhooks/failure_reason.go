@@ -0,0 +1,91 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"syscall"
+)
+
+// FailureReason classifies why a delivery attempt failed
+type FailureReason string
+
+const (
+	FailureNone              FailureReason = ""
+	FailureDNS               FailureReason = "dns_error"
+	FailureConnectionRefused FailureReason = "connection_refused"
+	FailureTLS               FailureReason = "tls_error"
+	FailureTimeout           FailureReason = "timeout"
+	FailureHTTP4xx           FailureReason = "http_4xx"
+	FailureHTTP5xx           FailureReason = "http_5xx"
+	FailureBodyMatch         FailureReason = "body_match_failed"
+	FailureHeaderTemplate    FailureReason = "header_template_error"
+	FailurePortNotAllowed    FailureReason = "port_not_allowed"
+	FailureOther             FailureReason = "other"
+)
+
+// ClassifyRequestError returns the failure reason for an error from sending a request,
+// when no response was received
+func ClassifyRequestError(err error) FailureReason {
+	if err == nil {
+		return FailureNone
+	}
+
+	if errors.Is(err, ErrPortNotAllowed) {
+		return FailurePortNotAllowed
+	}
+
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return FailureDNS
+	}
+
+	if errors.Is(err, syscall.ECONNREFUSED) {
+		return FailureConnectionRefused
+	}
+
+	var (
+		certErr     *tls.CertificateVerificationError
+		recordErr   tls.RecordHeaderError
+		alertErr    tls.AlertError
+		unknownAuth x509.UnknownAuthorityError
+		hostnameErr x509.HostnameError
+		certInvalid x509.CertificateInvalidError
+	)
+	if errors.As(err, &certErr) || errors.As(err, &recordErr) || errors.As(err, &alertErr) ||
+		errors.As(err, &unknownAuth) || errors.As(err, &hostnameErr) || errors.As(err, &certInvalid) {
+		return FailureTLS
+	}
+
+	var netErr net.Error
+	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &netErr) && netErr.Timeout()) {
+		return FailureTimeout
+	}
+
+	return FailureOther
+}
+
+// ClassifyStatusCode returns the failure reason for a non-2xx response
+func ClassifyStatusCode(statusCode int) FailureReason {
+	switch {
+	case statusCode >= 400 && statusCode < 500:
+		return FailureHTTP4xx
+	case statusCode >= 500 && statusCode < 600:
+		return FailureHTTP5xx
+	default:
+		return FailureOther
+	}
+}
+
+// FilterByFailureReason returns the deliveries whose last attempt failed for reason
+func FilterByFailureReason(deliveries []*WebhookDelivery, reason FailureReason) []*WebhookDelivery {
+	filtered := make([]*WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		if d.FailureReason == reason {
+			filtered = append(filtered, d)
+		}
+	}
+	return filtered
+}
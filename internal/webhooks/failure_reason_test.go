@@ -0,0 +1,62 @@
+package webhooks
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"syscall"
+	"testing"
+)
+
+func TestClassifyRequestError(t *testing.T) {
+	urlErr := func(err error) error {
+		return &url.Error{Op: "Post", URL: "https://example.com/hook", Err: err}
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want FailureReason
+	}{
+		{"no error", nil, FailureNone},
+		{"dns", urlErr(&net.DNSError{Err: "no such host", Name: "example.invalid", IsNotFound: true}), FailureDNS},
+		{"connection refused", urlErr(&net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}), FailureConnectionRefused},
+		{"unknown certificate authority", urlErr(&tls.CertificateVerificationError{Err: x509.UnknownAuthorityError{}}), FailureTLS},
+		{"hostname mismatch", urlErr(x509.HostnameError{Certificate: &x509.Certificate{}, Host: "example.com"}), FailureTLS},
+		{"client timeout", urlErr(context.DeadlineExceeded), FailureTimeout},
+		{"other", urlErr(errors.New("connection reset by peer")), FailureOther},
+		{"wrapped", fmt.Errorf("send: %w", urlErr(context.DeadlineExceeded)), FailureTimeout},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ClassifyRequestError(tt.err); got != tt.want {
+				t.Errorf("ClassifyRequestError() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyStatusCode(t *testing.T) {
+	tests := []struct {
+		code int
+		want FailureReason
+	}{
+		{400, FailureHTTP4xx},
+		{429, FailureHTTP4xx},
+		{500, FailureHTTP5xx},
+		{503, FailureHTTP5xx},
+		{302, FailureOther},
+	}
+
+	for _, tt := range tests {
+		if got := ClassifyStatusCode(tt.code); got != tt.want {
+			t.Errorf("ClassifyStatusCode(%d) = %q, want %q", tt.code, got, tt.want)
+		}
+	}
+}
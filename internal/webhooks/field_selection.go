@@ -0,0 +1,69 @@
+package webhooks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+)
+
+// ValidateFieldPaths checks that every path is a plain dot-separated JSON path (e.g. "user.email"
+// or "items.0.id"). Wildcards, queries and modifiers aren't supported because paths must also
+// be usable to write and delete values.
+func ValidateFieldPaths(paths []string) error {
+	for _, path := range paths {
+		if path == "" {
+			return fmt.Errorf("field paths cannot be empty")
+		}
+		if strings.ContainsAny(path, "*?#@|") {
+			return fmt.Errorf("invalid field path %q: wildcards, queries and modifiers are not supported", path)
+		}
+		if strings.HasPrefix(path, ".") || strings.HasSuffix(path, ".") || strings.Contains(path, "..") {
+			return fmt.Errorf("invalid field path %q: empty path segment", path)
+		}
+	}
+	return nil
+}
+
+// SelectFields applies a webhook's field selection to a JSON payload. When include is set only
+// those paths are kept; exclude paths are then removed. Paths missing from the payload are
+// skipped, so an include path that doesn't exist is simply absent from the result. When both
+// lists are empty the payload is returned unchanged.
+func SelectFields(payload string, include, exclude []string) (string, error) {
+	if len(include) == 0 && len(exclude) == 0 {
+		return payload, nil
+	}
+	if !gjson.Valid(payload) {
+		return "", fmt.Errorf("field selection requires a JSON payload")
+	}
+
+	result := payload
+	if len(include) > 0 {
+		result = "{}"
+		for _, path := range include {
+			value := gjson.Get(payload, path)
+			if !value.Exists() {
+				continue
+			}
+			var err error
+			result, err = sjson.SetRaw(result, path, value.Raw)
+			if err != nil {
+				return "", fmt.Errorf("failed to include field %q: %w", path, err)
+			}
+		}
+	}
+
+	for _, path := range exclude {
+		if !gjson.Get(result, path).Exists() {
+			continue
+		}
+		var err error
+		result, err = sjson.Delete(result, path)
+		if err != nil {
+			return "", fmt.Errorf("failed to exclude field %q: %w", path, err)
+		}
+	}
+
+	return result, nil
+}
@@ -0,0 +1,51 @@
+package webhooks
+
+import "testing"
+
+func TestSelectFields(t *testing.T) {
+	payload := `{"id":"ord_1","user":{"email":"a@example.com","name":"Ann"},"total":42}`
+
+	tests := []struct {
+		name    string
+		include []string
+		exclude []string
+		want    string
+	}{
+		{"no selection", nil, nil, payload},
+		{"include", []string{"id", "user.name"}, nil, `{"id":"ord_1","user":{"name":"Ann"}}`},
+		{"include missing path", []string{"id", "missing.field"}, nil, `{"id":"ord_1"}`},
+		{"exclude", nil, []string{"user.email"}, `{"id":"ord_1","user":{"name":"Ann"},"total":42}`},
+		{"exclude missing path", nil, []string{"nope"}, payload},
+		{"include then exclude", []string{"user"}, []string{"user.email"}, `{"user":{"name":"Ann"}}`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SelectFields(payload, tt.include, tt.exclude)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("SelectFields() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSelectFieldsRejectsNonJSON(t *testing.T) {
+	if _, err := SelectFields("a,b,c", []string{"a"}, nil); err == nil {
+		t.Error("expected error for non-JSON payload")
+	}
+}
+
+func TestValidateFieldPaths(t *testing.T) {
+	if err := ValidateFieldPaths([]string{"user.email", "items.0.id"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	for _, path := range []string{"", "items.#.id", "user.*", "a..b", ".a"} {
+		if err := ValidateFieldPaths([]string{path}); err == nil {
+			t.Errorf("expected error for path %q", path)
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package webhooks
+
+import (
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// isHeaderTemplate reports whether a header value contains template placeholders; other
+// values are sent as they are
+func isHeaderTemplate(value string) bool {
+	return strings.Contains(value, "{{")
+}
+
+// parseHeaderTemplate parses a templated header value. Referencing a field or metadata key
+// the delivery doesn't have is an error rather than an empty value.
+func parseHeaderTemplate(key, value string) (*template.Template, error) {
+	return template.New(key).Option("missingkey=error").Parse(value)
+}
+
+// ValidateHeaderTemplates checks that every templated header value parses
+func ValidateHeaderTemplates(headers map[string]string) error {
+	for key, value := range headers {
+		if !isHeaderTemplate(value) {
+			continue
+		}
+		if _, err := parseHeaderTemplate(key, value); err != nil {
+			return fmt.Errorf("invalid template in header %q: %w", key, err)
+		}
+	}
+	return nil
+}
+
+// HeaderTemplateData returns the fields header templates can reference: {{.event_id}},
+// {{.namespace}}, {{.event}}, {{.delivery_id}}, {{.webhook_id}} and {{.metadata.<key>}}
+func HeaderTemplateData(eventID, namespace, event, deliveryID, webhookID string, metadata map[string]string) map[string]any {
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	return map[string]any{
+		"event_id":    eventID,
+		"namespace":   namespace,
+		"event":       event,
+		"delivery_id": deliveryID,
+		"webhook_id":  webhookID,
+		"metadata":    metadata,
+	}
+}
+
+// RenderHeaders returns headers with templated values rendered against data
+func RenderHeaders(headers map[string]string, data map[string]any) (map[string]string, error) {
+	rendered := make(map[string]string, len(headers))
+	for key, value := range headers {
+		if !isHeaderTemplate(value) {
+			rendered[key] = value
+			continue
+		}
+
+		tmpl, err := parseHeaderTemplate(key, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template in header %q: %w", key, err)
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, data); err != nil {
+			return nil, fmt.Errorf("failed to render header %q: %w", key, err)
+		}
+		if strings.ContainsAny(sb.String(), "\r\n") {
+			return nil, fmt.Errorf("rendered header %q contains a line break", key)
+		}
+		rendered[key] = sb.String()
+	}
+	return rendered, nil
+}
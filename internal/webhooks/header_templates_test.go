@@ -0,0 +1,54 @@
+package webhooks
+
+import "testing"
+
+func TestValidateHeaderTemplates(t *testing.T) {
+	valid := map[string]string{
+		"X-Idempotency-Key": "{{.event_id}}",
+		"X-Tenant":          "{{.metadata.tenant}}",
+		"X-Static":          "plain value",
+	}
+	if err := ValidateHeaderTemplates(valid); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := ValidateHeaderTemplates(map[string]string{"X-Bad": "{{.event_id"}); err == nil {
+		t.Error("expected error for an unterminated template")
+	}
+}
+
+func TestRenderHeaders(t *testing.T) {
+	headers := map[string]string{
+		"X-Idempotency-Key": "{{.event_id}}-{{.webhook_id}}",
+		"X-Tenant":          "{{.metadata.tenant}}",
+		"X-Static":          "plain value",
+	}
+	data := HeaderTemplateData("evt-1", "orders", "order.created", "dlv-1", "wh-1", map[string]string{"tenant": "acme"})
+
+	rendered, err := RenderHeaders(headers, data)
+	if err != nil {
+		t.Fatalf("RenderHeaders failed: %v", err)
+	}
+	want := map[string]string{
+		"X-Idempotency-Key": "evt-1-wh-1",
+		"X-Tenant":          "acme",
+		"X-Static":          "plain value",
+	}
+	for key, value := range want {
+		if rendered[key] != value {
+			t.Errorf("%s = %q, want %q", key, rendered[key], value)
+		}
+	}
+
+	// Missing metadata keys fail rather than render empty
+	data = HeaderTemplateData("evt-1", "orders", "order.created", "dlv-1", "wh-1", nil)
+	if _, err := RenderHeaders(headers, data); err == nil {
+		t.Error("expected error for a missing metadata key")
+	}
+
+	// Rendered values can't smuggle in extra header lines
+	data = HeaderTemplateData("evt-1", "orders", "order.created", "dlv-1", "wh-1", map[string]string{"tenant": "acme\r\nX-Evil: 1"})
+	if _, err := RenderHeaders(headers, data); err == nil {
+		t.Error("expected error for a rendered line break")
+	}
+}
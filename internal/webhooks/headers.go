@@ -0,0 +1,62 @@
+package webhooks
+
+import (
+	"net/http"
+	"strings"
+)
+
+// EnvironmentHeader carries the deployment environment on every delivery; a webhook can set
+// its own value with a header of the same name
+const EnvironmentHeader = "X-Sparrow-Environment"
+
+// RedactedHeaderValue replaces the value of sensitive headers in captured headers
+const RedactedHeaderValue = "[REDACTED]"
+
+// sensitiveHeaders are never stored in plain text
+var sensitiveHeaders = map[string]bool{
+	"Authorization":       true,
+	"Proxy-Authorization": true,
+	"Cookie":              true,
+	"Set-Cookie":          true,
+	"X-Api-Key":           true,
+}
+
+// CaptureHeaders flattens headers for storage, joining repeated values with ", " and
+// redacting credentials. It returns nil for no headers.
+func CaptureHeaders(h http.Header) map[string]string {
+	if len(h) == 0 {
+		return nil
+	}
+
+	captured := make(map[string]string, len(h))
+	for name, values := range h {
+		name = http.CanonicalHeaderKey(name)
+		if sensitiveHeaders[name] {
+			captured[name] = RedactedHeaderValue
+			continue
+		}
+		captured[name] = strings.Join(values, ", ")
+	}
+	return captured
+}
+
+// MergeHeaders returns a webhook's headers on top of its namespace's default headers.
+// Names are compared case-insensitively, and the webhook's own value wins on conflict.
+func MergeHeaders(defaults, own map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return own
+	}
+
+	merged := make(map[string]string, len(defaults)+len(own))
+	overridden := make(map[string]bool, len(own))
+	for name, value := range own {
+		merged[name] = value
+		overridden[http.CanonicalHeaderKey(name)] = true
+	}
+	for name, value := range defaults {
+		if !overridden[http.CanonicalHeaderKey(name)] {
+			merged[name] = value
+		}
+	}
+	return merged
+}
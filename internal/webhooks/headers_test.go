@@ -0,0 +1,65 @@
+package webhooks
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestCaptureHeaders(t *testing.T) {
+	if got := CaptureHeaders(nil); got != nil {
+		t.Errorf("CaptureHeaders(nil) = %v, want nil", got)
+	}
+
+	h := http.Header{}
+	h.Set("Content-Type", "application/json")
+	h.Set("Authorization", "Bearer secret")
+	h.Add("Set-Cookie", "a=1")
+	h.Add("Vary", "Origin")
+	h.Add("Vary", "Accept")
+
+	got := CaptureHeaders(h)
+	want := map[string]string{
+		"Content-Type":  "application/json",
+		"Authorization": RedactedHeaderValue,
+		"Set-Cookie":    RedactedHeaderValue,
+		"Vary":          "Origin, Accept",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("CaptureHeaders() = %v, want %v", got, want)
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("CaptureHeaders()[%q] = %q, want %q", name, got[name], value)
+		}
+	}
+}
+
+func TestMergeHeaders(t *testing.T) {
+	own := map[string]string{"X-Team": "payments"}
+	if got := MergeHeaders(nil, own); len(got) != 1 || got["X-Team"] != "payments" {
+		t.Errorf("MergeHeaders(nil, own) = %v, want %v", got, own)
+	}
+
+	defaults := map[string]string{
+		"Authorization": "Bearer namespace",
+		"x-team":        "platform",
+		"X-Env":         "prod",
+	}
+	got := MergeHeaders(defaults, map[string]string{
+		"authorization": "Bearer webhook",
+		"X-Team":        "payments",
+	})
+	want := map[string]string{
+		"authorization": "Bearer webhook",
+		"X-Team":        "payments",
+		"X-Env":         "prod",
+	}
+	if len(got) != len(want) {
+		t.Fatalf("MergeHeaders() = %v, want %v", got, want)
+	}
+	for name, value := range want {
+		if got[name] != value {
+			t.Errorf("MergeHeaders()[%q] = %q, want %q", name, got[name], value)
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// WebhookHealth holds a webhook's recent delivery outcomes. Attempts are counted in fixed
+// windows; the previous window's counts fade out as the current one fills, giving a rolling
+// view over roughly one window.
+type WebhookHealth struct {
+	WebhookID     string     `json:"webhook_id" db:"webhook_id"`
+	WindowStart   time.Time  `json:"window_start" db:"window_start"`
+	Successes     int        `json:"successes" db:"successes"`
+	Failures      int        `json:"failures" db:"failures"`
+	PrevSuccesses int        `json:"prev_successes" db:"prev_successes"`
+	PrevFailures  int        `json:"prev_failures" db:"prev_failures"`
+	DisabledAt    *time.Time `json:"disabled_at,omitempty" db:"disabled_at"` // When it was disabled for failing; nil = never
+}
+
+// Score returns the weighted number of attempts over the rolling window ending at now, and
+// the fraction of them that succeeded (1 when there were none)
+func (h *WebhookHealth) Score(now time.Time, window time.Duration) (attempts, successRate float64) {
+	var successes, failures float64
+
+	// Weigh each stored window by how much of it still overlaps the rolling window. Without
+	// attempts since, the current window may itself have become the previous one.
+	elapsed := max(now.Sub(h.WindowStart), 0)
+	switch {
+	case elapsed < window:
+		weight := 1 - float64(elapsed)/float64(window)
+		successes = float64(h.Successes) + float64(h.PrevSuccesses)*weight
+		failures = float64(h.Failures) + float64(h.PrevFailures)*weight
+	case elapsed < 2*window:
+		weight := 1 - float64(elapsed-window)/float64(window)
+		successes = float64(h.Successes) * weight
+		failures = float64(h.Failures) * weight
+	}
+
+	attempts = successes + failures
+	if attempts == 0 {
+		return 0, 1
+	}
+	return attempts, successes / attempts
+}
+
+// HealthScore is a webhook's health as reported to clients
+type HealthScore struct {
+	Attempts    float64    // Attempts in the rolling window, older ones weighted down
+	SuccessRate float64    // Fraction of those attempts that succeeded; 1 without attempts
+	DisabledAt  *time.Time // When the webhook was disabled for failing; nil = never
+}
+
+// ScoreAt scores the health over the rolling window ending at now
+func (h *WebhookHealth) ScoreAt(now time.Time, window time.Duration) HealthScore {
+	attempts, successRate := h.Score(now, window)
+	return HealthScore{Attempts: attempts, SuccessRate: successRate, DisabledAt: h.DisabledAt}
+}
+
+// healthColumns is the column list shared by webhook health queries
+const healthColumns = `webhook_id, window_start, successes, failures, prev_successes, prev_failures, disabled_at`
+
+// RecordWebhookHealth counts a delivery attempt to a webhook at now, starting a new window
+// once the current one is window old, and returns the updated health
+func (r *Repository) RecordWebhookHealth(ctx context.Context, webhookID string, success bool, now time.Time, window time.Duration) (*WebhookHealth, error) {
+	// Later windows are aligned to the first; after a gap of two windows or more, the counts
+	// start over at now
+	query := `
+		INSERT INTO webhook_health (webhook_id, window_start, successes, failures)
+		VALUES ($1, $2, $3, $4)
+		ON CONFLICT (webhook_id) DO UPDATE SET
+			window_start = CASE
+				WHEN $2 >= webhook_health.window_start + 2 * $5::bigint * INTERVAL '1 millisecond' THEN $2
+				WHEN $2 >= webhook_health.window_start + $5::bigint * INTERVAL '1 millisecond'
+					THEN webhook_health.window_start + $5::bigint * INTERVAL '1 millisecond'
+				ELSE webhook_health.window_start END,
+			prev_successes = CASE
+				WHEN $2 >= webhook_health.window_start + 2 * $5::bigint * INTERVAL '1 millisecond' THEN 0
+				WHEN $2 >= webhook_health.window_start + $5::bigint * INTERVAL '1 millisecond' THEN webhook_health.successes
+				ELSE webhook_health.prev_successes END,
+			prev_failures = CASE
+				WHEN $2 >= webhook_health.window_start + 2 * $5::bigint * INTERVAL '1 millisecond' THEN 0
+				WHEN $2 >= webhook_health.window_start + $5::bigint * INTERVAL '1 millisecond' THEN webhook_health.failures
+				ELSE webhook_health.prev_failures END,
+			successes = $3 + CASE
+				WHEN $2 >= webhook_health.window_start + $5::bigint * INTERVAL '1 millisecond' THEN 0
+				ELSE webhook_health.successes END,
+			failures = $4 + CASE
+				WHEN $2 >= webhook_health.window_start + $5::bigint * INTERVAL '1 millisecond' THEN 0
+				ELSE webhook_health.failures END
+		RETURNING ` + healthColumns
+
+	successes, failures := 0, 1
+	if success {
+		successes, failures = 1, 0
+	}
+
+	return scanWebhookHealth(r.db.QueryRow(ctx, query, webhookID, now, successes, failures, window.Milliseconds()))
+}
+
+// GetWebhookHealth returns a webhook's health, or ErrNotFound if no attempt has been counted
+// since it was registered or last resumed
+func (r *Repository) GetWebhookHealth(ctx context.Context, webhookID string) (*WebhookHealth, error) {
+	query := `SELECT ` + healthColumns + ` FROM webhook_health WHERE webhook_id = $1`
+
+	h, err := scanWebhookHealth(r.db.QueryRow(ctx, query, webhookID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return h, err
+}
+
+// DisableUnhealthyWebhook marks an active webhook inactive because it keeps failing, and
+// reports whether it did
+func (r *Repository) DisableUnhealthyWebhook(ctx context.Context, webhookID string, now time.Time) (bool, error) {
+	query := `
+		WITH disabled AS (
+			UPDATE webhook_registrations
+			SET active = false, updated_at = $2
+			WHERE id = $1 AND active
+			RETURNING id
+		)
+		UPDATE webhook_health h
+		SET disabled_at = $2
+		FROM disabled
+		WHERE h.webhook_id = disabled.id
+	`
+
+	tag, err := r.db.Exec(ctx, query, webhookID, now)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func scanWebhookHealth(row pgx.Row) (*WebhookHealth, error) {
+	var h WebhookHealth
+	err := row.Scan(
+		&h.WebhookID,
+		&h.WindowStart,
+		&h.Successes,
+		&h.Failures,
+		&h.PrevSuccesses,
+		&h.PrevFailures,
+		&h.DisabledAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &h, nil
+}
@@ -0,0 +1,42 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestWebhookHealthScore(t *testing.T) {
+	start := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	window := time.Hour
+
+	tests := []struct {
+		name         string
+		health       WebhookHealth
+		now          time.Time
+		wantAttempts float64
+		wantRate     float64
+	}{
+		{"no attempts", WebhookHealth{WindowStart: start}, start, 0, 1},
+		{"current window only", WebhookHealth{WindowStart: start, Successes: 3, Failures: 1}, start.Add(10 * time.Minute), 4, 0.75},
+		{
+			"previous window fades out",
+			WebhookHealth{WindowStart: start, Successes: 1, Failures: 1, PrevSuccesses: 4, PrevFailures: 0},
+			start.Add(30 * time.Minute), 4, 0.75,
+		},
+		{
+			"stale current window fades out",
+			WebhookHealth{WindowStart: start, Successes: 0, Failures: 10, PrevSuccesses: 10},
+			start.Add(90 * time.Minute), 5, 0,
+		},
+		{"older than two windows", WebhookHealth{WindowStart: start, Failures: 10}, start.Add(2 * window), 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts, rate := tt.health.Score(tt.now, window)
+			if attempts != tt.wantAttempts || rate != tt.wantRate {
+				t.Errorf("Score() = %v attempts at %v, want %v at %v", attempts, rate, tt.wantAttempts, tt.wantRate)
+			}
+		})
+	}
+}
@@ -0,0 +1,1390 @@
+package webhooks
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+)
+
+// MemoryStore is a WebhookStore that keeps everything in memory, for tests that exercise
+// the servers and workers without Postgres. It follows Repository's semantics, except that
+// there are no River jobs: deliveries have no job args, and its transactions only support
+// the store's own *Tx methods. Payloads are kept as pushed, never compressed or encrypted.
+type MemoryStore struct {
+	mu sync.Mutex
+
+	webhooks    map[string]*WebhookRegistration
+	credentials map[string]*WebhookCredentials
+	signing     map[string]*SigningSecrets
+	health      map[string]*WebhookHealth
+	events      map[string]*EventRecord
+	deliveries  map[string]*memoryDelivery
+	failures    []*EventProcessingFailure
+	schemas     map[string]*EventSchema
+	namespaces  map[string]*memoryNamespace
+	apiKeys     map[string]*APIKey
+
+	strictNamespaces        bool
+	maxWebhooksPerNamespace int
+}
+
+// memoryDelivery is a stored delivery with the columns WebhookDelivery doesn't expose
+type memoryDelivery struct {
+	WebhookDelivery
+	deliveredAt *time.Time
+	durationMs  int64     // Request duration of the last attempt; 0 when nothing was sent
+	updatedAt   time.Time // When the status or attempt count last changed, like updated_at
+}
+
+// memoryNamespace is a created namespace and its defaults
+type memoryNamespace struct {
+	Namespace
+	defaults    NamespaceDefaults
+	maxWebhooks *int // Overrides the store's webhook limit when set
+}
+
+// NewMemoryStore creates an empty in-memory store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		webhooks:    make(map[string]*WebhookRegistration),
+		credentials: make(map[string]*WebhookCredentials),
+		signing:     make(map[string]*SigningSecrets),
+		health:      make(map[string]*WebhookHealth),
+		events:      make(map[string]*EventRecord),
+		deliveries:  make(map[string]*memoryDelivery),
+		schemas:     make(map[string]*EventSchema),
+		namespaces:  make(map[string]*memoryNamespace),
+		apiKeys:     make(map[string]*APIKey),
+	}
+}
+
+// EnableStrictNamespaces requires namespaces to be created before use, like
+// Repository.EnableStrictNamespaces
+func (s *MemoryStore) EnableStrictNamespaces() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.strictNamespaces = true
+}
+
+// LimitWebhooksPerNamespace caps the webhooks a namespace can register, like
+// Repository.LimitWebhooksPerNamespace
+func (s *MemoryStore) LimitWebhooksPerNamespace(max int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.maxWebhooksPerNamespace = max
+}
+
+// SetNamespaceMaxWebhooks overrides the webhook limit of a namespace, like setting its
+// max_webhooks column, or returns ErrNamespaceNotFound
+func (s *MemoryStore) SetNamespaceMaxWebhooks(namespace string, max int) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ns, ok := s.namespaces[namespace]
+	if !ok {
+		return ErrNamespaceNotFound
+	}
+	ns.maxWebhooks = &max
+	return nil
+}
+
+// memoryTx is a transaction of a MemoryStore. Changes made with the store's *Tx methods are
+// applied on Commit; the rest of pgx.Tx is not implemented.
+type memoryTx struct {
+	pgx.Tx
+	store  *MemoryStore
+	ops    []func()
+	closed bool
+}
+
+// Commit applies the transaction's changes
+func (tx *memoryTx) Commit(ctx context.Context) error {
+	if tx.closed {
+		return pgx.ErrTxClosed
+	}
+	tx.closed = true
+
+	tx.store.mu.Lock()
+	defer tx.store.mu.Unlock()
+	for _, op := range tx.ops {
+		op()
+	}
+	return nil
+}
+
+// Rollback discards the transaction's changes
+func (tx *memoryTx) Rollback(ctx context.Context) error {
+	if tx.closed {
+		return pgx.ErrTxClosed
+	}
+	tx.closed = true
+	return nil
+}
+
+// BeginTx starts a transaction for the store's *Tx methods
+func (s *MemoryStore) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return &memoryTx{store: s}, nil
+}
+
+// queue records op to run when tx commits
+func (s *MemoryStore) queue(tx pgx.Tx, op func()) error {
+	mtx, ok := tx.(*memoryTx)
+	if !ok || mtx.store != s {
+		return fmt.Errorf("transaction was not started by this store")
+	}
+	if mtx.closed {
+		return pgx.ErrTxClosed
+	}
+	mtx.ops = append(mtx.ops, op)
+	return nil
+}
+
+// RegisterWebhook stores a new webhook registration
+func (s *MemoryStore) RegisterWebhook(ctx context.Context, registration *WebhookRegistration) error {
+	registration.ID = uuid.New().String()
+	registration.CreatedAt = time.Now()
+	registration.UpdatedAt = registration.CreatedAt
+	if registration.MaxAttempts <= 0 {
+		registration.MaxAttempts = DefaultMaxAttempts
+	}
+
+	stored := *registration
+	stored.Credentials = nil
+	stored.SigningSecret = ""
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var override *int
+	if ns, ok := s.namespaces[registration.Namespace]; ok {
+		override = ns.maxWebhooks
+	}
+	if limit := WebhookQuota(s.maxWebhooksPerNamespace, override); limit > 0 {
+		if count := s.countWebhooks(registration.Namespace); count >= limit {
+			return fmt.Errorf("%w: namespace %q has %d of %d webhooks", ErrWebhookQuotaExceeded, registration.Namespace, count, limit)
+		}
+	}
+
+	s.webhooks[stored.ID] = &stored
+	if registration.Credentials != nil {
+		creds := *registration.Credentials
+		s.credentials[stored.ID] = &creds
+	}
+	if registration.SigningSecret != "" {
+		s.signing[stored.ID] = &SigningSecrets{Secret: registration.SigningSecret}
+	}
+	return nil
+}
+
+// CountWebhooks returns how many webhooks a namespace has registered, paused ones included
+func (s *MemoryStore) CountWebhooks(ctx context.Context, namespace string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.countWebhooks(namespace), nil
+}
+
+func (s *MemoryStore) countWebhooks(namespace string) int {
+	count := 0
+	for _, wh := range s.webhooks {
+		if wh.Namespace == namespace {
+			count++
+		}
+	}
+	return count
+}
+
+// UnregisterWebhook removes a webhook registration with its credentials, health and deliveries
+func (s *MemoryStore) UnregisterWebhook(ctx context.Context, webhookID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.deleteWebhook(webhookID)
+	return nil
+}
+
+func (s *MemoryStore) deleteWebhook(webhookID string) {
+	delete(s.webhooks, webhookID)
+	delete(s.credentials, webhookID)
+	delete(s.signing, webhookID)
+	delete(s.health, webhookID)
+	for id, d := range s.deliveries {
+		if d.WebhookID == webhookID {
+			delete(s.deliveries, id)
+		}
+	}
+}
+
+// SetWebhookActive pauses or resumes a webhook, like Repository.SetWebhookActive
+func (s *MemoryStore) SetWebhookActive(ctx context.Context, webhookID string, active bool) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wh, ok := s.webhooks[webhookID]
+	if !ok {
+		return false, ErrNotFound
+	}
+	if active {
+		delete(s.health, webhookID)
+	}
+	changed := wh.Active != active
+	wh.Active = active
+	wh.UpdatedAt = time.Now()
+	return changed, nil
+}
+
+// DeactivateExpiredWebhooks marks active webhooks expired at or before now inactive
+func (s *MemoryStore) DeactivateExpiredWebhooks(ctx context.Context, now time.Time) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	for _, wh := range s.webhooks {
+		if wh.Active && wh.ExpiresAt != nil && !wh.ExpiresAt.After(now) {
+			wh.Active = false
+			wh.UpdatedAt = now
+			n++
+		}
+	}
+	return n, nil
+}
+
+// DeleteWebhooksByFilter removes a namespace's webhooks, limited to those listening for
+// event when it is non-empty, and returns the number deleted and how many of those were active
+func (s *MemoryStore) DeleteWebhooksByFilter(ctx context.Context, namespace, event string) (deleted, active int64, err error) {
+	if namespace == "" {
+		return 0, 0, fmt.Errorf("namespace is required")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for id, wh := range s.webhooks {
+		if wh.Namespace == namespace && (event == "" || slices.Contains(wh.Events, event)) {
+			if wh.Active {
+				active++
+			}
+			s.deleteWebhook(id)
+			deleted++
+		}
+	}
+	return deleted, active, nil
+}
+
+// GetWebhookByID returns a webhook registration by ID, or ErrNotFound
+func (s *MemoryStore) GetWebhookByID(ctx context.Context, webhookID string) (*WebhookRegistration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wh, ok := s.webhooks[webhookID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *wh
+	return &c, nil
+}
+
+// findWebhooks returns copies of the webhooks matching match, ordered by ID
+func (s *MemoryStore) findWebhooks(match func(*WebhookRegistration) bool) []*WebhookRegistration {
+	var found []*WebhookRegistration
+	for _, wh := range s.webhooks {
+		if match(wh) {
+			c := *wh
+			found = append(found, &c)
+		}
+	}
+	slices.SortFunc(found, func(a, b *WebhookRegistration) int { return cmp.Compare(a.ID, b.ID) })
+	return found
+}
+
+// receivesEvent reports whether a webhook is active, unexpired and listening for namespace/event
+func receivesEvent(wh *WebhookRegistration, namespace, event string, now time.Time) bool {
+	return wh.Namespace == namespace && wh.Active && slices.Contains(wh.Events, event) &&
+		(wh.ExpiresAt == nil || wh.ExpiresAt.After(now))
+}
+
+// GetWebhooksByEvent returns all active, unexpired webhooks for a namespace/event
+func (s *MemoryStore) GetWebhooksByEvent(ctx context.Context, namespace, event string) ([]*WebhookRegistration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	return s.findWebhooks(func(wh *WebhookRegistration) bool {
+		return receivesEvent(wh, namespace, event, now)
+	}), nil
+}
+
+// GetWebhooksByEventPage returns up to limit webhooks for a namespace/event after afterID
+func (s *MemoryStore) GetWebhooksByEventPage(ctx context.Context, namespace, event, afterID string, limit int) ([]*WebhookRegistration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	found := s.findWebhooks(func(wh *WebhookRegistration) bool {
+		return wh.ID > afterID && receivesEvent(wh, namespace, event, now)
+	})
+	return found[:min(len(found), limit)], nil
+}
+
+// ListWebhooks returns webhooks for a namespace, newest first
+func (s *MemoryStore) ListWebhooks(ctx context.Context, namespace string, activeOnly bool) ([]*WebhookRegistration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := s.findWebhooks(func(wh *WebhookRegistration) bool {
+		return wh.Namespace == namespace && (wh.Active || !activeOnly)
+	})
+	slices.SortStableFunc(found, func(a, b *WebhookRegistration) int { return b.CreatedAt.Compare(a.CreatedAt) })
+	return found, nil
+}
+
+// ListAllWebhooks returns webhooks across all namespaces matching filter, ordered by ID
+func (s *MemoryStore) ListAllWebhooks(ctx context.Context, filter WebhookListFilter) ([]*WebhookRegistration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := s.findWebhooks(func(wh *WebhookRegistration) bool {
+		return (wh.Active || !filter.ActiveOnly) && strings.Contains(wh.URL, filter.URLContains) && wh.ID > filter.AfterID
+	})
+	return found[:min(len(found), filter.Limit)], nil
+}
+
+// GetWebhookCredentials returns a webhook's delivery credentials, or ErrNotFound
+func (s *MemoryStore) GetWebhookCredentials(ctx context.Context, webhookID string) (*WebhookCredentials, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	creds, ok := s.credentials[webhookID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *creds
+	return &c, nil
+}
+
+// GetSigningSecrets returns a webhook's signing secrets, or ErrNotFound
+func (s *MemoryStore) GetSigningSecrets(ctx context.Context, webhookID string) (*SigningSecrets, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets, ok := s.signing[webhookID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *secrets
+	return &c, nil
+}
+
+// RotateSigningSecretTx makes secret a webhook's signing secret when tx commits, keeping the
+// current one as the previous secret until previousExpiresAt. It returns ErrNotFound if the
+// webhook isn't signed.
+func (s *MemoryStore) RotateSigningSecretTx(ctx context.Context, tx pgx.Tx, webhookID, secret string, previousExpiresAt time.Time) error {
+	s.mu.Lock()
+	_, ok := s.signing[webhookID]
+	s.mu.Unlock()
+	if !ok {
+		return ErrNotFound
+	}
+
+	return s.queue(tx, func() {
+		current, ok := s.signing[webhookID]
+		if !ok {
+			return
+		}
+		s.signing[webhookID] = &SigningSecrets{
+			Secret:            secret,
+			PreviousSecret:    current.Secret,
+			PreviousExpiresAt: &previousExpiresAt,
+		}
+	})
+}
+
+// ClearExpiredPreviousSecret removes a webhook's previous signing secret once its grace
+// period has ended at now, reporting whether there was one to remove
+func (s *MemoryStore) ClearExpiredPreviousSecret(ctx context.Context, webhookID string, now time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	secrets, ok := s.signing[webhookID]
+	if !ok || secrets.PreviousExpiresAt == nil || secrets.PreviousExpiresAt.After(now) {
+		return false, nil
+	}
+	s.signing[webhookID] = &SigningSecrets{Secret: secrets.Secret}
+	return true, nil
+}
+
+// GetWebhookNamespace returns the namespace a webhook is registered in
+func (s *MemoryStore) GetWebhookNamespace(ctx context.Context, webhookID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wh, ok := s.webhooks[webhookID]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return wh.Namespace, nil
+}
+
+// RecordWebhookHealth counts a delivery attempt to a webhook at now, like
+// Repository.RecordWebhookHealth
+func (s *MemoryStore) RecordWebhookHealth(ctx context.Context, webhookID string, success bool, now time.Time, window time.Duration) (*WebhookHealth, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.health[webhookID]
+	switch {
+	case !ok:
+		h = &WebhookHealth{WebhookID: webhookID, WindowStart: now}
+		s.health[webhookID] = h
+	case !now.Before(h.WindowStart.Add(2 * window)):
+		*h = WebhookHealth{WebhookID: webhookID, WindowStart: now, DisabledAt: h.DisabledAt}
+	case !now.Before(h.WindowStart.Add(window)):
+		h.WindowStart = h.WindowStart.Add(window)
+		h.PrevSuccesses, h.PrevFailures = h.Successes, h.Failures
+		h.Successes, h.Failures = 0, 0
+	}
+	if success {
+		h.Successes++
+	} else {
+		h.Failures++
+	}
+
+	c := *h
+	return &c, nil
+}
+
+// GetWebhookHealth returns a webhook's health, or ErrNotFound if no attempt has been counted
+func (s *MemoryStore) GetWebhookHealth(ctx context.Context, webhookID string) (*WebhookHealth, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	h, ok := s.health[webhookID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *h
+	return &c, nil
+}
+
+// DisableUnhealthyWebhook marks an active webhook inactive because it keeps failing, and
+// reports whether it did
+func (s *MemoryStore) DisableUnhealthyWebhook(ctx context.Context, webhookID string, now time.Time) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	wh, ok := s.webhooks[webhookID]
+	if !ok || !wh.Active {
+		return false, nil
+	}
+	wh.Active = false
+	wh.UpdatedAt = now
+
+	h, ok := s.health[webhookID]
+	if !ok {
+		return false, nil
+	}
+	h.DisabledAt = &now
+	return true, nil
+}
+
+// StoreEvent stores an event record, keeping any existing record with the same ID
+func (s *MemoryStore) StoreEvent(ctx context.Context, event *EventRecord) error {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	event.CreatedAt = time.Now()
+	event.ExpiresAt = event.CreatedAt.Add(time.Duration(event.TTL) * time.Second)
+	event.Compressed = false
+	event.KeyVersion = 0
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.storeEvent(event)
+	return nil
+}
+
+// StoreEventTx stores an event record when tx commits
+func (s *MemoryStore) StoreEventTx(ctx context.Context, tx pgx.Tx, event *EventRecord) error {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
+	event.CreatedAt = time.Now()
+	event.ExpiresAt = event.CreatedAt.Add(time.Duration(event.TTL) * time.Second)
+	event.Compressed = false
+	event.KeyVersion = 0
+
+	stored := *event
+	return s.queue(tx, func() { s.storeEvent(&stored) })
+}
+
+// storeEvent keeps the first record stored under an event's ID; the caller must hold s.mu
+func (s *MemoryStore) storeEvent(event *EventRecord) {
+	if _, ok := s.events[event.ID]; !ok {
+		c := *event
+		s.events[event.ID] = &c
+	}
+}
+
+// GetEvent returns an event record by ID, or ErrNotFound
+func (s *MemoryStore) GetEvent(ctx context.Context, eventID string) (*EventRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event, ok := s.events[eventID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *event
+	c.Deliveries = s.deliverySummary(eventID)
+	return &c, nil
+}
+
+// deliverySummary counts an event's deliveries by outcome, which Repository keeps on the
+// event record; the caller must hold s.mu
+func (s *MemoryStore) deliverySummary(eventID string) DeliverySummary {
+	var summary DeliverySummary
+	for _, d := range s.deliveries {
+		if d.EventID == eventID {
+			summary.Add(d.Status)
+		}
+	}
+	return summary
+}
+
+// GetEventNamespace returns the namespace an event was pushed to
+func (s *MemoryStore) GetEventNamespace(ctx context.Context, eventID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	event, ok := s.events[eventID]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return event.Namespace, nil
+}
+
+// GetDeliveryNamespace returns the namespace of the webhook a delivery is for
+func (s *MemoryStore) GetDeliveryNamespace(ctx context.Context, deliveryID string) (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.deliveries[deliveryID]
+	if !ok {
+		return "", ErrNotFound
+	}
+	wh, ok := s.webhooks[d.WebhookID]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return wh.Namespace, nil
+}
+
+// inRange reports whether t is in [since, until), where zero bounds are open
+func inRange(t, since, until time.Time) bool {
+	return (since.IsZero() || !t.Before(since)) && (until.IsZero() || t.Before(until))
+}
+
+// beforeCursor reports whether (t, id) sorts before cursor in a newest-first listing
+func beforeCursor(t time.Time, id string, cursor *EventCursor) bool {
+	if cursor == nil {
+		return true
+	}
+	if c := t.Compare(cursor.CreatedAt); c != 0 {
+		return c < 0
+	}
+	return id < cursor.ID
+}
+
+// newestFirst orders records by (t, id) descending
+func newestFirst(ta time.Time, ida string, tb time.Time, idb string) int {
+	if c := tb.Compare(ta); c != 0 {
+		return c
+	}
+	return cmp.Compare(idb, ida)
+}
+
+// ListEvents returns events in a namespace matching filter, newest first
+func (s *MemoryStore) ListEvents(ctx context.Context, filter EventListFilter) ([]*ListedEvent, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var events []*ListedEvent
+	for _, e := range s.events {
+		if e.Namespace != filter.Namespace || (filter.Event != "" && e.Event != filter.Event) ||
+			!inRange(e.CreatedAt, filter.Since, filter.Until) || !beforeCursor(e.CreatedAt, e.ID, filter.Cursor) {
+			continue
+		}
+		c := *e
+		c.Deliveries = s.deliverySummary(e.ID)
+		events = append(events, &ListedEvent{EventRecord: &c, WebhookCount: c.Deliveries.Total})
+	}
+	slices.SortFunc(events, func(a, b *ListedEvent) int {
+		return newestFirst(a.CreatedAt, a.ID, b.CreatedAt, b.ID)
+	})
+	return events[:min(len(events), filter.Limit)], nil
+}
+
+// ListEventTypes returns the event names of a namespace's stored events and webhooks, like
+// Repository.ListEventTypes
+func (s *MemoryStore) ListEventTypes(ctx context.Context, filter EventTypeListFilter) ([]*EventType, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	types := make(map[string]*EventType)
+	typeOf := func(event string) *EventType {
+		t, ok := types[event]
+		if !ok {
+			t = &EventType{Event: event}
+			types[event] = t
+		}
+		return t
+	}
+
+	for _, e := range s.events {
+		if e.Namespace != filter.Namespace || !inRange(e.CreatedAt, filter.Since, filter.Until) {
+			continue
+		}
+		t := typeOf(e.Event)
+		t.Count++
+		if t.LastSeenAt == nil || e.CreatedAt.After(*t.LastSeenAt) {
+			createdAt := e.CreatedAt
+			t.LastSeenAt = &createdAt
+		}
+	}
+	for _, wh := range s.webhooks {
+		if wh.Namespace != filter.Namespace {
+			continue
+		}
+		for _, event := range wh.Events {
+			typeOf(event).Registered = true
+		}
+	}
+
+	sorted := slices.Collect(maps.Values(types))
+	slices.SortFunc(sorted, func(a, b *EventType) int { return cmp.Compare(a.Event, b.Event) })
+	return sorted, nil
+}
+
+// DeleteExpiredEvents deletes up to limit expired event records with their deliveries, like
+// Repository.DeleteExpiredEvents
+func (s *MemoryStore) DeleteExpiredEvents(ctx context.Context, now, olderThan time.Time, limit int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	for id, e := range s.events {
+		if n == int64(limit) {
+			break
+		}
+		if !e.ExpiresAt.Before(now) || s.hasRetainedDeliveries(id, olderThan) {
+			continue
+		}
+		delete(s.events, id)
+		for deliveryID, d := range s.deliveries {
+			if d.EventID == id {
+				delete(s.deliveries, deliveryID)
+			}
+		}
+		n++
+	}
+	return n, nil
+}
+
+// hasRetainedDeliveries reports whether an event has deliveries that aren't terminal or were
+// created at or after olderThan; the caller must hold s.mu
+func (s *MemoryStore) hasRetainedDeliveries(eventID string, olderThan time.Time) bool {
+	for _, d := range s.deliveries {
+		if d.EventID == eventID && (!isTerminalStatus(d.Status) || !d.CreatedAt.Before(olderThan)) {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordEventProcessingFailure stores a failed event processing job
+func (s *MemoryStore) RecordEventProcessingFailure(ctx context.Context, failure *EventProcessingFailure) error {
+	if failure.ID == "" {
+		failure.ID = uuid.New().String()
+	}
+	failure.FailedAt = time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := *failure
+	s.failures = append(s.failures, &c)
+	return nil
+}
+
+// ListEventFailures returns a namespace's event processing failures, newest first
+func (s *MemoryStore) ListEventFailures(ctx context.Context, filter EventFailureListFilter) ([]*EventProcessingFailure, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var failures []*EventProcessingFailure
+	for _, f := range s.failures {
+		if f.Namespace == filter.Namespace && beforeCursor(f.FailedAt, f.ID, filter.Cursor) {
+			c := *f
+			failures = append(failures, &c)
+		}
+	}
+	slices.SortFunc(failures, func(a, b *EventProcessingFailure) int {
+		return newestFirst(a.FailedAt, a.ID, b.FailedAt, b.ID)
+	})
+	return failures[:min(len(failures), filter.Limit)], nil
+}
+
+// UpsertEventSchema registers or replaces the schema for a namespace/event
+func (s *MemoryStore) UpsertEventSchema(ctx context.Context, schema *EventSchema) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := schema.Namespace + "/" + schema.Event
+	now := time.Now()
+	schema.CreatedAt, schema.UpdatedAt = now, now
+	if existing, ok := s.schemas[key]; ok {
+		schema.CreatedAt = existing.CreatedAt
+	}
+	c := *schema
+	s.schemas[key] = &c
+	return nil
+}
+
+// GetEventSchema returns the schema registered for a namespace/event, or ErrNotFound
+func (s *MemoryStore) GetEventSchema(ctx context.Context, namespace, event string) (*EventSchema, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	schema, ok := s.schemas[namespace+"/"+event]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := *schema
+	return &c, nil
+}
+
+// CreateDelivery creates a webhook delivery record. It reports false, without error, if the
+// webhook already has a delivery for the event.
+func (s *MemoryStore) CreateDelivery(ctx context.Context, delivery *WebhookDelivery) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.createDelivery(delivery)
+}
+
+// CreateDeliveryTx creates a webhook delivery record when tx commits
+func (s *MemoryStore) CreateDeliveryTx(ctx context.Context, tx pgx.Tx, delivery *WebhookDelivery) (bool, error) {
+	s.mu.Lock()
+	created, err := s.checkDelivery(delivery)
+	s.mu.Unlock()
+	if err != nil || !created {
+		return false, err
+	}
+
+	prepareDelivery(delivery)
+	stored := *delivery
+	return true, s.queue(tx, func() {
+		if ok, _ := s.checkDelivery(&stored); ok {
+			s.deliveries[stored.ID] = &memoryDelivery{WebhookDelivery: stored, updatedAt: stored.CreatedAt}
+		}
+	})
+}
+
+// prepareDelivery fills in the fields set when a delivery is created
+func prepareDelivery(delivery *WebhookDelivery) {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
+	delivery.CreatedAt = time.Now()
+	delivery.Status = StatusPending
+}
+
+// checkDelivery reports whether delivery can be created: its webhook and event exist and
+// the webhook has no delivery for the event yet
+func (s *MemoryStore) checkDelivery(delivery *WebhookDelivery) (bool, error) {
+	if _, ok := s.webhooks[delivery.WebhookID]; !ok {
+		return false, fmt.Errorf("webhook %s does not exist", delivery.WebhookID)
+	}
+	if _, ok := s.events[delivery.EventID]; !ok {
+		return false, fmt.Errorf("event %s does not exist", delivery.EventID)
+	}
+	for _, d := range s.deliveries {
+		if d.WebhookID == delivery.WebhookID && d.EventID == delivery.EventID {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func (s *MemoryStore) createDelivery(delivery *WebhookDelivery) (bool, error) {
+	created, err := s.checkDelivery(delivery)
+	if err != nil || !created {
+		return false, err
+	}
+	prepareDelivery(delivery)
+	s.deliveries[delivery.ID] = &memoryDelivery{WebhookDelivery: *delivery, updatedAt: delivery.CreatedAt}
+	return true, nil
+}
+
+// UpdateDeliveryStatus updates the status of a webhook delivery
+func (s *MemoryStore) UpdateDeliveryStatus(ctx context.Context, deliveryID string, status WebhookDeliveryStatus, responseCode int, responseBody, errorMessage string) error {
+	return s.RecordDeliveryAttempt(ctx, deliveryID, &DeliveryAttempt{
+		Status:       status,
+		ResponseCode: responseCode,
+		ResponseBody: responseBody,
+		ErrorMessage: errorMessage,
+	})
+}
+
+// RecordDeliveryAttempt stores the outcome of a delivery attempt, like
+// Repository.RecordDeliveryAttempt
+func (s *MemoryStore) RecordDeliveryAttempt(ctx context.Context, deliveryID string, attempt *DeliveryAttempt) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if d, ok := s.deliveries[deliveryID]; ok {
+		d.recordAttempt(attempt, time.Now())
+	}
+	return nil
+}
+
+func (d *memoryDelivery) recordAttempt(attempt *DeliveryAttempt, now time.Time) {
+	d.Status = attempt.Status
+	d.LastAttemptedAt = &now
+	d.updatedAt = now
+	d.ResponseCode = attempt.ResponseCode
+	d.ResponseBody = attempt.ResponseBody
+	d.ErrorMessage = attempt.ErrorMessage
+	d.ResponseContentType = attempt.ResponseContentType
+	d.ResponseURL = attempt.ResponseURL
+	d.FailureReason = attempt.FailureReason
+	d.RequestHeaders = nonEmpty(attempt.RequestHeaders)
+	d.ResponseHeaders = nonEmpty(attempt.ResponseHeaders)
+	d.ResponseTruncated = attempt.ResponseTruncated
+	d.durationMs = attempt.Duration.Milliseconds()
+	if attempt.Status == StatusSuccess && d.deliveredAt == nil {
+		d.deliveredAt = &now
+	}
+	if attempt.Status == StatusSending {
+		d.AttemptCount++
+	}
+}
+
+// nonEmpty returns headers, or nil when there are none, as stored by Repository
+func nonEmpty(headers map[string]string) map[string]string {
+	if len(headers) == 0 {
+		return nil
+	}
+	return maps.Clone(headers)
+}
+
+// GetDeliveryAttempts returns how many attempts a delivery has made and how many it allows
+func (s *MemoryStore) GetDeliveryAttempts(ctx context.Context, deliveryID string) (attemptCount, maxAttempts int, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.deliveries[deliveryID]
+	if !ok {
+		return 0, 0, ErrNotFound
+	}
+	return d.AttemptCount, d.MaxAttempts, nil
+}
+
+// IsDelivered reports whether a delivery has been acknowledged by its receiver
+func (s *MemoryStore) IsDelivered(ctx context.Context, deliveryID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.deliveries[deliveryID]
+	if !ok {
+		return false, ErrNotFound
+	}
+	return d.deliveredAt != nil, nil
+}
+
+// findDeliveries returns copies of the deliveries matching match, newest first
+func (s *MemoryStore) findDeliveries(match func(*memoryDelivery) bool) []*WebhookDelivery {
+	var found []*WebhookDelivery
+	for _, d := range s.deliveries {
+		if match(d) {
+			c := d.WebhookDelivery
+			found = append(found, &c)
+		}
+	}
+	slices.SortFunc(found, func(a, b *WebhookDelivery) int {
+		return newestFirst(a.CreatedAt, a.ID, b.CreatedAt, b.ID)
+	})
+	return found
+}
+
+// GetDeliveryByID returns a delivery, or ErrNotFound
+func (s *MemoryStore) GetDeliveryByID(ctx context.Context, deliveryID string) (*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.deliveries[deliveryID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := d.WebhookDelivery
+	return &c, nil
+}
+
+// GetDeliveriesByWebhook returns deliveries for a specific webhook
+func (s *MemoryStore) GetDeliveriesByWebhook(ctx context.Context, webhookID string) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.findDeliveries(func(d *memoryDelivery) bool { return d.WebhookID == webhookID }), nil
+}
+
+// GetDeliveriesByEvent returns deliveries for a specific event
+func (s *MemoryStore) GetDeliveriesByEvent(ctx context.Context, eventID string) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.findDeliveries(func(d *memoryDelivery) bool { return d.EventID == eventID }), nil
+}
+
+// GetDeliveriesBatch returns the deliveries of any of the webhooks or events, newest first
+func (s *MemoryStore) GetDeliveriesBatch(ctx context.Context, webhookIDs, eventIDs []string) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.findDeliveries(func(d *memoryDelivery) bool {
+		return slices.Contains(webhookIDs, d.WebhookID) || slices.Contains(eventIDs, d.EventID)
+	}), nil
+}
+
+// ListDeliveries returns deliveries to a namespace's webhooks matching filter, newest first
+func (s *MemoryStore) ListDeliveries(ctx context.Context, filter DeliveryListFilter) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := s.findDeliveries(func(d *memoryDelivery) bool {
+		wh, ok := s.webhooks[d.WebhookID]
+		return ok && wh.Namespace == filter.Namespace &&
+			(filter.Status == "" || d.Status == filter.Status) &&
+			inRange(d.CreatedAt, filter.Since, filter.Until) &&
+			beforeCursor(d.CreatedAt, d.ID, filter.Cursor)
+	})
+	return found[:min(len(found), filter.Limit)], nil
+}
+
+// GetDeliveryStats aggregates the deliveries of a namespace created in [since, until)
+func (s *MemoryStore) GetDeliveryStats(ctx context.Context, namespace string, since, until time.Time) (*NamespaceDeliveryStats, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	byEvent := make(map[string][]*memoryDelivery)
+	var all []*memoryDelivery
+	for _, d := range s.deliveries {
+		e, ok := s.events[d.EventID]
+		if !ok || e.Namespace != namespace || d.CreatedAt.Before(since) || !d.CreatedAt.Before(until) {
+			continue
+		}
+		byEvent[e.Event] = append(byEvent[e.Event], d)
+		all = append(all, d)
+	}
+
+	stats := &NamespaceDeliveryStats{Totals: aggregateDeliveries(all)}
+	for event, deliveries := range byEvent {
+		stats.Events = append(stats.Events, &EventDeliveryStats{Event: event, DeliveryStats: aggregateDeliveries(deliveries)})
+	}
+	slices.SortFunc(stats.Events, func(a, b *EventDeliveryStats) int {
+		if c := cmp.Compare(b.Total, a.Total); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Event, b.Event)
+	})
+	return stats, nil
+}
+
+// aggregateDeliveries counts deliveries by status and computes their latency percentiles
+func aggregateDeliveries(deliveries []*memoryDelivery) DeliveryStats {
+	var stats DeliveryStats
+	var durations []float64
+	for _, d := range deliveries {
+		stats.Total++
+		switch d.Status {
+		case StatusPending:
+			stats.Pending++
+		case StatusSending:
+			stats.Sending++
+		case StatusSuccess:
+			stats.Success++
+		case StatusFailed:
+			stats.Failed++
+		case StatusRetrying:
+			stats.Retrying++
+		case StatusExpired:
+			stats.Expired++
+		}
+		if d.durationMs != 0 {
+			durations = append(durations, float64(d.durationMs))
+		}
+	}
+	slices.Sort(durations)
+	stats.LatencyP50 = percentile(durations, 0.5)
+	stats.LatencyP95 = percentile(durations, 0.95)
+	return stats
+}
+
+// percentile interpolates the p-th percentile of sorted values, like Postgres's
+// percentile_cont; 0 when there are none
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	pos := p * float64(len(sorted)-1)
+	lower := int(math.Floor(pos))
+	if lower == len(sorted)-1 {
+		return sorted[lower]
+	}
+	return sorted[lower] + (pos-float64(lower))*(sorted[lower+1]-sorted[lower])
+}
+
+// WatchDeliveries polls the deliveries of a webhook (or of an event, when eventID is set),
+// like Repository.WatchDeliveries
+func (s *MemoryStore) WatchDeliveries(ctx context.Context, webhookID, eventID string, interval time.Duration, send func(*WebhookDelivery) error) error {
+	if webhookID == "" && eventID == "" {
+		return fmt.Errorf("either webhook ID or event ID is required")
+	}
+
+	return watchDeliveries(ctx, interval, send, func(since time.Time) ([]*WebhookDelivery, error) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		var changed []*memoryDelivery
+		for _, d := range s.deliveries {
+			matches := d.WebhookID == webhookID
+			if eventID != "" {
+				matches = d.EventID == eventID
+			}
+			if matches && !d.updatedAt.Before(since) {
+				changed = append(changed, d)
+			}
+		}
+		slices.SortFunc(changed, func(a, b *memoryDelivery) int {
+			if c := a.updatedAt.Compare(b.updatedAt); c != 0 {
+				return c
+			}
+			return strings.Compare(a.ID, b.ID)
+		})
+
+		found := make([]*WebhookDelivery, len(changed))
+		for i, d := range changed {
+			c := d.WebhookDelivery
+			found[i] = &c
+		}
+		return found, nil
+	})
+}
+
+// HasEarlierUndeliveredDelivery reports whether the webhook has a delivery created before
+// deliveryID that is still pending, in flight, or failed with attempts and time remaining
+func (s *MemoryStore) HasEarlierUndeliveredDelivery(ctx context.Context, webhookID, deliveryID string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	cur, ok := s.deliveries[deliveryID]
+	if !ok {
+		return false, nil
+	}
+	now := time.Now()
+	for _, d := range s.deliveries {
+		if d.WebhookID != webhookID || d.ID == cur.ID || newestFirst(d.CreatedAt, d.ID, cur.CreatedAt, cur.ID) <= 0 {
+			continue
+		}
+		switch d.Status {
+		case StatusPending, StatusSending, StatusRetrying:
+			return true, nil
+		case StatusFailed:
+			if d.AttemptCount < d.MaxAttempts && d.ExpiresAt.After(now) {
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+// StartDeliveryWithinLimit marks a delivery as sending if the webhook has fewer than
+// maxInFlight other deliveries sending, and reports whether it did
+func (s *MemoryStore) StartDeliveryWithinLimit(ctx context.Context, webhookID, deliveryID string, maxInFlight int) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	inFlight := 0
+	for _, d := range s.deliveries {
+		if d.WebhookID == webhookID && d.Status == StatusSending && d.ID != deliveryID {
+			inFlight++
+		}
+	}
+	if inFlight >= maxInFlight {
+		return false, nil
+	}
+	if d, ok := s.deliveries[deliveryID]; ok {
+		d.recordAttempt(&DeliveryAttempt{Status: StatusSending}, time.Now())
+	}
+	return true, nil
+}
+
+// ClaimBatchDeliveries marks up to limit of a webhook's batched deliveries as sending, like
+// Repository.ClaimBatchDeliveries, and returns them oldest first
+func (s *MemoryStore) ClaimBatchDeliveries(ctx context.Context, webhookID string, limit int, staleBefore time.Time) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	var claimable []*memoryDelivery
+	for _, d := range s.deliveries {
+		if d.WebhookID != webhookID || !d.Batched || d.deliveredAt != nil ||
+			!d.ExpiresAt.After(now) || d.AttemptCount >= d.MaxAttempts {
+			continue
+		}
+		stale := d.Status == StatusSending && d.LastAttemptedAt != nil && d.LastAttemptedAt.Before(staleBefore)
+		if d.Status == StatusPending || d.Status == StatusRetrying || stale {
+			claimable = append(claimable, d)
+		}
+	}
+	slices.SortFunc(claimable, func(a, b *memoryDelivery) int {
+		return -newestFirst(a.CreatedAt, a.ID, b.CreatedAt, b.ID)
+	})
+
+	var claimed []*WebhookDelivery
+	for _, d := range claimable[:min(len(claimable), limit)] {
+		d.Status = StatusSending
+		d.AttemptCount++
+		d.LastAttemptedAt = &now
+		d.updatedAt = now
+		c := d.WebhookDelivery
+		claimed = append(claimed, &c)
+	}
+	return claimed, nil
+}
+
+// ExpireBatchDeliveries marks a webhook's batched deliveries that expired while waiting as
+// expired and returns them
+func (s *MemoryStore) ExpireBatchDeliveries(ctx context.Context, webhookID string) ([]*WebhookDelivery, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	return s.findDeliveries(func(d *memoryDelivery) bool {
+		if d.WebhookID != webhookID || !d.Batched || d.ExpiresAt.After(now) || d.AttemptCount >= d.MaxAttempts ||
+			(d.Status != StatusPending && d.Status != StatusRetrying) {
+			return false
+		}
+		d.Status = StatusExpired
+		d.ErrorMessage = "Delivery expired"
+		d.updatedAt = now
+		return true
+	}), nil
+}
+
+// FindStuckDeliveries returns up to limit unbatched deliveries that have been "sending" since
+// before olderThan. There are no River jobs, so every such delivery counts as stuck.
+func (s *MemoryStore) FindStuckDeliveries(ctx context.Context, olderThan time.Time, limit int) ([]*DeliveryJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	found := s.findDeliveries(func(d *memoryDelivery) bool {
+		return d.Status == StatusSending && !d.Batched && d.LastAttemptedAt != nil && d.LastAttemptedAt.Before(olderThan)
+	})
+	slices.SortFunc(found, func(a, b *WebhookDelivery) int { return a.LastAttemptedAt.Compare(*b.LastAttemptedAt) })
+
+	stuck := make([]*DeliveryJob, 0, min(len(found), limit))
+	for _, d := range found[:min(len(found), limit)] {
+		stuck = append(stuck, &DeliveryJob{WebhookDelivery: d})
+	}
+	return stuck, nil
+}
+
+// ResolveStuckDelivery moves a delivery out of "sending" and reports whether it was still stuck
+func (s *MemoryStore) ResolveStuckDelivery(ctx context.Context, deliveryID string, status WebhookDeliveryStatus, errorMessage string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.resolveStuckDelivery(deliveryID, status, errorMessage), nil
+}
+
+// ResolveStuckDeliveryTx is ResolveStuckDelivery when tx commits
+func (s *MemoryStore) ResolveStuckDeliveryTx(ctx context.Context, tx pgx.Tx, deliveryID string, status WebhookDeliveryStatus, errorMessage string) (bool, error) {
+	s.mu.Lock()
+	d, ok := s.deliveries[deliveryID]
+	stuck := ok && d.Status == StatusSending
+	s.mu.Unlock()
+	if !stuck {
+		return false, nil
+	}
+	return true, s.queue(tx, func() { s.resolveStuckDelivery(deliveryID, status, errorMessage) })
+}
+
+func (s *MemoryStore) resolveStuckDelivery(deliveryID string, status WebhookDeliveryStatus, errorMessage string) bool {
+	d, ok := s.deliveries[deliveryID]
+	if !ok || d.Status != StatusSending {
+		return false
+	}
+	d.Status = status
+	d.ErrorMessage = errorMessage
+	d.updatedAt = time.Now()
+	return true
+}
+
+// GetDeliveryJob returns a delivery, without job args as there are no River jobs
+func (s *MemoryStore) GetDeliveryJob(ctx context.Context, deliveryID string) (*DeliveryJob, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	d, ok := s.deliveries[deliveryID]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	c := d.WebhookDelivery
+	return &DeliveryJob{WebhookDelivery: &c}, nil
+}
+
+// ExtendExpiredDeliveryTx moves an expired delivery back to retrying with a new expiry when
+// tx commits, extending its event's expiry to match, and reports whether it was still expired
+func (s *MemoryStore) ExtendExpiredDeliveryTx(ctx context.Context, tx pgx.Tx, deliveryID string, expiresAt time.Time) (bool, error) {
+	s.mu.Lock()
+	d, ok := s.deliveries[deliveryID]
+	expired := ok && d.Status == StatusExpired
+	s.mu.Unlock()
+	if !expired {
+		return false, nil
+	}
+
+	return true, s.queue(tx, func() {
+		d, ok := s.deliveries[deliveryID]
+		if !ok || d.Status != StatusExpired {
+			return
+		}
+		d.Status = StatusRetrying
+		d.ExpiresAt = expiresAt
+		d.ErrorMessage = "Delivery TTL extended; rescheduled"
+		d.MaxAttempts = max(d.MaxAttempts, d.AttemptCount+1)
+		d.updatedAt = time.Now()
+		if e, ok := s.events[d.EventID]; ok && e.ExpiresAt.Before(expiresAt) {
+			e.ExpiresAt = expiresAt
+		}
+	})
+}
+
+// DeleteTerminalDeliveries deletes up to limit terminal deliveries created before olderThan
+func (s *MemoryStore) DeleteTerminalDeliveries(ctx context.Context, olderThan time.Time, limit int) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var n int64
+	for id, d := range s.deliveries {
+		if n == int64(limit) {
+			break
+		}
+		if isTerminalStatus(d.Status) && d.CreatedAt.Before(olderThan) {
+			delete(s.deliveries, id)
+			n++
+		}
+	}
+	return n, nil
+}
+
+// isTerminalStatus reports whether a delivery with status is finished
+func isTerminalStatus(status WebhookDeliveryStatus) bool {
+	return status == StatusSuccess || status == StatusFailed || status == StatusExpired
+}
+
+// CreateNamespace registers a namespace, or returns ErrNamespaceExists
+func (s *MemoryStore) CreateNamespace(ctx context.Context, namespace *Namespace) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.namespaces[namespace.Name]; ok {
+		return ErrNamespaceExists
+	}
+	namespace.CreatedAt = time.Now()
+	s.namespaces[namespace.Name] = &memoryNamespace{Namespace: *namespace}
+	return nil
+}
+
+// EnsureNamespace checks that a namespace may be used, creating it unless namespaces are strict
+func (s *MemoryStore) EnsureNamespace(ctx context.Context, name string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.namespaces[name]; ok {
+		return nil
+	}
+	if s.strictNamespaces {
+		return ErrNamespaceNotFound
+	}
+	s.namespaces[name] = &memoryNamespace{Namespace: Namespace{Name: name, CreatedAt: time.Now()}}
+	return nil
+}
+
+// SetNamespaceDefaults replaces a namespace's defaults, or returns ErrNamespaceNotFound
+func (s *MemoryStore) SetNamespaceDefaults(ctx context.Context, namespace string, defaults *NamespaceDefaults) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	ns, ok := s.namespaces[namespace]
+	if !ok {
+		return ErrNamespaceNotFound
+	}
+	ns.defaults = NamespaceDefaults{Headers: maps.Clone(defaults.Headers), Timeout: defaults.Timeout}
+	return nil
+}
+
+// GetNamespaceDefaults returns a namespace's defaults. Namespaces that don't exist have none.
+func (s *MemoryStore) GetNamespaceDefaults(ctx context.Context, namespace string) (*NamespaceDefaults, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	defaults := &NamespaceDefaults{}
+	if ns, ok := s.namespaces[namespace]; ok {
+		defaults.Headers = maps.Clone(ns.defaults.Headers)
+		defaults.Timeout = ns.defaults.Timeout
+	}
+	return defaults, nil
+}
+
+// CreateAPIKey stores a new API key by its hash
+func (s *MemoryStore) CreateAPIKey(ctx context.Context, key *APIKey) error {
+	key.ID = uuid.New().String()
+	key.CreatedAt = time.Now()
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	c := *key
+	s.apiKeys[key.ID] = &c
+	return nil
+}
+
+// RevokeAPIKey marks an API key as revoked, returning ErrNotFound if no active key matches
+func (s *MemoryStore) RevokeAPIKey(ctx context.Context, keyID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key, ok := s.apiKeys[keyID]
+	if !ok || key.RevokedAt != nil {
+		return ErrNotFound
+	}
+	now := time.Now()
+	key.RevokedAt = &now
+	return nil
+}
+
+// GetActiveAPIKeyByHash returns the non-revoked API key with the given hash
+func (s *MemoryStore) GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, key := range s.apiKeys {
+		if key.KeyHash == keyHash && key.RevokedAt == nil {
+			c := *key
+			return &c, nil
+		}
+	}
+	return nil, ErrNotFound
+}
@@ -0,0 +1,145 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMemoryStoreDeliveries(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	webhook := &WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if webhook.ID == "" || webhook.MaxAttempts != DefaultMaxAttempts {
+		t.Errorf("registration not filled in: %+v", webhook)
+	}
+	if err := store.StoreEvent(ctx, &EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 60}); err != nil {
+		t.Fatal(err)
+	}
+
+	// Deliveries need an existing webhook and event, and are created once per pair
+	if _, err := store.CreateDelivery(ctx, &WebhookDelivery{WebhookID: webhook.ID, EventID: "missing"}); err == nil {
+		t.Error("expected an error for a delivery of a missing event")
+	}
+	delivery := &WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, ExpiresAt: time.Now().Add(time.Minute)}
+	if created, err := store.CreateDelivery(ctx, delivery); !created || err != nil {
+		t.Fatalf("CreateDelivery = %v, %v", created, err)
+	}
+	if created, _ := store.CreateDelivery(ctx, &WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1"}); created {
+		t.Error("expected a duplicate delivery not to be created")
+	}
+
+	// A sending attempt counts; a successful one marks the delivery delivered
+	if err := store.RecordDeliveryAttempt(ctx, delivery.ID, &DeliveryAttempt{Status: StatusSending}); err != nil {
+		t.Fatal(err)
+	}
+	if delivered, _ := store.IsDelivered(ctx, delivery.ID); delivered {
+		t.Error("delivery marked delivered before succeeding")
+	}
+	if err := store.RecordDeliveryAttempt(ctx, delivery.ID, &DeliveryAttempt{Status: StatusSuccess, ResponseCode: 200, Duration: 40 * time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	if delivered, _ := store.IsDelivered(ctx, delivery.ID); !delivered {
+		t.Error("delivery not marked delivered")
+	}
+	attempts, maxAttempts, _ := store.GetDeliveryAttempts(ctx, delivery.ID)
+	if attempts != 1 || maxAttempts != 3 {
+		t.Errorf("attempts = %d of %d, want 1 of 3", attempts, maxAttempts)
+	}
+
+	stats, _ := store.GetDeliveryStats(ctx, "orders", time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	if stats.Totals.Success != 1 || stats.Totals.LatencyP50 != 40 || len(stats.Events) != 1 {
+		t.Errorf("stats = %+v", stats)
+	}
+
+	// Unregistering removes the webhook's deliveries
+	if err := store.UnregisterWebhook(ctx, webhook.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.GetWebhookByID(ctx, webhook.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("GetWebhookByID after unregistering: %v", err)
+	}
+	if deliveries, _ := store.GetDeliveriesByEvent(ctx, "evt-1"); len(deliveries) != 0 {
+		t.Errorf("got %d deliveries after unregistering", len(deliveries))
+	}
+}
+
+func TestMemoryStoreTransactions(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	webhook := &WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, Active: true}
+	store.RegisterWebhook(ctx, webhook)
+	store.StoreEvent(ctx, &EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 60})
+
+	// Rolled back changes are discarded
+	tx, _ := store.BeginTx(ctx)
+	if err := store.StoreEventTx(ctx, tx, &EventRecord{ID: "evt-2", Namespace: "orders", Event: "order.created", TTL: 60}); err != nil {
+		t.Fatalf("StoreEventTx: %v", err)
+	}
+	if created, err := store.CreateDeliveryTx(ctx, tx, &WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1"}); !created || err != nil {
+		t.Fatalf("CreateDeliveryTx = %v, %v", created, err)
+	}
+	tx.Rollback(ctx)
+	if deliveries, _ := store.GetDeliveriesByWebhook(ctx, webhook.ID); len(deliveries) != 0 {
+		t.Fatalf("got %d deliveries after rollback", len(deliveries))
+	}
+	if _, err := store.GetEvent(ctx, "evt-2"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("GetEvent after rollback = %v, want ErrNotFound", err)
+	}
+
+	// Committed changes apply
+	tx, _ = store.BeginTx(ctx)
+	delivery := &WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1"}
+	store.CreateDeliveryTx(ctx, tx, delivery)
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if deliveries, _ := store.GetDeliveriesByWebhook(ctx, webhook.ID); len(deliveries) != 1 || deliveries[0].ID != delivery.ID {
+		t.Fatalf("deliveries after commit = %v", deliveries)
+	}
+	if err := tx.Commit(ctx); err == nil {
+		t.Error("expected committing twice to fail")
+	}
+
+	// Only the store's own transactions are accepted
+	other, _ := NewMemoryStore().BeginTx(ctx)
+	if _, err := store.ResolveStuckDeliveryTx(ctx, other, delivery.ID, StatusFailed, ""); err != nil {
+		t.Errorf("ResolveStuckDeliveryTx of a delivery that isn't stuck: %v", err)
+	}
+	store.StartDeliveryWithinLimit(ctx, webhook.ID, delivery.ID, 1)
+	if _, err := store.ResolveStuckDeliveryTx(ctx, other, delivery.ID, StatusFailed, ""); err == nil {
+		t.Error("expected an error for another store's transaction")
+	}
+}
+
+func TestMemoryStoreDeleteWebhooksByFilter(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	for _, wh := range []*WebhookRegistration{
+		{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com/a", Active: true},
+		{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com/b", Active: false},
+		{Namespace: "orders", Events: []string{"order.shipped"}, URL: "https://example.com/c", Active: true},
+		{Namespace: "billing", Events: []string{"order.created"}, URL: "https://example.com/d", Active: true},
+	} {
+		if err := store.RegisterWebhook(ctx, wh); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// Paused webhooks are deleted but not counted as active
+	if deleted, active, err := store.DeleteWebhooksByFilter(ctx, "orders", "order.created"); deleted != 2 || active != 1 || err != nil {
+		t.Errorf("DeleteWebhooksByFilter = %d, %d, %v; want 2 deleted, 1 active", deleted, active, err)
+	}
+	if deleted, active, err := store.DeleteWebhooksByFilter(ctx, "orders", ""); deleted != 1 || active != 1 || err != nil {
+		t.Errorf("DeleteWebhooksByFilter = %d, %d, %v; want 1 deleted, 1 active", deleted, active, err)
+	}
+	if count, _ := store.CountWebhooks(ctx, "billing"); count != 1 {
+		t.Errorf("other namespace has %d webhooks, want 1", count)
+	}
+}
@@ -0,0 +1,44 @@
+package webhooks
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxMetadataFilterEntries limits how many metadata entries a webhook can require
+const MaxMetadataFilterEntries = 20
+
+// ValidateMetadataFilter checks the event metadata a webhook requires
+func ValidateMetadataFilter(filter map[string]string) error {
+	if len(filter) > MaxMetadataFilterEntries {
+		return fmt.Errorf("at most %d metadata_filter entries are allowed", MaxMetadataFilterEntries)
+	}
+	for key := range filter {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("metadata_filter can't have an empty key")
+		}
+	}
+	return nil
+}
+
+// MatchesMetadata reports whether an event's metadata has every key of filter with exactly
+// the required value. An empty filter matches every event.
+func MatchesMetadata(filter, metadata map[string]string) bool {
+	for key, want := range filter {
+		if got, ok := metadata[key]; !ok || got != want {
+			return false
+		}
+	}
+	return true
+}
+
+// FilterByMetadata returns the webhooks whose metadata filter matches an event's metadata
+func FilterByMetadata(webhooks []*WebhookRegistration, metadata map[string]string) []*WebhookRegistration {
+	matching := make([]*WebhookRegistration, 0, len(webhooks))
+	for _, wh := range webhooks {
+		if MatchesMetadata(wh.MetadataFilter, metadata) {
+			matching = append(matching, wh)
+		}
+	}
+	return matching
+}
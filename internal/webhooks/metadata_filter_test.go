@@ -0,0 +1,52 @@
+package webhooks
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestValidateMetadataFilter(t *testing.T) {
+	if err := ValidateMetadataFilter(map[string]string{"region": "eu", "tier": ""}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ValidateMetadataFilter(map[string]string{" ": "eu"}); err == nil {
+		t.Error("expected an error for an empty key")
+	}
+
+	tooMany := make(map[string]string)
+	for i := 0; i <= MaxMetadataFilterEntries; i++ {
+		tooMany[fmt.Sprintf("key%d", i)] = "x"
+	}
+	if err := ValidateMetadataFilter(tooMany); err == nil {
+		t.Error("expected an error for too many entries")
+	}
+}
+
+func TestFilterByMetadata(t *testing.T) {
+	all := &WebhookRegistration{ID: "all"}
+	eu := &WebhookRegistration{ID: "eu", MetadataFilter: map[string]string{"region": "eu"}}
+	euPaid := &WebhookRegistration{ID: "eu-paid", MetadataFilter: map[string]string{"region": "eu", "tier": "paid"}}
+	hooks := []*WebhookRegistration{all, eu, euPaid}
+
+	tests := map[string]struct {
+		metadata map[string]string
+		want     []string
+	}{
+		"no metadata":    {nil, []string{"all"}},
+		"partial match":  {map[string]string{"region": "eu"}, []string{"all", "eu"}},
+		"full match":     {map[string]string{"region": "eu", "tier": "paid", "extra": "x"}, []string{"all", "eu", "eu-paid"}},
+		"value mismatch": {map[string]string{"region": "us", "tier": "paid"}, []string{"all"}},
+	}
+	for name, tt := range tests {
+		got := FilterByMetadata(hooks, tt.metadata)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: got %d webhooks, want %v", name, len(got), tt.want)
+			continue
+		}
+		for i, wh := range got {
+			if wh.ID != tt.want[i] {
+				t.Errorf("%s: webhook %d = %s, want %s", name, i, wh.ID, tt.want[i])
+			}
+		}
+	}
+}
@@ -6,45 +6,122 @@ import (
 
 // WebhookRegistration represents a registered webhook
 type WebhookRegistration struct {
+	ID                      string              `json:"id" db:"id"`
+	Namespace               string              `json:"namespace" db:"namespace"`
+	Events                  []string            `json:"events" db:"events"` // Multiple events supported
+	URL                     string              `json:"url" db:"url"`
+	Headers                 map[string]string   `json:"headers" db:"headers"`
+	Timeout                 int                 `json:"timeout" db:"timeout"`
+	MaxAttempts             int                 `json:"max_attempts" db:"max_attempts"`
+	ContentType             string              `json:"content_type" db:"content_type"`
+	MaxStoredResponseBytes  int                 `json:"max_stored_response_bytes" db:"max_stored_response_bytes"` // 0 = global default
+	DisableTracePropagation bool                `json:"disable_trace_propagation" db:"disable_trace_propagation"`
+	Ordered                 bool                `json:"ordered" db:"ordered"`                                     // Deliver strictly in order, one at a time
+	IncludeFields           []string            `json:"include_fields" db:"include_fields"`                       // JSON paths to deliver (empty = all)
+	ExcludeFields           []string            `json:"exclude_fields" db:"exclude_fields"`                       // JSON paths to strip before delivery
+	Priority                int                 `json:"priority" db:"priority"`                                   // River priority 1-4; 0 = default
+	FallbackURLs            []string            `json:"fallback_urls" db:"fallback_urls"`                         // Tried in order when URL fails or returns 5xx
+	SuccessBodyMatcher      *BodyMatcher        `json:"success_body_matcher,omitempty" db:"success_body_matcher"` // nil = status code only
+	AuthType                string              `json:"auth_type" db:"auth_type"`                                 // "", AuthTypeBasic or AuthTypeBearer
+	Credentials             *WebhookCredentials `json:"-" db:"-"`                                                 // Only set when registering; stored separately
+	Signed                  bool                `json:"signed" db:"signed"`                                       // Deliveries carry X-Sparrow-Signature headers
+	SigningSecret           string              `json:"-" db:"-"`                                                 // Only set when registering; stored separately
+	ExpiresAt               *time.Time          `json:"expires_at,omitempty" db:"expires_at"`                     // Stops receiving events after this; nil = never
+	MaxInFlight             int                 `json:"max_in_flight" db:"max_in_flight"`                         // Concurrent deliveries allowed; 0 = unlimited
+	CompressBody            bool                `json:"compress_body" db:"compress_body"`                         // Gzip bodies above the worker's threshold
+	BatchWindowMs           int                 `json:"batch_window_ms" db:"batch_window_ms"`                     // Batch deliveries arriving within this window; 0 = no batching
+	BatchMaxSize            int                 `json:"batch_max_size" db:"batch_max_size"`                       // Most deliveries per batch
+	RetrySchedule           []int               `json:"retry_schedule" db:"retry_schedule"`                       // Seconds before each retry; default backoff after the last
+	QueryParams             map[string]string   `json:"query_params" db:"query_params"`                           // Added to each delivery URL; values may be templates
+	MetadataFilter          map[string]string   `json:"metadata_filter" db:"metadata_filter"`                     // Event metadata required for delivery; empty = all events
+	BypassProxy             bool                `json:"bypass_proxy" db:"bypass_proxy"`                           // Connect directly, not through the outbound proxy
+	Active                  bool                `json:"active" db:"active"`
+	Description             string              `json:"description" db:"description"`
+	CreatedAt               time.Time           `json:"created_at" db:"created_at"`
+	UpdatedAt               time.Time           `json:"updated_at" db:"updated_at"`
+}
+
+// EventRecord represents an event that was pushed
+type EventRecord struct {
 	ID          string            `json:"id" db:"id"`
 	Namespace   string            `json:"namespace" db:"namespace"`
-	Events      []string          `json:"events" db:"events"` // Multiple events supported
-	URL         string            `json:"url" db:"url"`
-	Headers     map[string]string `json:"headers" db:"headers"`
-	Timeout     int               `json:"timeout" db:"timeout"`
-	Active      bool              `json:"active" db:"active"`
-	Description string            `json:"description" db:"description"`
+	Event       string            `json:"event" db:"event"`
+	Payload     string            `json:"payload" db:"payload"`
+	ContentType string            `json:"content_type" db:"content_type"`
+	Compressed  bool              `json:"compressed" db:"compressed"`   // Whether the stored payload is gzipped; Payload is always plain
+	KeyVersion  int               `json:"key_version" db:"key_version"` // Key that encrypted the stored payload; 0 = plaintext
+	TTL         int64             `json:"ttl" db:"ttl"`
+	Metadata    map[string]string `json:"metadata" db:"metadata"`
 	CreatedAt   time.Time         `json:"created_at" db:"created_at"`
-	UpdatedAt   time.Time         `json:"updated_at" db:"updated_at"`
+	ExpiresAt   time.Time         `json:"expires_at" db:"expires_at"`
+
+	// Deliveries counts the event's deliveries by outcome; it's maintained by the store and
+	// ignored by StoreEvent
+	Deliveries DeliverySummary `json:"deliveries"`
 }
 
-// EventRecord represents an event that was pushed
-type EventRecord struct {
-	ID        string            `json:"id" db:"id"`
-	Namespace string            `json:"namespace" db:"namespace"`
-	Event     string            `json:"event" db:"event"`
-	Payload   string            `json:"payload" db:"payload"`
-	TTL       int64             `json:"ttl" db:"ttl"`
-	Metadata  map[string]string `json:"metadata" db:"metadata"`
-	CreatedAt time.Time         `json:"created_at" db:"created_at"`
-	ExpiresAt time.Time         `json:"expires_at" db:"expires_at"`
+// DeliverySummary counts an event's deliveries by outcome. Failed includes expired
+// deliveries; Pending counts those still pending, sending or retrying.
+type DeliverySummary struct {
+	Total     int `json:"total_deliveries" db:"total_deliveries"`
+	Succeeded int `json:"succeeded" db:"succeeded_deliveries"`
+	Failed    int `json:"failed" db:"failed_deliveries"`
+	Pending   int `json:"pending" db:"pending_deliveries"`
+}
+
+// Add counts a delivery with the given status
+func (s *DeliverySummary) Add(status WebhookDeliveryStatus) {
+	s.Total++
+	switch status {
+	case StatusSuccess:
+		s.Succeeded++
+	case StatusFailed, StatusExpired:
+		s.Failed++
+	default:
+		s.Pending++
+	}
 }
 
 // WebhookDelivery represents a webhook delivery attempt
 type WebhookDelivery struct {
-	ID              string                `json:"id" db:"id"`
-	WebhookID       string                `json:"webhook_id" db:"webhook_id"`
-	EventID         string                `json:"event_id" db:"event_id"`
-	Status          WebhookDeliveryStatus `json:"status" db:"status"`
-	AttemptCount    int                   `json:"attempt_count" db:"attempt_count"`
-	MaxAttempts     int                   `json:"max_attempts" db:"max_attempts"`
-	CreatedAt       time.Time             `json:"created_at" db:"created_at"`
-	LastAttemptedAt *time.Time            `json:"last_attempted_at" db:"last_attempted_at"`
-	NextRetryAt     *time.Time            `json:"next_retry_at" db:"next_retry_at"`
-	ExpiresAt       time.Time             `json:"expires_at" db:"expires_at"`
-	ResponseCode    int                   `json:"response_code" db:"response_code"`
-	ResponseBody    string                `json:"response_body" db:"response_body"`
-	ErrorMessage    string                `json:"error_message" db:"error_message"`
+	ID                  string                `json:"id" db:"id"`
+	WebhookID           string                `json:"webhook_id" db:"webhook_id"`
+	EventID             string                `json:"event_id" db:"event_id"`
+	Status              WebhookDeliveryStatus `json:"status" db:"status"`
+	AttemptCount        int                   `json:"attempt_count" db:"attempt_count"`
+	MaxAttempts         int                   `json:"max_attempts" db:"max_attempts"`
+	CreatedAt           time.Time             `json:"created_at" db:"created_at"`
+	LastAttemptedAt     *time.Time            `json:"last_attempted_at" db:"last_attempted_at"`
+	NextRetryAt         *time.Time            `json:"next_retry_at" db:"next_retry_at"`
+	ExpiresAt           time.Time             `json:"expires_at" db:"expires_at"`
+	ResponseCode        int                   `json:"response_code" db:"response_code"`
+	ResponseBody        string                `json:"response_body" db:"response_body"`
+	ResponseContentType string                `json:"response_content_type" db:"response_content_type"`
+	ResponseURL         string                `json:"response_url" db:"response_url"`             // URL that produced the response
+	ResponseTruncated   bool                  `json:"response_truncated" db:"response_truncated"` // Response body was cut at the capture limit
+	ErrorMessage        string                `json:"error_message" db:"error_message"`
+	FailureReason       FailureReason         `json:"failure_reason,omitempty" db:"failure_reason"`     // Why the last attempt failed
+	RequestHeaders      map[string]string     `json:"request_headers,omitempty" db:"request_headers"`   // Sent by the last attempt, credentials redacted
+	ResponseHeaders     map[string]string     `json:"response_headers,omitempty" db:"response_headers"` // Received by the last attempt
+	Batched             bool                  `json:"-" db:"batched"`                                   // Sent by the webhook's batch job; only set when creating
+}
+
+// MaxStoredResponseBytesLimit caps how much of a response body can be stored per delivery
+const MaxStoredResponseBytesLimit = 64 * 1024
+
+// DeliveryAttempt captures the outcome of a single delivery attempt
+type DeliveryAttempt struct {
+	Status              WebhookDeliveryStatus
+	ResponseCode        int
+	ResponseBody        string
+	ResponseContentType string
+	ResponseURL         string
+	ResponseTruncated   bool // The response body was longer than the capture limit
+	ErrorMessage        string
+	FailureReason       FailureReason
+	Duration            time.Duration     // Request duration; 0 when nothing was sent
+	RequestHeaders      map[string]string // Headers sent, from CaptureHeaders; nil when nothing was sent
+	ResponseHeaders     map[string]string // Headers received, from CaptureHeaders
 }
 
 // WebhookDeliveryStatus represents the status of a webhook delivery
@@ -0,0 +1,143 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// ErrNamespaceNotFound is returned in strict mode for namespaces that haven't been created
+var ErrNamespaceNotFound = errors.New("namespace not found")
+
+// ErrNamespaceExists is returned when creating a namespace that already exists
+var ErrNamespaceExists = errors.New("namespace already exists")
+
+// ErrWebhookQuotaExceeded is returned when registering a webhook in a namespace that already
+// has as many webhooks as it may register
+var ErrWebhookQuotaExceeded = errors.New("webhook quota exceeded")
+
+// WebhookQuota resolves the number of webhooks a namespace may register from the server's
+// limit and the namespace's override, if it has one. 0 means unlimited.
+func WebhookQuota(serverLimit int, override *int) int {
+	if override != nil {
+		return max(*override, 0)
+	}
+	return serverLimit
+}
+
+// Namespace is a registered namespace
+type Namespace struct {
+	Name        string    `json:"name" db:"name"`
+	Description string    `json:"description" db:"description"`
+	CreatedAt   time.Time `json:"created_at" db:"created_at"`
+}
+
+// EnableStrictNamespaces requires namespaces to be created with CreateNamespace before
+// webhooks or events can use them. Otherwise they are created on first use.
+func (r *Repository) EnableStrictNamespaces() {
+	r.strictNamespaces = true
+}
+
+// CreateNamespace registers a namespace, or returns ErrNamespaceExists
+func (r *Repository) CreateNamespace(ctx context.Context, namespace *Namespace) error {
+	query := `
+		INSERT INTO namespaces (name, description, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (name) DO NOTHING
+		RETURNING created_at
+	`
+
+	err := r.db.QueryRow(ctx, query, namespace.Name, namespace.Description, time.Now()).Scan(&namespace.CreatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return ErrNamespaceExists
+	}
+	if err != nil {
+		return err
+	}
+
+	r.knownNamespaces.Store(namespace.Name, struct{}{})
+	return nil
+}
+
+// EnsureNamespace checks that a namespace may be used. In strict mode it returns
+// ErrNamespaceNotFound for namespaces that haven't been created; otherwise unknown
+// namespaces are created on the spot. Namespaces are never deleted, so known ones are
+// remembered and don't cost a query.
+func (r *Repository) EnsureNamespace(ctx context.Context, name string) error {
+	if _, ok := r.knownNamespaces.Load(name); ok {
+		return nil
+	}
+
+	if r.strictNamespaces {
+		var exists bool
+		err := r.db.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM namespaces WHERE name = $1)`, name).Scan(&exists)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ErrNamespaceNotFound
+		}
+	} else {
+		_, err := r.db.Exec(ctx, `INSERT INTO namespaces (name) VALUES ($1) ON CONFLICT (name) DO NOTHING`, name)
+		if err != nil {
+			return err
+		}
+	}
+
+	r.knownNamespaces.Store(name, struct{}{})
+	return nil
+}
+
+// NamespaceDefaults are inherited by a namespace's webhooks
+type NamespaceDefaults struct {
+	Headers map[string]string `json:"headers"` // Merged into deliveries; webhook headers win
+	Timeout int               `json:"timeout"` // Seconds, for webhooks registered without a timeout; 0 = server default
+}
+
+// SetNamespaceDefaults replaces a namespace's defaults, or returns ErrNamespaceNotFound
+func (r *Repository) SetNamespaceDefaults(ctx context.Context, namespace string, defaults *NamespaceDefaults) error {
+	headers := defaults.Headers
+	if headers == nil {
+		headers = map[string]string{}
+	}
+	headersJSON, err := json.Marshal(headers)
+	if err != nil {
+		return fmt.Errorf("failed to marshal headers: %w", err)
+	}
+
+	tag, err := r.db.Exec(ctx,
+		`UPDATE namespaces SET default_headers = $2, default_timeout = $3 WHERE name = $1`,
+		namespace, headersJSON, defaults.Timeout)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNamespaceNotFound
+	}
+	return nil
+}
+
+// GetNamespaceDefaults returns a namespace's defaults. Namespaces that don't exist have none.
+func (r *Repository) GetNamespaceDefaults(ctx context.Context, namespace string) (*NamespaceDefaults, error) {
+	var (
+		defaults    NamespaceDefaults
+		headersJSON []byte
+	)
+	err := r.db.QueryRow(ctx,
+		`SELECT default_headers, default_timeout FROM namespaces WHERE name = $1`,
+		namespace).Scan(&headersJSON, &defaults.Timeout)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &defaults, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(headersJSON, &defaults.Headers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal default headers: %w", err)
+	}
+	return &defaults, nil
+}
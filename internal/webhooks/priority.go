@@ -0,0 +1,35 @@
+package webhooks
+
+// Job priorities follow River: 1 is the most urgent and 4 the least.
+const (
+	MinPriority = 1
+	MaxPriority = 4
+
+	// DefaultPriority applies when neither the event nor the webhook sets a priority. It leaves
+	// room for critical traffic (1) to jump ahead and for bulk traffic (3-4) to fall behind.
+	DefaultPriority = 2
+)
+
+// IsValidPriority reports whether p is unset (0) or within River's priority range
+func IsValidPriority(p int) bool {
+	return p == 0 || (p >= MinPriority && p <= MaxPriority)
+}
+
+// ResolvePriority returns the priority for a delivery job. When both the event and the
+// webhook set a priority the more urgent one (the lower number) wins, so a critical event
+// is never slowed down by a bulk webhook and a critical webhook is never slowed down by a
+// bulk event. Unset (0) values are ignored.
+func ResolvePriority(eventPriority, webhookPriority int) int {
+	switch {
+	case eventPriority == 0 && webhookPriority == 0:
+		return DefaultPriority
+	case eventPriority == 0:
+		return webhookPriority
+	case webhookPriority == 0:
+		return eventPriority
+	case eventPriority < webhookPriority:
+		return eventPriority
+	default:
+		return webhookPriority
+	}
+}
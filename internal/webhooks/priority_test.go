@@ -0,0 +1,21 @@
+package webhooks
+
+import "testing"
+
+func TestResolvePriority(t *testing.T) {
+	tests := []struct {
+		event, webhook, want int
+	}{
+		{0, 0, DefaultPriority},
+		{1, 0, 1},
+		{0, 4, 4},
+		{1, 4, 1},
+		{3, 2, 2},
+	}
+
+	for _, tt := range tests {
+		if got := ResolvePriority(tt.event, tt.webhook); got != tt.want {
+			t.Errorf("ResolvePriority(%d, %d) = %d, want %d", tt.event, tt.webhook, got, tt.want)
+		}
+	}
+}
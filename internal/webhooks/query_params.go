@@ -0,0 +1,90 @@
+package webhooks
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// MaxQueryParams limits how many query parameters a webhook can add to its URLs
+const MaxQueryParams = 20
+
+// ValidateQueryParams checks the query parameters a webhook adds to its delivery URLs. Values
+// may be templates, like header values. A parameter the URL or a fallback URL already carries
+// would be ambiguous, so it's rejected, as are parameters on SQS targets.
+func ValidateQueryParams(params map[string]string, rawURL string, fallbackURLs []string) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if len(params) > MaxQueryParams {
+		return fmt.Errorf("at most %d query_params are allowed", MaxQueryParams)
+	}
+
+	for key, value := range params {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("query_params can't have an empty name")
+		}
+		if isHeaderTemplate(value) {
+			if _, err := parseHeaderTemplate(key, value); err != nil {
+				return fmt.Errorf("invalid template in query parameter %q: %w", key, err)
+			}
+		}
+	}
+
+	for _, raw := range append([]string{rawURL}, fallbackURLs...) {
+		if TargetType(raw) != TargetHTTP {
+			return fmt.Errorf("query_params can't be used with SQS targets")
+		}
+		u, err := url.Parse(raw)
+		if err != nil {
+			return fmt.Errorf("invalid URL %q: %w", raw, err)
+		}
+		existing := u.Query()
+		for key := range params {
+			if existing.Has(key) {
+				return fmt.Errorf("query parameter %q is already set by URL %q", key, raw)
+			}
+		}
+	}
+	return nil
+}
+
+// RenderQueryParams returns params with templated values rendered against data, which takes
+// the same fields as header templates
+func RenderQueryParams(params map[string]string, data map[string]any) (map[string]string, error) {
+	rendered := make(map[string]string, len(params))
+	for key, value := range params {
+		if !isHeaderTemplate(value) {
+			rendered[key] = value
+			continue
+		}
+
+		tmpl, err := parseHeaderTemplate(key, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid template in query parameter %q: %w", key, err)
+		}
+		var sb strings.Builder
+		if err := tmpl.Execute(&sb, data); err != nil {
+			return nil, fmt.Errorf("failed to render query parameter %q: %w", key, err)
+		}
+		rendered[key] = sb.String()
+	}
+	return rendered, nil
+}
+
+// AddQueryParams returns rawURL with params added to its query string, which is re-encoded
+func AddQueryParams(rawURL string, params map[string]string) (string, error) {
+	if len(params) == 0 {
+		return rawURL, nil
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %w", rawURL, err)
+	}
+	query := u.Query()
+	for key, value := range params {
+		query.Set(key, value)
+	}
+	u.RawQuery = query.Encode()
+	return u.String(), nil
+}
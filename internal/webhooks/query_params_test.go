@@ -0,0 +1,53 @@
+package webhooks
+
+import "testing"
+
+func TestValidateQueryParams(t *testing.T) {
+	params := map[string]string{"tenant": "{{.metadata.tenant}}", "source": "sparrow"}
+
+	if err := ValidateQueryParams(params, "https://example.com/hook?token=abc", []string{"https://backup.example.com/hook"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if err := ValidateQueryParams(nil, "sqs://orders", nil); err != nil {
+		t.Errorf("unexpected error without params: %v", err)
+	}
+
+	tests := map[string]struct {
+		params    map[string]string
+		url       string
+		fallbacks []string
+	}{
+		"conflicts with URL":      {params, "https://example.com/hook?tenant=acme", nil},
+		"conflicts with fallback": {params, "https://example.com/hook", []string{"https://backup.example.com/hook?source=x"}},
+		"SQS target":              {params, "sqs://orders", nil},
+		"empty name":              {map[string]string{"": "x"}, "https://example.com/hook", nil},
+		"bad template":            {map[string]string{"id": "{{.event_id"}, "https://example.com/hook", nil},
+	}
+	for name, tt := range tests {
+		if err := ValidateQueryParams(tt.params, tt.url, tt.fallbacks); err == nil {
+			t.Errorf("%s: expected an error", name)
+		}
+	}
+}
+
+func TestAddQueryParams(t *testing.T) {
+	data := HeaderTemplateData("evt-1", "orders", "order.created", "dlv-1", "wh-1", map[string]string{"tenant": "a&b"})
+	rendered, err := RenderQueryParams(map[string]string{"event_id": "{{.event_id}}", "tenant": "{{.metadata.tenant}}"}, data)
+	if err != nil {
+		t.Fatalf("RenderQueryParams failed: %v", err)
+	}
+
+	got, err := AddQueryParams("https://example.com/hook?token=abc", rendered)
+	if err != nil {
+		t.Fatalf("AddQueryParams failed: %v", err)
+	}
+	if want := "https://example.com/hook?event_id=evt-1&tenant=a%26b&token=abc"; got != want {
+		t.Errorf("AddQueryParams = %q, want %q", got, want)
+	}
+
+	// Missing metadata keys fail rather than render empty
+	data = HeaderTemplateData("evt-1", "orders", "order.created", "dlv-1", "wh-1", nil)
+	if _, err := RenderQueryParams(map[string]string{"tenant": "{{.metadata.tenant}}"}, data); err == nil {
+		t.Error("expected error for a missing metadata key")
+	}
+}
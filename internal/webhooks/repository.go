@@ -4,16 +4,40 @@ import (
 	"context"
 	"database/sql/driver"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
+// ErrNotFound is returned when a requested record does not exist
+var ErrNotFound = errors.New("not found")
+
 // Repository handles webhook registration storage
 type Repository struct {
 	db *pgxpool.Pool
+
+	// compressionThreshold is the payload size above which events are stored gzipped (0 = never)
+	compressionThreshold int
+
+	// payloadCipher encrypts stored event payloads when set
+	payloadCipher *PayloadCipher
+
+	// maxEventTTL caps the TTL of stored events, in seconds (0 = unlimited)
+	maxEventTTL int64
+
+	// maxWebhooksPerNamespace caps the webhooks each namespace can register (0 = unlimited)
+	maxWebhooksPerNamespace int
+
+	// strictNamespaces rejects namespaces that weren't created up front; knownNamespaces
+	// caches the ones that exist
+	strictNamespaces bool
+	knownNamespaces  sync.Map
 }
 
 // NewRepository creates a new webhook repository
@@ -21,16 +45,61 @@ func NewRepository(db *pgxpool.Pool) *Repository {
 	return &Repository{db: db}
 }
 
+// EnablePayloadCompression stores event payloads larger than thresholdBytes gzipped
+func (r *Repository) EnablePayloadCompression(thresholdBytes int) {
+	r.compressionThreshold = thresholdBytes
+}
+
+// EnablePayloadEncryption encrypts event payloads before they are stored. Payloads stored
+// earlier, in plaintext or with other keys known to cipher, remain readable.
+func (r *Repository) EnablePayloadEncryption(cipher *PayloadCipher) {
+	r.payloadCipher = cipher
+}
+
+// LimitEventTTL caps the TTL events are stored with at maxSeconds
+func (r *Repository) LimitEventTTL(maxSeconds int64) {
+	r.maxEventTTL = maxSeconds
+}
+
+// LimitWebhooksPerNamespace caps the webhooks a namespace can register at max, unless the
+// namespace's max_webhooks overrides it
+func (r *Repository) LimitWebhooksPerNamespace(max int) {
+	r.maxWebhooksPerNamespace = max
+}
+
+// dbExecutor is satisfied by both the pool and a transaction
+type dbExecutor interface {
+	Exec(ctx context.Context, sql string, args ...any) (pgconn.CommandTag, error)
+}
+
+// BeginTx starts a transaction for operations that must commit together
+func (r *Repository) BeginTx(ctx context.Context) (pgx.Tx, error) {
+	return r.db.Begin(ctx)
+}
+
+// DefaultMaxAttempts is the number of delivery attempts used when a webhook doesn't specify one
+const DefaultMaxAttempts = 3
+
+// webhookColumns is the column list shared by all webhook registration queries
+const webhookColumns = `id, namespace, events, url, headers, timeout, max_attempts, content_type, max_stored_response_bytes,
+	disable_trace_propagation, ordered, include_fields, exclude_fields, priority, fallback_urls, success_body_matcher,
+	auth_type, max_in_flight, expires_at, compress_body, batch_window_ms, batch_max_size, retry_schedule, query_params, metadata_filter, signed, bypass_proxy, active, description, created_at, updated_at`
+
 // RegisterWebhook stores a new webhook registration
 func (r *Repository) RegisterWebhook(ctx context.Context, registration *WebhookRegistration) error {
 	registration.ID = uuid.New().String()
 	registration.CreatedAt = time.Now()
 	registration.UpdatedAt = time.Now()
+	if registration.MaxAttempts <= 0 {
+		registration.MaxAttempts = DefaultMaxAttempts
+	}
 
 	query := `
 		INSERT INTO webhook_registrations (
-			id, namespace, events, url, headers, timeout, active, description, created_at, updated_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+			id, namespace, events, url, headers, timeout, max_attempts, content_type, max_stored_response_bytes,
+			disable_trace_propagation, ordered, include_fields, exclude_fields, priority, fallback_urls, success_body_matcher,
+			auth_type, max_in_flight, expires_at, compress_body, batch_window_ms, batch_max_size, retry_schedule, query_params, metadata_filter, signed, bypass_proxy, active, description, created_at, updated_at
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26, $27, $28, $29, $30, $31)
 	`
 
 	headersJSON, err := json.Marshal(registration.Headers)
@@ -43,19 +112,151 @@ func (r *Repository) RegisterWebhook(ctx context.Context, registration *WebhookR
 		return fmt.Errorf("failed to marshal events: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query,
+	includeFieldsJSON, err := marshalFieldPaths(registration.IncludeFields)
+	if err != nil {
+		return fmt.Errorf("failed to marshal include fields: %w", err)
+	}
+
+	excludeFieldsJSON, err := marshalFieldPaths(registration.ExcludeFields)
+	if err != nil {
+		return fmt.Errorf("failed to marshal exclude fields: %w", err)
+	}
+
+	fallbackURLsJSON, err := marshalFieldPaths(registration.FallbackURLs)
+	if err != nil {
+		return fmt.Errorf("failed to marshal fallback URLs: %w", err)
+	}
+
+	retryScheduleJSON, err := marshalRetrySchedule(registration.RetrySchedule)
+	if err != nil {
+		return fmt.Errorf("failed to marshal retry schedule: %w", err)
+	}
+
+	queryParams := registration.QueryParams
+	if queryParams == nil {
+		queryParams = map[string]string{}
+	}
+	queryParamsJSON, err := json.Marshal(queryParams)
+	if err != nil {
+		return fmt.Errorf("failed to marshal query params: %w", err)
+	}
+
+	metadataFilter := registration.MetadataFilter
+	if metadataFilter == nil {
+		metadataFilter = map[string]string{}
+	}
+	metadataFilterJSON, err := json.Marshal(metadataFilter)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata filter: %w", err)
+	}
+
+	// A nil matcher is stored as NULL
+	var matcherJSON []byte
+	if registration.SuccessBodyMatcher != nil {
+		matcherJSON, err = json.Marshal(registration.SuccessBodyMatcher)
+		if err != nil {
+			return fmt.Errorf("failed to marshal success body matcher: %w", err)
+		}
+	}
+
+	// Store the registration and its credentials together
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	if err := r.checkWebhookQuota(ctx, tx, registration.Namespace); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, query,
 		registration.ID,
 		registration.Namespace,
 		eventsJSON,
 		registration.URL,
 		headersJSON,
 		registration.Timeout,
+		registration.MaxAttempts,
+		registration.ContentType,
+		registration.MaxStoredResponseBytes,
+		registration.DisableTracePropagation,
+		registration.Ordered,
+		includeFieldsJSON,
+		excludeFieldsJSON,
+		registration.Priority,
+		fallbackURLsJSON,
+		matcherJSON,
+		registration.AuthType,
+		registration.MaxInFlight,
+		registration.ExpiresAt,
+		registration.CompressBody,
+		registration.BatchWindowMs,
+		registration.BatchMaxSize,
+		retryScheduleJSON,
+		queryParamsJSON,
+		metadataFilterJSON,
+		registration.Signed,
+		registration.BypassProxy,
 		registration.Active,
 		registration.Description,
 		registration.CreatedAt,
 		registration.UpdatedAt,
 	)
-	return err
+	if err != nil {
+		return err
+	}
+
+	if registration.Credentials != nil {
+		if err := storeWebhookCredentials(ctx, tx, registration.ID, registration.Credentials); err != nil {
+			return fmt.Errorf("failed to store credentials: %w", err)
+		}
+	}
+	if registration.SigningSecret != "" {
+		if err := storeSigningSecret(ctx, tx, registration.ID, registration.SigningSecret); err != nil {
+			return fmt.Errorf("failed to store signing secret: %w", err)
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+// checkWebhookQuota returns ErrWebhookQuotaExceeded if namespace already has as many webhooks
+// as it may register. An advisory lock on the namespace is held until tx ends, so concurrent
+// registrations can't both take the last slot, even when the namespace has no row to lock.
+func (r *Repository) checkWebhookQuota(ctx context.Context, tx pgx.Tx, namespace string) error {
+	_, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('webhook_registrations'), hashtext($1))`, namespace)
+	if err != nil {
+		return fmt.Errorf("failed to lock webhook quota: %w", err)
+	}
+
+	var override *int
+	err = tx.QueryRow(ctx, `SELECT max_webhooks FROM namespaces WHERE name = $1`, namespace).Scan(&override)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("failed to load webhook quota: %w", err)
+	}
+
+	limit := WebhookQuota(r.maxWebhooksPerNamespace, override)
+	if limit == 0 {
+		return nil
+	}
+
+	var count int
+	err = tx.QueryRow(ctx, `SELECT COUNT(*) FROM webhook_registrations WHERE namespace = $1`, namespace).Scan(&count)
+	if err != nil {
+		return fmt.Errorf("failed to count webhooks: %w", err)
+	}
+	if count >= limit {
+		return fmt.Errorf("%w: namespace %q has %d of %d webhooks", ErrWebhookQuotaExceeded, namespace, count, limit)
+	}
+	return nil
+}
+
+// CountWebhooks returns how many webhooks a namespace has registered, paused ones included
+func (r *Repository) CountWebhooks(ctx context.Context, namespace string) (int, error) {
+	var count int
+	err := r.db.QueryRow(ctx, `SELECT COUNT(*) FROM webhook_registrations WHERE namespace = $1`, namespace).Scan(&count)
+	return count, err
 }
 
 // UnregisterWebhook removes a webhook registration
@@ -65,60 +266,133 @@ func (r *Repository) UnregisterWebhook(ctx context.Context, webhookID string) er
 	return err
 }
 
-// GetWebhooksByEvent returns all active webhooks for a namespace/event
-func (r *Repository) GetWebhooksByEvent(ctx context.Context, namespace, event string) ([]*WebhookRegistration, error) {
+// SetWebhookActive pauses or resumes a webhook and reports whether its state changed.
+// Resuming clears the webhook's health, so it isn't disabled again for earlier failures.
+// It returns ErrNotFound if the webhook doesn't exist.
+func (r *Repository) SetWebhookActive(ctx context.Context, webhookID string, active bool) (bool, error) {
 	query := `
-		SELECT id, namespace, events, url, headers, timeout, active, description, created_at, updated_at
-		FROM webhook_registrations 
-		WHERE namespace = $1 AND active = true AND events::jsonb ? $2
+		WITH prev AS (
+			SELECT id, active FROM webhook_registrations WHERE id = $1 FOR UPDATE
+		), reset_health AS (
+			DELETE FROM webhook_health WHERE webhook_id = $1 AND $2
+		)
+		UPDATE webhook_registrations w
+		SET active = $2, updated_at = NOW()
+		FROM prev
+		WHERE w.id = prev.id
+		RETURNING prev.active
+	`
+
+	var wasActive bool
+	err := r.db.QueryRow(ctx, query, webhookID, active).Scan(&wasActive)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return false, ErrNotFound
+		}
+		return false, err
+	}
+	return wasActive != active, nil
+}
+
+// DeactivateExpiredWebhooks marks active webhooks whose expiry is at or before now inactive,
+// and returns the number deactivated
+func (r *Repository) DeactivateExpiredWebhooks(ctx context.Context, now time.Time) (int64, error) {
+	query := `
+		UPDATE webhook_registrations
+		SET active = false, updated_at = $1
+		WHERE active = true AND expires_at IS NOT NULL AND expires_at <= $1
+	`
+
+	tag, err := r.db.Exec(ctx, query, now)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// DeleteWebhooksByFilter removes every webhook in a namespace, limited to webhooks listening
+// for event when it is non-empty, and returns the number deleted and how many of those were
+// active
+func (r *Repository) DeleteWebhooksByFilter(ctx context.Context, namespace, event string) (deleted, active int64, err error) {
+	// Guard against an accidental full-table delete
+	if namespace == "" {
+		return 0, 0, fmt.Errorf("namespace is required")
+	}
+
+	query := `
+		DELETE FROM webhook_registrations
+		WHERE namespace = $1 AND ($2 = '' OR events::jsonb ? $2)
+		RETURNING active
 	`
 
 	rows, err := r.db.Query(ctx, query, namespace, event)
 	if err != nil {
-		return nil, err
+		return 0, 0, err
 	}
 	defer rows.Close()
 
-	var webhooks []*WebhookRegistration
 	for rows.Next() {
-		var wh WebhookRegistration
-		var headersJSON []byte
-		var eventsJSON []byte
-
-		err := rows.Scan(
-			&wh.ID,
-			&wh.Namespace,
-			&eventsJSON,
-			&wh.URL,
-			&headersJSON,
-			&wh.Timeout,
-			&wh.Active,
-			&wh.Description,
-			&wh.CreatedAt,
-			&wh.UpdatedAt,
-		)
-		if err != nil {
-			return nil, err
+		var wasActive bool
+		if err := rows.Scan(&wasActive); err != nil {
+			return 0, 0, err
 		}
-
-		if err := json.Unmarshal(headersJSON, &wh.Headers); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal headers: %w", err)
+		deleted++
+		if wasActive {
+			active++
 		}
+	}
+	if err := rows.Err(); err != nil {
+		return 0, 0, err
+	}
+	return deleted, active, nil
+}
 
-		if err := json.Unmarshal(eventsJSON, &wh.Events); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal events: %w", err)
-		}
+// GetWebhookByID returns a webhook registration by ID, or ErrNotFound if it doesn't exist
+func (r *Repository) GetWebhookByID(ctx context.Context, webhookID string) (*WebhookRegistration, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhook_registrations
+		WHERE id = $1
+	`
 
-		webhooks = append(webhooks, &wh)
+	wh, err := scanWebhook(r.db.QueryRow(ctx, query, webhookID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
 	}
+	return wh, err
+}
+
+// GetWebhooksByEvent returns all active, unexpired webhooks for a namespace/event
+func (r *Repository) GetWebhooksByEvent(ctx context.Context, namespace, event string) ([]*WebhookRegistration, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhook_registrations 
+		WHERE namespace = $1 AND active = true AND events::jsonb ? $2
+		  AND (expires_at IS NULL OR expires_at > NOW())
+	`
+
+	return r.getWebhooks(ctx, query, namespace, event)
+}
+
+// GetWebhooksByEventPage returns up to limit active, unexpired webhooks for a namespace/event,
+// ordered by ID and starting after afterID, so a large fan-out can be processed in batches
+func (r *Repository) GetWebhooksByEventPage(ctx context.Context, namespace, event, afterID string, limit int) ([]*WebhookRegistration, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhook_registrations
+		WHERE namespace = $1 AND active = true AND events::jsonb ? $2 AND id > $3
+		  AND (expires_at IS NULL OR expires_at > NOW())
+		ORDER BY id
+		LIMIT $4
+	`
 
-	return webhooks, nil
+	return r.getWebhooks(ctx, query, namespace, event, afterID, limit)
 }
 
 // ListWebhooks returns webhooks for a namespace
 func (r *Repository) ListWebhooks(ctx context.Context, namespace string, activeOnly bool) ([]*WebhookRegistration, error) {
 	query := `
-		SELECT id, namespace, events, url, headers, timeout, active, description, created_at, updated_at
+		SELECT ` + webhookColumns + `
 		FROM webhook_registrations 
 		WHERE namespace = $1
 	`
@@ -130,6 +404,10 @@ func (r *Repository) ListWebhooks(ctx context.Context, namespace string, activeO
 
 	query += ` ORDER BY created_at DESC`
 
+	return r.getWebhooks(ctx, query, args...)
+}
+
+func (r *Repository) getWebhooks(ctx context.Context, query string, args ...interface{}) ([]*WebhookRegistration, error) {
 	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
@@ -138,50 +416,168 @@ func (r *Repository) ListWebhooks(ctx context.Context, namespace string, activeO
 
 	var webhooks []*WebhookRegistration
 	for rows.Next() {
-		var wh WebhookRegistration
-		var headersJSON []byte
-		var eventsJSON []byte
-
-		err := rows.Scan(
-			&wh.ID,
-			&wh.Namespace,
-			&eventsJSON,
-			&wh.URL,
-			&headersJSON,
-			&wh.Timeout,
-			&wh.Active,
-			&wh.Description,
-			&wh.CreatedAt,
-			&wh.UpdatedAt,
-		)
+		wh, err := scanWebhook(rows)
 		if err != nil {
 			return nil, err
 		}
+		webhooks = append(webhooks, wh)
+	}
 
-		if err := json.Unmarshal(headersJSON, &wh.Headers); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal headers: %w", err)
-		}
+	return webhooks, rows.Err()
+}
+
+// scanWebhook scans a row selected with webhookColumns into a registration
+func scanWebhook(row pgx.Row) (*WebhookRegistration, error) {
+	var wh WebhookRegistration
+	var headersJSON []byte
+	var eventsJSON []byte
+	var includeFieldsJSON, excludeFieldsJSON, fallbackURLsJSON, matcherJSON, retryScheduleJSON, queryParamsJSON, metadataFilterJSON []byte
+
+	err := row.Scan(
+		&wh.ID,
+		&wh.Namespace,
+		&eventsJSON,
+		&wh.URL,
+		&headersJSON,
+		&wh.Timeout,
+		&wh.MaxAttempts,
+		&wh.ContentType,
+		&wh.MaxStoredResponseBytes,
+		&wh.DisableTracePropagation,
+		&wh.Ordered,
+		&includeFieldsJSON,
+		&excludeFieldsJSON,
+		&wh.Priority,
+		&fallbackURLsJSON,
+		&matcherJSON,
+		&wh.AuthType,
+		&wh.MaxInFlight,
+		&wh.ExpiresAt,
+		&wh.CompressBody,
+		&wh.BatchWindowMs,
+		&wh.BatchMaxSize,
+		&retryScheduleJSON,
+		&queryParamsJSON,
+		&metadataFilterJSON,
+		&wh.Signed,
+		&wh.BypassProxy,
+		&wh.Active,
+		&wh.Description,
+		&wh.CreatedAt,
+		&wh.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(headersJSON, &wh.Headers); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal headers: %w", err)
+	}
+
+	if err := json.Unmarshal(eventsJSON, &wh.Events); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal events: %w", err)
+	}
+
+	if err := json.Unmarshal(includeFieldsJSON, &wh.IncludeFields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal include fields: %w", err)
+	}
 
-		if err := json.Unmarshal(eventsJSON, &wh.Events); err != nil {
-			return nil, fmt.Errorf("failed to unmarshal events: %w", err)
+	if err := json.Unmarshal(excludeFieldsJSON, &wh.ExcludeFields); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal exclude fields: %w", err)
+	}
+
+	if err := json.Unmarshal(fallbackURLsJSON, &wh.FallbackURLs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal fallback URLs: %w", err)
+	}
+
+	if err := json.Unmarshal(retryScheduleJSON, &wh.RetrySchedule); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal retry schedule: %w", err)
+	}
+
+	if err := json.Unmarshal(queryParamsJSON, &wh.QueryParams); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal query params: %w", err)
+	}
+
+	if err := json.Unmarshal(metadataFilterJSON, &wh.MetadataFilter); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal metadata filter: %w", err)
+	}
+
+	if matcherJSON != nil {
+		if err := json.Unmarshal(matcherJSON, &wh.SuccessBodyMatcher); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal success body matcher: %w", err)
 		}
+	}
 
-		webhooks = append(webhooks, &wh)
+	return &wh, nil
+}
+
+// marshalFieldPaths encodes a string list such as field paths, storing nil as an empty array
+func marshalFieldPaths(paths []string) ([]byte, error) {
+	if paths == nil {
+		paths = []string{}
 	}
+	return json.Marshal(paths)
+}
 
-	return webhooks, nil
+// marshalRetrySchedule encodes a retry schedule, storing nil as an empty array
+func marshalRetrySchedule(schedule []int) ([]byte, error) {
+	if schedule == nil {
+		schedule = []int{}
+	}
+	return json.Marshal(schedule)
 }
 
 // StoreEvent stores an event record
 func (r *Repository) StoreEvent(ctx context.Context, event *EventRecord) error {
-	event.ID = uuid.New().String()
+	return r.storeEvent(ctx, r.db, event)
+}
+
+// StoreEventTx stores an event record within tx, like StoreEvent
+func (r *Repository) StoreEventTx(ctx context.Context, tx pgx.Tx, event *EventRecord) error {
+	return r.storeEvent(ctx, tx, event)
+}
+
+func (r *Repository) storeEvent(ctx context.Context, db dbExecutor, event *EventRecord) error {
+	if event.ID == "" {
+		event.ID = uuid.New().String()
+	}
 	event.CreatedAt = time.Now()
+	if r.maxEventTTL > 0 && event.TTL > r.maxEventTTL {
+		event.TTL = r.maxEventTTL
+	}
 	event.ExpiresAt = time.Now().Add(time.Duration(event.TTL) * time.Second)
 
+	// Compress large payloads in storage only; event.Payload keeps the original
+	storedPayload := event.Payload
+	event.Compressed = false
+	if r.compressionThreshold > 0 && len(event.Payload) > r.compressionThreshold {
+		compressed, err := compressPayload(event.Payload)
+		if err != nil {
+			return fmt.Errorf("failed to compress payload: %w", err)
+		}
+		storedPayload = compressed
+		event.Compressed = true
+	}
+
+	// Encrypt after compressing; ciphertext doesn't compress
+	event.KeyVersion = 0
+	if r.payloadCipher != nil {
+		encrypted, version, err := r.payloadCipher.encrypt(event.ID, storedPayload)
+		if err != nil {
+			return fmt.Errorf("failed to encrypt payload: %w", err)
+		}
+		storedPayload = encrypted
+		event.KeyVersion = version
+	}
+
+	payloadText, payloadBytes := splitStoredPayload(storedPayload)
+
 	query := `
 		INSERT INTO event_records (
-			id, namespace, event, payload, ttl, metadata, created_at, expires_at
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+			id, namespace, event, payload, content_type, compressed, ttl, metadata, created_at, expires_at, key_version,
+			payload_bytes
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, NULLIF($11, 0), $12)
+		ON CONFLICT (id) DO NOTHING
 	`
 
 	metadataJSON, err := json.Marshal(event.Metadata)
@@ -189,33 +585,126 @@ func (r *Repository) StoreEvent(ctx context.Context, event *EventRecord) error {
 		return fmt.Errorf("failed to marshal metadata: %w", err)
 	}
 
-	_, err = r.db.Exec(ctx, query,
+	_, err = db.Exec(ctx, query,
 		event.ID,
 		event.Namespace,
 		event.Event,
-		event.Payload,
+		payloadText,
+		event.ContentType,
+		event.Compressed,
 		event.TTL,
 		metadataJSON,
 		event.CreatedAt,
 		event.ExpiresAt,
+		event.KeyVersion,
+		payloadBytes,
 	)
 	return err
 }
 
-// CreateDelivery creates a webhook delivery record
-func (r *Repository) CreateDelivery(ctx context.Context, delivery *WebhookDelivery) error {
-	delivery.ID = uuid.New().String()
+// eventColumns is the column list shared by event record queries
+const eventColumns = `id, namespace, event, payload, content_type, compressed, ttl, metadata, created_at, expires_at,
+	COALESCE(key_version, 0), total_deliveries, succeeded_deliveries, failed_deliveries, pending_deliveries, payload_bytes`
+
+// GetEvent returns an event record by ID with its payload decrypted and decompressed, or ErrNotFound
+func (r *Repository) GetEvent(ctx context.Context, eventID string) (*EventRecord, error) {
+	query := `
+		SELECT ` + eventColumns + `
+		FROM event_records
+		WHERE id = $1
+	`
+
+	event, err := r.scanEvent(r.db.QueryRow(ctx, query, eventID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, ErrNotFound
+	}
+	return event, err
+}
+
+// scanEvent scans a row selected with eventColumns (plus any extra destinations) into an
+// event record, decrypting and decompressing its payload
+func (r *Repository) scanEvent(row pgx.Row, extra ...any) (*EventRecord, error) {
+	var event EventRecord
+	var metadataJSON, payloadBytes []byte
+
+	dest := []any{
+		&event.ID,
+		&event.Namespace,
+		&event.Event,
+		&event.Payload,
+		&event.ContentType,
+		&event.Compressed,
+		&event.TTL,
+		&metadataJSON,
+		&event.CreatedAt,
+		&event.ExpiresAt,
+		&event.KeyVersion,
+		&event.Deliveries.Total,
+		&event.Deliveries.Succeeded,
+		&event.Deliveries.Failed,
+		&event.Deliveries.Pending,
+		&payloadBytes,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	event.Payload = joinStoredPayload(event.Payload, payloadBytes)
+
+	if event.KeyVersion != 0 {
+		if r.payloadCipher == nil {
+			return nil, fmt.Errorf("event %s is encrypted but payload encryption is not configured", event.ID)
+		}
+		payload, err := r.payloadCipher.decrypt(event.ID, event.Payload, event.KeyVersion)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decrypt payload: %w", err)
+		}
+		event.Payload = payload
+	}
+
+	if event.Compressed {
+		payload, err := decompressPayload(event.Payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to decompress payload: %w", err)
+		}
+		event.Payload = payload
+	}
+
+	if len(metadataJSON) > 0 {
+		if err := json.Unmarshal(metadataJSON, &event.Metadata); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+		}
+	}
+
+	return &event, nil
+}
+
+// CreateDelivery creates a webhook delivery record. It reports false, without error, if the
+// webhook already has a delivery for the event.
+func (r *Repository) CreateDelivery(ctx context.Context, delivery *WebhookDelivery) (bool, error) {
+	return createDelivery(ctx, r.db, delivery)
+}
+
+// CreateDeliveryTx creates a webhook delivery record within tx, like CreateDelivery
+func (r *Repository) CreateDeliveryTx(ctx context.Context, tx pgx.Tx, delivery *WebhookDelivery) (bool, error) {
+	return createDelivery(ctx, tx, delivery)
+}
+
+func createDelivery(ctx context.Context, db dbExecutor, delivery *WebhookDelivery) (bool, error) {
+	if delivery.ID == "" {
+		delivery.ID = uuid.New().String()
+	}
 	delivery.CreatedAt = time.Now()
 	delivery.Status = StatusPending
 
 	query := `
 		INSERT INTO webhook_deliveries (
 			id, webhook_id, event_id, status, attempt_count, max_attempts, 
-			created_at, expires_at, response_code, response_body, error_message
-		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+			created_at, expires_at, response_code, response_body, error_message, batched
+		) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		ON CONFLICT (webhook_id, event_id) DO NOTHING
 	`
 
-	_, err := r.db.Exec(ctx, query,
+	tag, err := db.Exec(ctx, query,
 		delivery.ID,
 		delivery.WebhookID,
 		delivery.EventID,
@@ -227,30 +716,115 @@ func (r *Repository) CreateDelivery(ctx context.Context, delivery *WebhookDelive
 		delivery.ResponseCode,
 		delivery.ResponseBody,
 		delivery.ErrorMessage,
+		delivery.Batched,
 	)
-	return err
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
 }
 
 // UpdateDeliveryStatus updates the status of a webhook delivery
 func (r *Repository) UpdateDeliveryStatus(ctx context.Context, deliveryID string, status WebhookDeliveryStatus, responseCode int, responseBody, errorMessage string) error {
+	return r.RecordDeliveryAttempt(ctx, deliveryID, &DeliveryAttempt{
+		Status:       status,
+		ResponseCode: responseCode,
+		ResponseBody: responseBody,
+		ErrorMessage: errorMessage,
+	})
+}
+
+// RecordDeliveryAttempt stores the outcome of a delivery attempt. A successful attempt marks
+// the delivery as delivered in the same update.
+func (r *Repository) RecordDeliveryAttempt(ctx context.Context, deliveryID string, attempt *DeliveryAttempt) error {
+	return recordDeliveryAttempt(ctx, r.db, deliveryID, attempt)
+}
+
+func recordDeliveryAttempt(ctx context.Context, db dbExecutor, deliveryID string, attempt *DeliveryAttempt) error {
 	now := time.Now()
 	query := `
 		UPDATE webhook_deliveries 
 		SET status = $2, last_attempted_at = $3, response_code = $4, response_body = $5, error_message = $6,
-		    attempt_count = attempt_count + 1
+		    response_content_type = $7, response_url = $8,
+		    failure_reason = NULLIF($9, '')::delivery_failure_reason, duration_ms = NULLIF($10, 0),
+		    request_headers = $11, response_headers = $12, response_truncated = $13,
+		    delivered_at = CASE WHEN $2 = 'success' THEN COALESCE(delivered_at, $3) ELSE delivered_at END,
+		    attempt_count = attempt_count + CASE WHEN $2 = 'sending' THEN 1 ELSE 0 END
 		WHERE id = $1
 	`
 
-	_, err := r.db.Exec(ctx, query, deliveryID, status, now, responseCode, responseBody, errorMessage)
+	requestHeaders, err := headersJSON(attempt.RequestHeaders)
+	if err != nil {
+		return err
+	}
+	responseHeaders, err := headersJSON(attempt.ResponseHeaders)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Exec(ctx, query, deliveryID, attempt.Status, now, attempt.ResponseCode,
+		attempt.ResponseBody, attempt.ErrorMessage, attempt.ResponseContentType, attempt.ResponseURL,
+		string(attempt.FailureReason), attempt.Duration.Milliseconds(), requestHeaders, responseHeaders,
+		attempt.ResponseTruncated)
 	return err
 }
 
+// headersJSON encodes captured headers for a JSONB column, or NULL when there are none
+func headersJSON(headers map[string]string) ([]byte, error) {
+	if len(headers) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(headers)
+}
+
+// GetDeliveryAttempts returns how many attempts a delivery has made and how many it allows
+func (r *Repository) GetDeliveryAttempts(ctx context.Context, deliveryID string) (attemptCount, maxAttempts int, err error) {
+	query := `SELECT attempt_count, max_attempts FROM webhook_deliveries WHERE id = $1`
+
+	err = r.db.QueryRow(ctx, query, deliveryID).Scan(&attemptCount, &maxAttempts)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, 0, ErrNotFound
+	}
+	return attemptCount, maxAttempts, err
+}
+
+// IsDelivered reports whether a delivery has been acknowledged by its receiver, or returns
+// ErrNotFound if it doesn't exist
+func (r *Repository) IsDelivered(ctx context.Context, deliveryID string) (bool, error) {
+	query := `SELECT delivered_at IS NOT NULL FROM webhook_deliveries WHERE id = $1`
+
+	var delivered bool
+	err := r.db.QueryRow(ctx, query, deliveryID).Scan(&delivered)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, ErrNotFound
+	}
+	return delivered, err
+}
+
+// deliveryColumns is the column list shared by all webhook delivery queries
+const deliveryColumns = `id, webhook_id, event_id, status, attempt_count, max_attempts, 
+		       created_at, last_attempted_at, next_retry_at, expires_at,
+		       response_code, response_body, response_content_type, response_url, error_message,
+		       COALESCE(failure_reason::text, ''), request_headers, response_headers, response_truncated`
+
+// GetDeliveryByID returns a delivery, or ErrNotFound
+func (r *Repository) GetDeliveryByID(ctx context.Context, deliveryID string) (*WebhookDelivery, error) {
+	query := `SELECT ` + deliveryColumns + ` FROM webhook_deliveries WHERE id = $1`
+
+	d, err := scanDelivery(r.db.QueryRow(ctx, query, deliveryID))
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return d, nil
+}
+
 // GetDeliveriesByWebhook returns deliveries for a specific webhook
 func (r *Repository) GetDeliveriesByWebhook(ctx context.Context, webhookID string) ([]*WebhookDelivery, error) {
 	query := `
-		SELECT id, webhook_id, event_id, status, attempt_count, max_attempts, 
-		       created_at, last_attempted_at, next_retry_at, expires_at,
-		       response_code, response_body, error_message
+		SELECT ` + deliveryColumns + `
 		FROM webhook_deliveries 
 		WHERE webhook_id = $1 
 		ORDER BY created_at DESC
@@ -262,9 +836,7 @@ func (r *Repository) GetDeliveriesByWebhook(ctx context.Context, webhookID strin
 // GetDeliveriesByEvent returns deliveries for a specific event
 func (r *Repository) GetDeliveriesByEvent(ctx context.Context, eventID string) ([]*WebhookDelivery, error) {
 	query := `
-		SELECT id, webhook_id, event_id, status, attempt_count, max_attempts, 
-		       created_at, last_attempted_at, next_retry_at, expires_at,
-		       response_code, response_body, error_message
+		SELECT ` + deliveryColumns + `
 		FROM webhook_deliveries 
 		WHERE event_id = $1 
 		ORDER BY created_at DESC
@@ -273,8 +845,8 @@ func (r *Repository) GetDeliveriesByEvent(ctx context.Context, eventID string) (
 	return r.getDeliveries(ctx, query, eventID)
 }
 
-func (r *Repository) getDeliveries(ctx context.Context, query string, arg interface{}) ([]*WebhookDelivery, error) {
-	rows, err := r.db.Query(ctx, query, arg)
+func (r *Repository) getDeliveries(ctx context.Context, query string, args ...interface{}) ([]*WebhookDelivery, error) {
+	rows, err := r.db.Query(ctx, query, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -282,31 +854,146 @@ func (r *Repository) getDeliveries(ctx context.Context, query string, arg interf
 
 	var deliveries []*WebhookDelivery
 	for rows.Next() {
-		var d WebhookDelivery
-
-		err := rows.Scan(
-			&d.ID,
-			&d.WebhookID,
-			&d.EventID,
-			&d.Status,
-			&d.AttemptCount,
-			&d.MaxAttempts,
-			&d.CreatedAt,
-			&d.LastAttemptedAt,
-			&d.NextRetryAt,
-			&d.ExpiresAt,
-			&d.ResponseCode,
-			&d.ResponseBody,
-			&d.ErrorMessage,
-		)
+		d, err := scanDelivery(rows)
 		if err != nil {
 			return nil, err
 		}
+		deliveries = append(deliveries, d)
+	}
+
+	return deliveries, rows.Err()
+}
+
+// scanDelivery scans a row selected with deliveryColumns into a delivery. Any extra
+// destinations receive the columns selected after deliveryColumns.
+func scanDelivery(row pgx.Row, extra ...any) (*WebhookDelivery, error) {
+	var d WebhookDelivery
+
+	dest := []any{
+		&d.ID,
+		&d.WebhookID,
+		&d.EventID,
+		&d.Status,
+		&d.AttemptCount,
+		&d.MaxAttempts,
+		&d.CreatedAt,
+		&d.LastAttemptedAt,
+		&d.NextRetryAt,
+		&d.ExpiresAt,
+		&d.ResponseCode,
+		&d.ResponseBody,
+		&d.ResponseContentType,
+		&d.ResponseURL,
+		&d.ErrorMessage,
+		&d.FailureReason,
+		&d.RequestHeaders,
+		&d.ResponseHeaders,
+		&d.ResponseTruncated,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+
+	return &d, nil
+}
+
+// HasEarlierUndeliveredDelivery reports whether the webhook has a delivery created before
+// deliveryID that is still pending, in flight, or failed with attempts and time remaining
+func (r *Repository) HasEarlierUndeliveredDelivery(ctx context.Context, webhookID, deliveryID string) (bool, error) {
+	query := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM webhook_deliveries d
+			JOIN webhook_deliveries cur ON cur.id = $2
+			WHERE d.webhook_id = $1
+			  AND d.id <> cur.id
+			  AND (d.created_at, d.id) < (cur.created_at, cur.id)
+			  AND (
+			      d.status IN ('pending', 'sending', 'retrying')
+			      OR (d.status = 'failed' AND d.attempt_count < d.max_attempts AND d.expires_at > NOW())
+			  )
+		)
+	`
+
+	var exists bool
+	err := r.db.QueryRow(ctx, query, webhookID, deliveryID).Scan(&exists)
+	return exists, err
+}
+
+// StartDeliveryWithinLimit marks a delivery as sending if the webhook has fewer than
+// maxInFlight other deliveries sending, and reports whether it did. The check and update
+// run under a per-webhook advisory lock so concurrent workers can't exceed the limit.
+func (r *Repository) StartDeliveryWithinLimit(ctx context.Context, webhookID, deliveryID string, maxInFlight int) (bool, error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	if _, err := tx.Exec(ctx, `SELECT pg_advisory_xact_lock(hashtext('webhook_in_flight:' || $1))`, webhookID); err != nil {
+		return false, fmt.Errorf("failed to lock webhook: %w", err)
+	}
+
+	var inFlight int
+	query := `
+		SELECT COUNT(*)
+		FROM webhook_deliveries
+		WHERE webhook_id = $1 AND status = 'sending' AND id <> $2
+	`
+	if err := tx.QueryRow(ctx, query, webhookID, deliveryID).Scan(&inFlight); err != nil {
+		return false, err
+	}
+	if inFlight >= maxInFlight {
+		return false, nil
+	}
 
-		deliveries = append(deliveries, &d)
+	if err := recordDeliveryAttempt(ctx, tx, deliveryID, &DeliveryAttempt{Status: StatusSending}); err != nil {
+		return false, err
 	}
+	return true, tx.Commit(ctx)
+}
 
-	return deliveries, nil
+// DeleteExpiredEvents deletes up to limit event records whose expiry has passed and whose
+// deliveries are all terminal and created before olderThan. Deleting an event cascades to its
+// deliveries, so events are kept until their delivery history is past retention.
+func (r *Repository) DeleteExpiredEvents(ctx context.Context, now, olderThan time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM event_records
+		WHERE id IN (
+			SELECT id FROM event_records e
+			WHERE e.expires_at < $1
+			  AND NOT EXISTS (
+				SELECT 1 FROM webhook_deliveries d
+				WHERE d.event_id = e.id
+				  AND (d.status NOT IN ('success', 'failed', 'expired') OR d.created_at >= $2)
+			  )
+			LIMIT $3
+		)
+	`
+
+	tag, err := r.db.Exec(ctx, query, now, olderThan, limit)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// DeleteTerminalDeliveries deletes up to limit deliveries in a terminal state created before olderThan
+func (r *Repository) DeleteTerminalDeliveries(ctx context.Context, olderThan time.Time, limit int) (int64, error) {
+	query := `
+		DELETE FROM webhook_deliveries
+		WHERE id IN (
+			SELECT id FROM webhook_deliveries
+			WHERE status IN ('success', 'failed', 'expired') AND created_at < $1
+			LIMIT $2
+		)
+	`
+
+	tag, err := r.db.Exec(ctx, query, olderThan, limit)
+	if err != nil {
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
 }
 
 // Ensure we can store map[string]string as JSON in the database
@@ -0,0 +1,43 @@
+package webhooks
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MaxRetryScheduleLength caps the delays in a retry schedule: one per retry of the most
+	// attempts a webhook can make
+	MaxRetryScheduleLength = 24
+
+	// MaxRetryScheduleDelay caps each delay in a retry schedule
+	MaxRetryScheduleDelay = 24 * time.Hour
+)
+
+// ValidateRetrySchedule checks a webhook's retry schedule: delays in seconds before each
+// retry, which may start at 0 (retry immediately) and never decrease
+func ValidateRetrySchedule(schedule []int) error {
+	if len(schedule) > MaxRetryScheduleLength {
+		return fmt.Errorf("retry_schedule_seconds can have at most %d delays", MaxRetryScheduleLength)
+	}
+	maxSeconds := int(MaxRetryScheduleDelay / time.Second)
+	for i, seconds := range schedule {
+		if seconds < 0 || seconds > maxSeconds {
+			return fmt.Errorf("retry_schedule_seconds[%d] must be between 0 and %d", i, maxSeconds)
+		}
+		if i > 0 && seconds < schedule[i-1] {
+			return fmt.Errorf("retry_schedule_seconds must not decrease, but %d follows %d", seconds, schedule[i-1])
+		}
+	}
+	return nil
+}
+
+// ScheduledRetryDelay returns how long to wait after a delivery's attempt-th attempt
+// (counting from 1) failed, according to the schedule. It reports false once the schedule
+// is exhausted, leaving the delay to the default backoff.
+func ScheduledRetryDelay(schedule []int, attempt int) (time.Duration, bool) {
+	if attempt < 1 || attempt > len(schedule) {
+		return 0, false
+	}
+	return time.Duration(schedule[attempt-1]) * time.Second, true
+}
@@ -0,0 +1,54 @@
+package webhooks
+
+import (
+	"testing"
+	"time"
+)
+
+func TestValidateRetrySchedule(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule []int
+		wantErr  bool
+	}{
+		{"empty", nil, false},
+		{"immediate then growing", []int{0, 60, 600, 3600}, false},
+		{"repeated delays", []int{30, 30, 30}, false},
+		{"decreasing", []int{60, 10}, true},
+		{"negative", []int{-1}, true},
+		{"delay too long", []int{int(MaxRetryScheduleDelay/time.Second) + 1}, true},
+		{"too many delays", make([]int, MaxRetryScheduleLength+1), true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateRetrySchedule(tt.schedule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ValidateRetrySchedule(%v) error = %v, wantErr %v", tt.schedule, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestScheduledRetryDelay(t *testing.T) {
+	schedule := []int{0, 60, 600}
+
+	tests := []struct {
+		attempt int
+		want    time.Duration
+		wantOK  bool
+	}{
+		{1, 0, true},
+		{2, time.Minute, true},
+		{3, 10 * time.Minute, true},
+		{4, 0, false}, // exhausted, default backoff applies
+		{0, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ScheduledRetryDelay(schedule, tt.attempt)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("ScheduledRetryDelay(attempt %d) = %v, %v; want %v, %v", tt.attempt, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
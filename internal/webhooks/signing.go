@@ -0,0 +1,122 @@
+package webhooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+
+	"github.com/sarathsp06/sparrow/pkg/verify"
+)
+
+// MinSigningSecretLength is the shortest signing secret a webhook can have
+const MinSigningSecretLength = 16
+
+// Bounds of the grace period during which a rotated-out secret still signs deliveries
+const (
+	DefaultSecretGracePeriod = 24 * time.Hour
+	MaxSecretGracePeriod     = 7 * 24 * time.Hour
+)
+
+// SigningSecrets sign a webhook's deliveries. They're stored apart from the registration and
+// never returned by the API.
+type SigningSecrets struct {
+	Secret            string
+	PreviousSecret    string     // Rotated-out secret, still used until PreviousExpiresAt
+	PreviousExpiresAt *time.Time // When the previous secret stops signing deliveries
+}
+
+// Active returns the secrets deliveries are signed with at now: the current secret, and the
+// previous one during a rotation's grace period
+func (s *SigningSecrets) Active(now time.Time) []string {
+	secrets := []string{s.Secret}
+	if s.PreviousSecret != "" && s.PreviousExpiresAt != nil && now.Before(*s.PreviousExpiresAt) {
+		secrets = append(secrets, s.PreviousSecret)
+	}
+	return secrets
+}
+
+// ValidateSigningSecret checks a webhook's signing secret
+func ValidateSigningSecret(secret string) error {
+	if len(secret) < MinSigningSecretLength {
+		return fmt.Errorf("signing secret must be at least %d characters", MinSigningSecretLength)
+	}
+	return nil
+}
+
+// SignatureHeaders returns the timestamp and signature header values of a body signed at
+// now with each of secrets, as checked by the verify package
+func SignatureHeaders(secrets []string, body []byte, now time.Time) (timestamp, signature string) {
+	signatures := make([]string, len(secrets))
+	for i, secret := range secrets {
+		signatures[i] = verify.Sign(secret, body, now)
+	}
+	return strconv.FormatInt(now.Unix(), 10), strings.Join(signatures, " ")
+}
+
+// GetSigningSecrets returns a webhook's signing secrets, or ErrNotFound
+func (r *Repository) GetSigningSecrets(ctx context.Context, webhookID string) (*SigningSecrets, error) {
+	query := `SELECT secret, previous_secret, previous_expires_at FROM webhook_signing_secrets WHERE webhook_id = $1`
+
+	var (
+		secrets  SigningSecrets
+		previous *string
+	)
+	err := r.db.QueryRow(ctx, query, webhookID).Scan(&secrets.Secret, &previous, &secrets.PreviousExpiresAt)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	if previous != nil {
+		secrets.PreviousSecret = *previous
+	}
+	return &secrets, nil
+}
+
+// RotateSigningSecretTx makes secret a webhook's signing secret within tx. The current
+// secret becomes the previous one, which keeps signing deliveries until previousExpiresAt.
+// It returns ErrNotFound if the webhook isn't signed.
+func (r *Repository) RotateSigningSecretTx(ctx context.Context, tx pgx.Tx, webhookID, secret string, previousExpiresAt time.Time) error {
+	query := `
+		UPDATE webhook_signing_secrets
+		SET previous_secret = secret, previous_expires_at = $3, secret = $2, updated_at = NOW()
+		WHERE webhook_id = $1
+	`
+
+	tag, err := tx.Exec(ctx, query, webhookID, secret, previousExpiresAt)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// ClearExpiredPreviousSecret removes a webhook's previous signing secret once its grace
+// period has ended at now, reporting whether there was one to remove
+func (r *Repository) ClearExpiredPreviousSecret(ctx context.Context, webhookID string, now time.Time) (bool, error) {
+	query := `
+		UPDATE webhook_signing_secrets
+		SET previous_secret = NULL, previous_expires_at = NULL, updated_at = NOW()
+		WHERE webhook_id = $1 AND previous_expires_at <= $2
+	`
+
+	tag, err := r.db.Exec(ctx, query, webhookID, now)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
+
+func storeSigningSecret(ctx context.Context, db dbExecutor, webhookID, secret string) error {
+	query := `INSERT INTO webhook_signing_secrets (webhook_id, secret) VALUES ($1, $2)`
+	_, err := db.Exec(ctx, query, webhookID, secret)
+	return err
+}
@@ -0,0 +1,73 @@
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sarathsp06/sparrow/pkg/verify"
+)
+
+func TestSigningSecretRotation(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+
+	webhook := &WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com", Signed: true, SigningSecret: "old-secret-0123456789"}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+
+	tx, _ := store.BeginTx(ctx)
+	expiresAt := time.Now().Add(time.Hour)
+	if err := store.RotateSigningSecretTx(ctx, tx, webhook.ID, "new-secret-0123456789", expiresAt); err != nil {
+		t.Fatal(err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	secrets, err := store.GetSigningSecrets(ctx, webhook.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// During the grace period deliveries are signed with both secrets, and verify with either
+	active := secrets.Active(time.Now())
+	if len(active) != 2 || active[0] != "new-secret-0123456789" || active[1] != "old-secret-0123456789" {
+		t.Fatalf("active secrets = %v", active)
+	}
+	body := []byte(`{"id": 1}`)
+	timestamp, signature := SignatureHeaders(active, body, time.Now())
+	if strings.Count(signature, "v1=") != 2 {
+		t.Errorf("signature header = %q, want two signatures", signature)
+	}
+	for _, secret := range active {
+		if err := verify.VerifySignature(secret, body, signature, timestamp, 0); err != nil {
+			t.Errorf("verifying with %q: %v", secret, err)
+		}
+	}
+	if got := secrets.Active(expiresAt); len(got) != 1 {
+		t.Errorf("active secrets after the grace period = %v", got)
+	}
+
+	// The previous secret is only cleared once its grace period has ended
+	if cleared, _ := store.ClearExpiredPreviousSecret(ctx, webhook.ID, time.Now()); cleared {
+		t.Error("previous secret cleared during its grace period")
+	}
+	if cleared, _ := store.ClearExpiredPreviousSecret(ctx, webhook.ID, expiresAt); !cleared {
+		t.Error("previous secret not cleared after its grace period")
+	}
+	if secrets, _ := store.GetSigningSecrets(ctx, webhook.ID); secrets.PreviousSecret != "" {
+		t.Errorf("previous secret = %q after clearing", secrets.PreviousSecret)
+	}
+
+	// Unsigned webhooks have nothing to rotate
+	unsigned := &WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com"}
+	store.RegisterWebhook(ctx, unsigned)
+	tx, _ = store.BeginTx(ctx)
+	defer tx.Rollback(ctx)
+	if err := store.RotateSigningSecretTx(ctx, tx, unsigned.ID, "new-secret-0123456789", expiresAt); err != ErrNotFound {
+		t.Errorf("rotating an unsigned webhook: %v, want ErrNotFound", err)
+	}
+}
@@ -0,0 +1,93 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// WebhookStore is the storage used by the servers and workers. Repository implements it
+// with Postgres; MemoryStore keeps everything in memory for tests.
+type WebhookStore interface {
+	// Transactions, for operations that must commit together with River jobs
+	BeginTx(ctx context.Context) (pgx.Tx, error)
+
+	// Webhooks
+	RegisterWebhook(ctx context.Context, registration *WebhookRegistration) error
+	UnregisterWebhook(ctx context.Context, webhookID string) error
+	SetWebhookActive(ctx context.Context, webhookID string, active bool) (bool, error)
+	DeactivateExpiredWebhooks(ctx context.Context, now time.Time) (int64, error)
+	DeleteWebhooksByFilter(ctx context.Context, namespace, event string) (deleted, active int64, err error)
+	GetWebhookByID(ctx context.Context, webhookID string) (*WebhookRegistration, error)
+	GetWebhooksByEvent(ctx context.Context, namespace, event string) ([]*WebhookRegistration, error)
+	GetWebhooksByEventPage(ctx context.Context, namespace, event, afterID string, limit int) ([]*WebhookRegistration, error)
+	ListWebhooks(ctx context.Context, namespace string, activeOnly bool) ([]*WebhookRegistration, error)
+	ListAllWebhooks(ctx context.Context, filter WebhookListFilter) ([]*WebhookRegistration, error)
+	GetWebhookCredentials(ctx context.Context, webhookID string) (*WebhookCredentials, error)
+	GetSigningSecrets(ctx context.Context, webhookID string) (*SigningSecrets, error)
+	RotateSigningSecretTx(ctx context.Context, tx pgx.Tx, webhookID, secret string, previousExpiresAt time.Time) error
+	ClearExpiredPreviousSecret(ctx context.Context, webhookID string, now time.Time) (bool, error)
+	GetWebhookNamespace(ctx context.Context, webhookID string) (string, error)
+	CountWebhooks(ctx context.Context, namespace string) (int, error)
+
+	// Webhook health
+	RecordWebhookHealth(ctx context.Context, webhookID string, success bool, now time.Time, window time.Duration) (*WebhookHealth, error)
+	GetWebhookHealth(ctx context.Context, webhookID string) (*WebhookHealth, error)
+	DisableUnhealthyWebhook(ctx context.Context, webhookID string, now time.Time) (bool, error)
+
+	// Events
+	StoreEvent(ctx context.Context, event *EventRecord) error
+	StoreEventTx(ctx context.Context, tx pgx.Tx, event *EventRecord) error
+	GetEvent(ctx context.Context, eventID string) (*EventRecord, error)
+	GetEventNamespace(ctx context.Context, eventID string) (string, error)
+	ListEvents(ctx context.Context, filter EventListFilter) ([]*ListedEvent, error)
+	ListEventTypes(ctx context.Context, filter EventTypeListFilter) ([]*EventType, error)
+	DeleteExpiredEvents(ctx context.Context, now, olderThan time.Time, limit int) (int64, error)
+	RecordEventProcessingFailure(ctx context.Context, failure *EventProcessingFailure) error
+	ListEventFailures(ctx context.Context, filter EventFailureListFilter) ([]*EventProcessingFailure, error)
+	UpsertEventSchema(ctx context.Context, schema *EventSchema) error
+	GetEventSchema(ctx context.Context, namespace, event string) (*EventSchema, error)
+
+	// Deliveries
+	CreateDelivery(ctx context.Context, delivery *WebhookDelivery) (bool, error)
+	CreateDeliveryTx(ctx context.Context, tx pgx.Tx, delivery *WebhookDelivery) (bool, error)
+	UpdateDeliveryStatus(ctx context.Context, deliveryID string, status WebhookDeliveryStatus, responseCode int, responseBody, errorMessage string) error
+	RecordDeliveryAttempt(ctx context.Context, deliveryID string, attempt *DeliveryAttempt) error
+	GetDeliveryAttempts(ctx context.Context, deliveryID string) (attemptCount, maxAttempts int, err error)
+	IsDelivered(ctx context.Context, deliveryID string) (bool, error)
+	GetDeliveryByID(ctx context.Context, deliveryID string) (*WebhookDelivery, error)
+	GetDeliveryNamespace(ctx context.Context, deliveryID string) (string, error)
+	GetDeliveriesByWebhook(ctx context.Context, webhookID string) ([]*WebhookDelivery, error)
+	GetDeliveriesByEvent(ctx context.Context, eventID string) ([]*WebhookDelivery, error)
+	GetDeliveriesBatch(ctx context.Context, webhookIDs, eventIDs []string) ([]*WebhookDelivery, error)
+	ListDeliveries(ctx context.Context, filter DeliveryListFilter) ([]*WebhookDelivery, error)
+	GetDeliveryStats(ctx context.Context, namespace string, since, until time.Time) (*NamespaceDeliveryStats, error)
+	WatchDeliveries(ctx context.Context, webhookID, eventID string, interval time.Duration, send func(*WebhookDelivery) error) error
+	HasEarlierUndeliveredDelivery(ctx context.Context, webhookID, deliveryID string) (bool, error)
+	StartDeliveryWithinLimit(ctx context.Context, webhookID, deliveryID string, maxInFlight int) (bool, error)
+	ClaimBatchDeliveries(ctx context.Context, webhookID string, limit int, staleBefore time.Time) ([]*WebhookDelivery, error)
+	ExpireBatchDeliveries(ctx context.Context, webhookID string) ([]*WebhookDelivery, error)
+	FindStuckDeliveries(ctx context.Context, olderThan time.Time, limit int) ([]*DeliveryJob, error)
+	ResolveStuckDelivery(ctx context.Context, deliveryID string, status WebhookDeliveryStatus, errorMessage string) (bool, error)
+	ResolveStuckDeliveryTx(ctx context.Context, tx pgx.Tx, deliveryID string, status WebhookDeliveryStatus, errorMessage string) (bool, error)
+	GetDeliveryJob(ctx context.Context, deliveryID string) (*DeliveryJob, error)
+	ExtendExpiredDeliveryTx(ctx context.Context, tx pgx.Tx, deliveryID string, expiresAt time.Time) (bool, error)
+	DeleteTerminalDeliveries(ctx context.Context, olderThan time.Time, limit int) (int64, error)
+
+	// Namespaces
+	CreateNamespace(ctx context.Context, namespace *Namespace) error
+	EnsureNamespace(ctx context.Context, name string) error
+	SetNamespaceDefaults(ctx context.Context, namespace string, defaults *NamespaceDefaults) error
+	GetNamespaceDefaults(ctx context.Context, namespace string) (*NamespaceDefaults, error)
+
+	// API keys
+	CreateAPIKey(ctx context.Context, key *APIKey) error
+	RevokeAPIKey(ctx context.Context, keyID string) error
+	GetActiveAPIKeyByHash(ctx context.Context, keyHash string) (*APIKey, error)
+}
+
+var (
+	_ WebhookStore = (*Repository)(nil)
+	_ WebhookStore = (*MemoryStore)(nil)
+)
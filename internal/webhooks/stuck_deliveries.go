@@ -0,0 +1,85 @@
+package webhooks
+
+import (
+	"context"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// DeliveryJob is a delivery with the args, queue and priority of its latest River job
+type DeliveryJob struct {
+	*WebhookDelivery
+	JobArgs     []byte // Args of the delivery's latest River job; nil if the job no longer exists
+	JobQueue    string
+	JobPriority int
+}
+
+// latestDeliveryJob joins each delivery d to its latest River job j
+const latestDeliveryJob = `
+		LEFT JOIN LATERAL (
+			SELECT args, queue, priority, state
+			FROM river_job
+			WHERE kind = 'webhook_delivery' AND args->>'delivery_id' = d.id
+			ORDER BY id DESC
+			LIMIT 1
+		) j ON true`
+
+// FindStuckDeliveries returns up to limit deliveries that have been "sending" since before
+// olderThan and whose latest River job is finalized or gone, so no job will ever update
+// them; typically their worker crashed on the final attempt. Batched deliveries are left
+// to their webhook's batch job (see ClaimBatchDeliveries).
+func (r *Repository) FindStuckDeliveries(ctx context.Context, olderThan time.Time, limit int) ([]*DeliveryJob, error) {
+	query := `
+		SELECT ` + deliveryColumns + `, j.args, COALESCE(j.queue, ''), COALESCE(j.priority, 0)
+		FROM webhook_deliveries d` + latestDeliveryJob + `
+		WHERE d.status = 'sending' AND NOT d.batched
+		  AND d.last_attempted_at < $1
+		  AND (j.state IS NULL OR j.state IN ('completed', 'discarded', 'cancelled'))
+		ORDER BY d.last_attempted_at
+		LIMIT $2
+	`
+
+	rows, err := r.db.Query(ctx, query, olderThan, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var stuck []*DeliveryJob
+	for rows.Next() {
+		s := &DeliveryJob{}
+		s.WebhookDelivery, err = scanDelivery(rows, &s.JobArgs, &s.JobQueue, &s.JobPriority)
+		if err != nil {
+			return nil, err
+		}
+		stuck = append(stuck, s)
+	}
+
+	return stuck, rows.Err()
+}
+
+// ResolveStuckDelivery moves a delivery out of "sending" and reports whether it was still
+// stuck, so concurrent sweeps don't handle it twice
+func (r *Repository) ResolveStuckDelivery(ctx context.Context, deliveryID string, status WebhookDeliveryStatus, errorMessage string) (bool, error) {
+	return resolveStuckDelivery(ctx, r.db, deliveryID, status, errorMessage)
+}
+
+// ResolveStuckDeliveryTx is ResolveStuckDelivery within tx
+func (r *Repository) ResolveStuckDeliveryTx(ctx context.Context, tx pgx.Tx, deliveryID string, status WebhookDeliveryStatus, errorMessage string) (bool, error) {
+	return resolveStuckDelivery(ctx, tx, deliveryID, status, errorMessage)
+}
+
+func resolveStuckDelivery(ctx context.Context, db dbExecutor, deliveryID string, status WebhookDeliveryStatus, errorMessage string) (bool, error) {
+	query := `
+		UPDATE webhook_deliveries
+		SET status = $2, error_message = $3
+		WHERE id = $1 AND status = 'sending'
+	`
+
+	tag, err := db.Exec(ctx, query, deliveryID, status, errorMessage)
+	if err != nil {
+		return false, err
+	}
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,75 @@
+package webhooks
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+)
+
+// ErrPortNotAllowed is returned for http(s) URLs whose port isn't in the allowed ports
+var ErrPortNotAllowed = errors.New("port not allowed")
+
+// MaxFallbackURLs limits how many backup URLs a webhook can register
+const MaxFallbackURLs = 5
+
+// Delivery target types, chosen by the webhook URL's scheme
+const (
+	TargetHTTP = "http"
+	TargetSQS  = "sqs"
+)
+
+// TargetType returns the kind of target raw delivers to: TargetSQS for sqs://<queue-name>,
+// TargetHTTP otherwise
+func TargetType(raw string) string {
+	if u, err := url.Parse(raw); err == nil && u.Scheme == "sqs" {
+		return TargetSQS
+	}
+	return TargetHTTP
+}
+
+// ValidateWebhookURL checks that raw is an absolute http or https URL, or an SQS queue
+// given as sqs://<queue-name>
+func ValidateWebhookURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %w", raw, err)
+	}
+	if u.Scheme == "sqs" {
+		if u.Host == "" || (u.Path != "" && u.Path != "/") || u.RawQuery != "" {
+			return fmt.Errorf("invalid URL %q: SQS targets must be sqs://<queue-name>", raw)
+		}
+		return nil
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("invalid URL %q: scheme must be http, https or sqs", raw)
+	}
+	if u.Host == "" {
+		return fmt.Errorf("invalid URL %q: host is required", raw)
+	}
+	return nil
+}
+
+// CheckWebhookPort checks that an http(s) URL's port, explicit or implied by its scheme, is
+// one of allowed. An empty allowed list allows any port, and other targets have no port.
+func CheckWebhookPort(raw string, allowed []int) error {
+	if len(allowed) == 0 || TargetType(raw) != TargetHTTP {
+		return nil
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return fmt.Errorf("invalid URL %q: %w", raw, err)
+	}
+	port := u.Port()
+	if port == "" {
+		port = "80"
+		if u.Scheme == "https" {
+			port = "443"
+		}
+	}
+	if n, err := strconv.Atoi(port); err != nil || !slices.Contains(allowed, n) {
+		return fmt.Errorf("%w: %q uses port %s, allowed ports are %v", ErrPortNotAllowed, raw, port, allowed)
+	}
+	return nil
+}
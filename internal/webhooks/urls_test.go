@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{"https://example.com/hook", false},
+		{"http://localhost:8080/hook", false},
+		{"ftp://example.com/hook", true},
+		{"example.com/hook", true},
+		{"https:///hook", true},
+		{"http://[::1", true},
+		{"sqs://orders", false},
+		{"sqs://orders.fifo", false},
+		{"sqs:///orders", true},
+		{"sqs://orders/extra", true},
+	}
+
+	for _, tt := range tests {
+		err := ValidateWebhookURL(tt.url)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ValidateWebhookURL(%q) error = %v, wantErr %v", tt.url, err, tt.wantErr)
+		}
+	}
+}
+
+func TestCheckWebhookPort(t *testing.T) {
+	allowed := []int{80, 443}
+	tests := []struct {
+		url     string
+		allowed []int
+		wantErr bool
+	}{
+		{"https://example.com/hook", allowed, false},
+		{"http://example.com/hook", allowed, false},
+		{"https://example.com:443/hook", allowed, false},
+		{"http://example.com:443/hook", allowed, false},
+		{"https://example.com:8443/hook", allowed, true},
+		{"http://localhost:8080/hook", allowed, true},
+		{"http://localhost:8080/hook", nil, false},
+		{"sqs://orders", allowed, false},
+	}
+
+	for _, tt := range tests {
+		err := CheckWebhookPort(tt.url, tt.allowed)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("CheckWebhookPort(%q, %v) error = %v, wantErr %v", tt.url, tt.allowed, err, tt.wantErr)
+		}
+		if err != nil && !errors.Is(err, ErrPortNotAllowed) {
+			t.Errorf("CheckWebhookPort(%q) error = %v, want ErrPortNotAllowed", tt.url, err)
+		}
+	}
+}
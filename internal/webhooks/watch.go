@@ -0,0 +1,77 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// deliveryState holds the fields a watcher reports changes for
+type deliveryState struct {
+	status       WebhookDeliveryStatus
+	attemptCount int
+}
+
+// WatchDeliveries polls the deliveries of a webhook (or of an event, when eventID is set) and
+// calls send with the current state of each delivery followed by every subsequent status change.
+// It returns nil once ctx is cancelled, or the first error from the database or send.
+func (r *Repository) WatchDeliveries(ctx context.Context, webhookID, eventID string, interval time.Duration, send func(*WebhookDelivery) error) error {
+	column, id := "webhook_id", webhookID
+	if eventID != "" {
+		column, id = "event_id", eventID
+	}
+	if id == "" {
+		return fmt.Errorf("either webhook ID or event ID is required")
+	}
+
+	// A trigger stamps updated_at on every change of status or attempt count
+	query := `
+		SELECT ` + deliveryColumns + `
+		FROM webhook_deliveries
+		WHERE ` + column + ` = $1 AND updated_at >= $2
+		ORDER BY updated_at
+	`
+
+	return watchDeliveries(ctx, interval, send, func(since time.Time) ([]*WebhookDelivery, error) {
+		return r.getDeliveries(ctx, query, id, since)
+	})
+}
+
+// watchDeliveries runs a watch, calling poll for the deliveries changed since a time
+func watchDeliveries(ctx context.Context, interval time.Duration, send func(*WebhookDelivery) error, poll func(since time.Time) ([]*WebhookDelivery, error)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	seen := make(map[string]deliveryState)
+	var since time.Time
+	for {
+		polledAt := time.Now()
+		deliveries, err := poll(since)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+
+		for _, d := range deliveries {
+			state := deliveryState{status: d.Status, attemptCount: d.AttemptCount}
+			if prev, ok := seen[d.ID]; ok && prev == state {
+				continue
+			}
+			seen[d.ID] = state
+			if err := send(d); err != nil {
+				return err
+			}
+		}
+
+		// Overlap polls by one interval so updates committed late aren't missed; seen dedupes them
+		since = polledAt.Add(-interval)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
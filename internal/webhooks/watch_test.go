@@ -0,0 +1,137 @@
+package webhooks
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+const testWatchInterval = 10 * time.Millisecond
+
+// watchWebhook runs store.WatchDeliveries for a webhook until the test ends
+func watchWebhook(t *testing.T, store *MemoryStore, webhookID string) <-chan *WebhookDelivery {
+	t.Helper()
+	ctx, cancel := context.WithCancel(context.Background())
+	sent := make(chan *WebhookDelivery, 16)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		store.WatchDeliveries(ctx, webhookID, "", testWatchInterval, func(d *WebhookDelivery) error {
+			sent <- d
+			return nil
+		})
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+	return sent
+}
+
+// awaitStatus waits for the watch to send a delivery with status
+func awaitStatus(t *testing.T, sent <-chan *WebhookDelivery, deliveryID string, status WebhookDeliveryStatus) {
+	t.Helper()
+	timeout := time.After(time.Second)
+	for {
+		select {
+		case d := <-sent:
+			if d.ID == deliveryID && d.Status == status {
+				return
+			}
+		case <-timeout:
+			t.Fatalf("watch didn't send delivery %s as %s", deliveryID, status)
+		}
+	}
+}
+
+func TestWatchSeesStuckDeliveryResolution(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	webhook := &WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreEvent(ctx, &EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 60}); err != nil {
+		t.Fatal(err)
+	}
+	delivery := &WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, ExpiresAt: time.Now().Add(time.Minute)}
+	if _, err := store.CreateDelivery(ctx, delivery); err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := store.StartDeliveryWithinLimit(ctx, webhook.ID, delivery.ID, 1); !ok || err != nil {
+		t.Fatalf("StartDeliveryWithinLimit = %v, %v", ok, err)
+	}
+
+	sent := watchWebhook(t, store, webhook.ID)
+	awaitStatus(t, sent, delivery.ID, StatusSending)
+
+	// Resolved well after its last attempt, as the reconciler does
+	time.Sleep(5 * testWatchInterval)
+	if ok, err := store.ResolveStuckDelivery(ctx, delivery.ID, StatusFailed, "worker lost"); !ok || err != nil {
+		t.Fatalf("ResolveStuckDelivery = %v, %v", ok, err)
+	}
+	awaitStatus(t, sent, delivery.ID, StatusFailed)
+}
+
+func TestWatchSeesDeliveryTTLExtension(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	webhook := &WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreEvent(ctx, &EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 60}); err != nil {
+		t.Fatal(err)
+	}
+	delivery := &WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, ExpiresAt: time.Now()}
+	if _, err := store.CreateDelivery(ctx, delivery); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.RecordDeliveryAttempt(ctx, delivery.ID, &DeliveryAttempt{Status: StatusExpired}); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := watchWebhook(t, store, webhook.ID)
+	awaitStatus(t, sent, delivery.ID, StatusExpired)
+
+	time.Sleep(5 * testWatchInterval)
+	tx, err := store.BeginTx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := store.ExtendExpiredDeliveryTx(ctx, tx, delivery.ID, time.Now().Add(time.Hour)); !ok || err != nil {
+		t.Fatalf("ExtendExpiredDeliveryTx = %v, %v", ok, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+	awaitStatus(t, sent, delivery.ID, StatusRetrying)
+}
+
+func TestWatchSeesBatchExpiry(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore()
+	webhook := &WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreEvent(ctx, &EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 60}); err != nil {
+		t.Fatal(err)
+	}
+	delivery := &WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, Batched: true,
+		ExpiresAt: time.Now().Add(5 * testWatchInterval)}
+	if _, err := store.CreateDelivery(ctx, delivery); err != nil {
+		t.Fatal(err)
+	}
+
+	sent := watchWebhook(t, store, webhook.ID)
+	awaitStatus(t, sent, delivery.ID, StatusPending)
+
+	// Expiring a delivery that was never attempted is a status change without an attempt
+	time.Sleep(10 * testWatchInterval)
+	expired, err := store.ExpireBatchDeliveries(ctx, webhook.ID)
+	if err != nil || len(expired) != 1 {
+		t.Fatalf("ExpireBatchDeliveries = %v, %v", expired, err)
+	}
+	awaitStatus(t, sent, delivery.ID, StatusExpired)
+}
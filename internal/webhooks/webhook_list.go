@@ -0,0 +1,59 @@
+package webhooks
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+)
+
+// WebhookListFilter selects the webhooks returned by ListAllWebhooks
+type WebhookListFilter struct {
+	ActiveOnly  bool
+	URLContains string // Optional substring of the webhook URL
+	Limit       int
+
+	// AfterID continues a previous listing after the given webhook (see EncodeWebhookCursor)
+	AfterID string
+}
+
+// ListAllWebhooks returns webhooks across all namespaces matching filter, ordered by ID.
+// Pages continue from the last ID rather than an offset, so deep pages stay cheap.
+func (r *Repository) ListAllWebhooks(ctx context.Context, filter WebhookListFilter) ([]*WebhookRegistration, error) {
+	query := `
+		SELECT ` + webhookColumns + `
+		FROM webhook_registrations
+		WHERE true
+	`
+	var args []any
+
+	if filter.ActiveOnly {
+		query += ` AND active = true`
+	}
+	if filter.URLContains != "" {
+		args = append(args, filter.URLContains)
+		query += fmt.Sprintf(" AND strpos(url, $%d) > 0", len(args))
+	}
+	if filter.AfterID != "" {
+		args = append(args, filter.AfterID)
+		query += fmt.Sprintf(" AND id > $%d", len(args))
+	}
+
+	args = append(args, filter.Limit)
+	query += fmt.Sprintf(" ORDER BY id LIMIT $%d", len(args))
+
+	return r.getWebhooks(ctx, query, args...)
+}
+
+// EncodeWebhookCursor returns an opaque page token that continues a listing after the webhook
+func EncodeWebhookCursor(webhook *WebhookRegistration) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(webhook.ID))
+}
+
+// DecodeWebhookCursor parses a page token produced by EncodeWebhookCursor into a webhook ID
+func DecodeWebhookCursor(token string) (string, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(raw) == 0 {
+		return "", fmt.Errorf("invalid page token")
+	}
+	return string(raw), nil
+}
@@ -0,0 +1,19 @@
+package webhooks
+
+import "testing"
+
+func TestWebhookCursorRoundTrip(t *testing.T) {
+	webhook := &WebhookRegistration{ID: "7f9c2ba4-e88f-4d3a-9b1c-000000000001"}
+
+	id, err := DecodeWebhookCursor(EncodeWebhookCursor(webhook))
+	if err != nil {
+		t.Fatalf("DecodeWebhookCursor failed: %v", err)
+	}
+	if id != webhook.ID {
+		t.Errorf("got %q, want %q", id, webhook.ID)
+	}
+
+	if _, err := DecodeWebhookCursor("not a token"); err == nil {
+		t.Error("expected error for invalid token")
+	}
+}
@@ -0,0 +1,73 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+// CleanupWorker removes expired events and terminal deliveries past their retention
+type CleanupWorker struct {
+	river.WorkerDefaults[jobs.CleanupArgs]
+	webhookRepo webhooks.WebhookStore
+	retention   time.Duration
+	batchSize   int
+}
+
+// NewCleanupWorker creates a new cleanup worker
+func NewCleanupWorker(webhookRepo webhooks.WebhookStore, retention time.Duration, batchSize int) *CleanupWorker {
+	return &CleanupWorker{
+		webhookRepo: webhookRepo,
+		retention:   retention,
+		batchSize:   batchSize,
+	}
+}
+
+// Work deletes expired rows in batches until none remain
+func (w *CleanupWorker) Work(ctx context.Context, job *river.Job[jobs.CleanupArgs]) error {
+	log := logger.NewLogger("cleanup-worker")
+	now := time.Now()
+
+	// Deliveries go first: an expired event is kept while any of its deliveries are retained,
+	// as deleting the event would cascade to them
+	olderThan := now.Add(-w.retention)
+	var deliveriesDeleted int64
+	for {
+		n, err := w.webhookRepo.DeleteTerminalDeliveries(ctx, olderThan, w.batchSize)
+		if err != nil {
+			log.Error("Failed to delete old deliveries", "error", err, "deleted_so_far", deliveriesDeleted)
+			return err
+		}
+		deliveriesDeleted += n
+		if n < int64(w.batchSize) {
+			break
+		}
+	}
+
+	var eventsDeleted int64
+	for {
+		n, err := w.webhookRepo.DeleteExpiredEvents(ctx, now, olderThan, w.batchSize)
+		if err != nil {
+			log.Error("Failed to delete expired events", "error", err, "deleted_so_far", eventsDeleted)
+			return err
+		}
+		eventsDeleted += n
+		if n < int64(w.batchSize) {
+			break
+		}
+	}
+
+	log.Info("Cleanup completed",
+		"job_id", job.ID,
+		"events_deleted", eventsDeleted,
+		"deliveries_deleted", deliveriesDeleted,
+		"retention", w.retention.String(),
+	)
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package workers
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+func TestCleanupKeepsExpiredEventsWithRetainedDeliveries(t *testing.T) {
+	ctx := context.Background()
+	store := webhooks.NewMemoryStore()
+	webhook := &webhooks.WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com/hook", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	// A TTL of 0 expires the event as soon as it's stored
+	if err := store.StoreEvent(ctx, &webhooks.EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 0}); err != nil {
+		t.Fatal(err)
+	}
+	delivery := &webhooks.WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, ExpiresAt: time.Now().Add(time.Minute)}
+	if _, err := store.CreateDelivery(ctx, delivery); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.UpdateDeliveryStatus(ctx, delivery.ID, webhooks.StatusSuccess, 200, "", ""); err != nil {
+		t.Fatal(err)
+	}
+	job := &river.Job[jobs.CleanupArgs]{JobRow: &rivertype.JobRow{ID: 1, Attempt: 1, MaxAttempts: 3}}
+
+	// Within retention, the delivery and its expired event are both kept
+	if err := NewCleanupWorker(store, time.Hour, 100).Work(ctx, job); err != nil {
+		t.Fatalf("Work: %v", err)
+	}
+	if _, err := store.GetDeliveryByID(ctx, delivery.ID); err != nil {
+		t.Errorf("delivery within retention was deleted: %v", err)
+	}
+	event, err := store.GetEvent(ctx, "evt-1")
+	if err != nil {
+		t.Fatalf("expired event with a retained delivery was deleted: %v", err)
+	}
+	if event.Deliveries.Succeeded != 1 {
+		t.Errorf("event deliveries = %+v, want 1 succeeded", event.Deliveries)
+	}
+
+	// Past retention, both go
+	time.Sleep(time.Millisecond)
+	if err := NewCleanupWorker(store, time.Nanosecond, 100).Work(ctx, job); err != nil {
+		t.Fatalf("Work: %v", err)
+	}
+	if _, err := store.GetDeliveryByID(ctx, delivery.ID); !errors.Is(err, webhooks.ErrNotFound) {
+		t.Errorf("GetDeliveryByID = %v, want the delivery deleted past retention", err)
+	}
+	if _, err := store.GetEvent(ctx, "evt-1"); !errors.Is(err, webhooks.ErrNotFound) {
+		t.Errorf("GetEvent = %v, want the event deleted once its deliveries are", err)
+	}
+}
+
+func TestCleanupKeepsExtendedDeliveries(t *testing.T) {
+	ctx := context.Background()
+	store := webhooks.NewMemoryStore()
+	webhook := &webhooks.WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com/hook", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreEvent(ctx, &webhooks.EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 0}); err != nil {
+		t.Fatal(err)
+	}
+	delivery := &webhooks.WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, ExpiresAt: time.Now()}
+	if _, err := store.CreateDelivery(ctx, delivery); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.UpdateDeliveryStatus(ctx, delivery.ID, webhooks.StatusExpired, 0, "", "Delivery expired"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Extend the delivery as ExtendDeliveryTTL does
+	expiresAt := time.Now().Add(time.Hour)
+	tx, err := store.BeginTx(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := store.ExtendExpiredDeliveryTx(ctx, tx, delivery.ID, expiresAt); !ok || err != nil {
+		t.Fatalf("ExtendExpiredDeliveryTx = %v, %v", ok, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	time.Sleep(time.Millisecond)
+	job := &river.Job[jobs.CleanupArgs]{JobRow: &rivertype.JobRow{ID: 1, Attempt: 1, MaxAttempts: 3}}
+	if err := NewCleanupWorker(store, time.Nanosecond, 100).Work(ctx, job); err != nil {
+		t.Fatalf("Work: %v", err)
+	}
+	if d, err := store.GetDeliveryByID(ctx, delivery.ID); err != nil || d.Status != webhooks.StatusRetrying {
+		t.Errorf("GetDeliveryByID = %v, %v, want the rescheduled delivery kept", d, err)
+	}
+	event, err := store.GetEvent(ctx, "evt-1")
+	if err != nil {
+		t.Fatalf("GetEvent: %v", err)
+	}
+	if event.ExpiresAt.Before(expiresAt) {
+		t.Errorf("event expires at %v, want it extended to %v", event.ExpiresAt, expiresAt)
+	}
+}
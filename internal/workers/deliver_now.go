@@ -0,0 +1,108 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+// SyncDelivery is the outcome of a delivery's first attempt made inline by DeliverNow
+type SyncDelivery struct {
+	WebhookID  string
+	DeliveryID string
+	Status     webhooks.WebhookDeliveryStatus
+	StatusCode int    // Response status; 0 when none was received
+	Error      string // Why the attempt failed; empty on success
+}
+
+// DeliverNow makes the first attempt of a stored, pending delivery inline instead of through
+// the queue. When the attempt fails and attempts remain, it schedules a delivery job for the
+// rest of them.
+func (w *WebhookWorker) DeliverNow(ctx context.Context, args jobs.WebhookArgs, opts *river.InsertOpts) SyncDelivery {
+	ctx, span := w.tracer.Start(ctx, "webhook.delivery",
+		trace.WithAttributes(
+			attribute.String("delivery_id", args.DeliveryID),
+			attribute.String("webhook_id", args.WebhookID),
+			attribute.String("event_id", args.EventID),
+			attribute.String("url", args.URL),
+			attribute.String("namespace", args.Namespace),
+			attribute.String("event", args.Event),
+			attribute.Bool("synchronous", true),
+		),
+	)
+	defer span.End()
+
+	log := logger.NewLogger("webhook-worker").With("synchronous", true)
+
+	log.Info("Processing webhook delivery",
+		"delivery_id", args.DeliveryID,
+		"webhook_id", args.WebhookID,
+		"event_id", args.EventID,
+		"url", args.URL,
+		"method", "POST",
+		"namespace", args.Namespace,
+		"event", args.Event,
+	)
+
+	if err := w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
+		webhooks.StatusSending, 0, "", ""); err != nil {
+		log.Error("Failed to update delivery status to sending", "error", err)
+	}
+
+	final := opts.MaxAttempts <= 1
+	result := w.deliver(ctx, log, args, final)
+
+	sync := SyncDelivery{
+		WebhookID:  args.WebhookID,
+		DeliveryID: args.DeliveryID,
+		Status:     result.Status,
+		StatusCode: result.StatusCode,
+	}
+	if result.Err == nil {
+		return sync
+	}
+	sync.Error = result.Err.Error()
+	if final || result.Permanent {
+		return sync
+	}
+
+	// The remaining attempts go through the queue, after the receiver's Retry-After, the
+	// webhook's first scheduled delay or River's first retry delay
+	delay := result.RetryAfter
+	if delay == 0 {
+		scheduled, ok := webhooks.ScheduledRetryDelay(args.RetrySchedule, 1)
+		if !ok {
+			scheduled = batchRetryDelay(1)
+		}
+		delay = scheduled
+	}
+	retryOpts := *opts
+	retryOpts.MaxAttempts = opts.MaxAttempts - 1
+	retryOpts.ScheduledAt = time.Now().Add(delay)
+	if _, err := w.riverClient.Insert(ctx, args, &retryOpts); err != nil {
+		log.Error("Failed to schedule retry of synchronous delivery",
+			"error", err,
+			"delivery_id", args.DeliveryID,
+		)
+		sync.Status = webhooks.StatusFailed
+		if err := w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
+			webhooks.StatusFailed, result.StatusCode, "", "Failed to schedule retry: "+err.Error()); err != nil {
+			log.Error("Failed to update delivery status to failed", "error", err)
+		}
+		return sync
+	}
+
+	log.Info("Scheduled retry of synchronous delivery",
+		"delivery_id", args.DeliveryID,
+		"webhook_id", args.WebhookID,
+		"retry_in", delay,
+	)
+	return sync
+}
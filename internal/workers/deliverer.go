@@ -0,0 +1,213 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/sarathsp06/sparrow/internal/config"
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+// Result is a target's response to one delivery attempt. Its status decides whether the
+// attempt succeeded, and its body is stored with the delivery.
+type Result struct {
+	StatusCode int
+	Status     string
+	Header     http.Header
+
+	// Body streams the response body; the caller must close it
+	Body io.ReadCloser
+
+	// URL is the URL that produced the response, after any redirects; empty if the deliverer
+	// doesn't report it
+	URL string
+
+	// RequestHeader holds the headers actually sent, including any the deliverer added;
+	// nil if the deliverer doesn't report them
+	RequestHeader http.Header
+}
+
+// Deliverer sends one delivery attempt to a target. ctx bounds the attempt, including
+// reading the result's body; headers are the delivery's headers, already including
+// Content-Type, Authorization and trace context.
+type Deliverer interface {
+	Deliver(ctx context.Context, target, payload string, headers http.Header) (Result, error)
+}
+
+// deliveryKey is the context key for the delivery being attempted
+type deliveryKey struct{}
+
+// withDelivery records the delivery being attempted in ctx, for deliverers that identify
+// messages by delivery or webhook
+func withDelivery(ctx context.Context, args jobs.WebhookArgs) context.Context {
+	return context.WithValue(ctx, deliveryKey{}, args)
+}
+
+// deliveryFromContext returns the delivery recorded by withDelivery
+func deliveryFromContext(ctx context.Context) (jobs.WebhookArgs, bool) {
+	args, ok := ctx.Value(deliveryKey{}).(jobs.WebhookArgs)
+	return args, ok
+}
+
+// deliveryProxy returns the delivery transport's proxy function: proxyURL when set, or
+// HTTP_PROXY, HTTPS_PROXY and NO_PROXY from the environment otherwise. Deliveries to webhooks
+// with bypass_proxy always connect directly.
+func deliveryProxy(proxyURL string) func(*http.Request) (*url.URL, error) {
+	proxy := http.ProxyFromEnvironment
+	if proxyURL != "" {
+		// An invalid URL fails every proxied delivery rather than silently connecting directly
+		u, err := url.Parse(proxyURL)
+		proxy = func(*http.Request) (*url.URL, error) { return u, err }
+	}
+	return func(req *http.Request) (*url.URL, error) {
+		if args, ok := deliveryFromContext(req.Context()); ok && args.BypassProxy {
+			return nil, nil
+		}
+		return proxy(req)
+	}
+}
+
+// NewDeliverer builds the deliverer used by webhook workers: HTTP targets always, and SQS
+// targets when an AWS region is configured. With SimulateDeliveries set, deliveries to every
+// target are simulated instead.
+func NewDeliverer(cfg *config.Config) Deliverer {
+	if cfg.SimulateDeliveries {
+		logger.NewLogger("webhook-worker").Warn("Delivery simulation enabled: no webhooks will be delivered",
+			"environment", cfg.Environment,
+			"min_latency", cfg.SimulatedLatencyMin,
+			"max_latency", cfg.SimulatedLatencyMax,
+			"failure_rate", cfg.SimulatedFailureRate)
+		return &simulatedDeliverer{
+			minLatency:  cfg.SimulatedLatencyMin,
+			maxLatency:  cfg.SimulatedLatencyMax,
+			failureRate: cfg.SimulatedFailureRate,
+		}
+	}
+
+	// The client is shared by all deliveries so connections to a host are pooled and bounded
+	client := newDeliveryClient(cfg)
+
+	deliverers := targetDeliverers{
+		webhooks.TargetHTTP: NewHTTPDeliverer(client, cfg.UserAgent),
+	}
+	if cfg.AWSRegion != "" {
+		deliverers[webhooks.TargetSQS] = newSQSDeliverer(client, cfg.AWSRegion, cfg.SQSEndpoint, awsCredentials{
+			AccessKeyID:     cfg.AWSAccessKeyID,
+			SecretAccessKey: cfg.AWSSecretAccessKey,
+			SessionToken:    cfg.AWSSessionToken,
+		})
+	}
+	return deliverers
+}
+
+// newDeliveryClient builds the HTTP client used for deliveries. It has no overall timeout;
+// each delivery bounds its request with the webhook's own timeout instead, and the transport
+// bounds connecting and waiting for response headers within it.
+func newDeliveryClient(cfg *config.Config) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = deliveryProxy(cfg.WebhookProxyURL)
+	transport.DialContext = (&net.Dialer{
+		Timeout:   cfg.HTTPDialTimeout,
+		KeepAlive: 30 * time.Second,
+	}).DialContext
+	transport.TLSHandshakeTimeout = cfg.HTTPTLSHandshakeTimeout
+	transport.ResponseHeaderTimeout = cfg.HTTPResponseHeaderTimeout
+	transport.MaxIdleConnsPerHost = cfg.HTTPMaxIdleConnsPerHost
+	transport.MaxConnsPerHost = cfg.HTTPMaxConnsPerHost
+	if transport.MaxIdleConns < cfg.HTTPMaxIdleConnsPerHost {
+		transport.MaxIdleConns = cfg.HTTPMaxIdleConnsPerHost
+	}
+
+	return &http.Client{Transport: transport, CheckRedirect: checkRedirect(cfg.HTTPRedirectPolicy, cfg.AllowedWebhookPorts)}
+}
+
+// maxRedirects is how many redirects a delivery follows when its policy allows them
+const maxRedirects = 10
+
+// checkRedirect returns the delivery client's redirect check for a config.Redirect* policy.
+// A redirect that isn't followed is returned as the response; one the policy follows to a
+// port outside allowedPorts fails the request.
+func checkRedirect(policy string, allowedPorts []int) func(req *http.Request, via []*http.Request) error {
+	return func(req *http.Request, via []*http.Request) error {
+		switch policy {
+		case config.RedirectAll:
+		case config.RedirectSameHost:
+			original := via[0].URL
+			if req.URL.Host != original.Host || (original.Scheme == "https" && req.URL.Scheme != "https") {
+				return http.ErrUseLastResponse
+			}
+		default:
+			return http.ErrUseLastResponse
+		}
+		if len(via) >= maxRedirects {
+			return fmt.Errorf("stopped after %d redirects", maxRedirects)
+		}
+		return webhooks.CheckWebhookPort(req.URL.String(), allowedPorts)
+	}
+}
+
+// targetDeliverers routes each delivery to the deliverer for its target type
+// (webhooks.TargetHTTP, TargetSQS)
+type targetDeliverers map[string]Deliverer
+
+// Deliver sends the attempt with the deliverer for target's type
+func (d targetDeliverers) Deliver(ctx context.Context, target, payload string, headers http.Header) (Result, error) {
+	targetType := webhooks.TargetType(target)
+	deliverer, ok := d[targetType]
+	if !ok {
+		return Result{}, fmt.Errorf("no deliverer configured for %s targets", targetType)
+	}
+	return deliverer.Deliver(ctx, target, payload, headers)
+}
+
+// HTTPDeliverer POSTs deliveries to http and https URLs
+type HTTPDeliverer struct {
+	client    *http.Client
+	userAgent string
+}
+
+// NewHTTPDeliverer creates an HTTP deliverer. userAgent is sent unless the delivery's
+// headers set their own User-Agent.
+func NewHTTPDeliverer(client *http.Client, userAgent string) *HTTPDeliverer {
+	return &HTTPDeliverer{client: client, userAgent: userAgent}
+}
+
+// Deliver POSTs the payload to target with the given headers
+func (d *HTTPDeliverer) Deliver(ctx context.Context, target, payload string, headers http.Header) (Result, error) {
+	// Create HTTP request (always POST for webhooks). The body streams from the payload
+	// string without copying it, and GetBody re-reads it for redirects that resend the body.
+	req, err := http.NewRequestWithContext(ctx, "POST", target, strings.NewReader(payload))
+	if err != nil {
+		return Result{}, fmt.Errorf("failed to create request: %w", err)
+	}
+
+	// Identify Sparrow to receivers; a User-Agent in headers takes precedence
+	if d.userAgent != "" {
+		req.Header.Set("User-Agent", d.userAgent)
+	}
+	for key, values := range headers {
+		req.Header[key] = values
+	}
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		Body:       resp.Body,
+		URL:        resp.Request.URL.String(),
+
+		RequestHeader: req.Header,
+	}, nil
+}
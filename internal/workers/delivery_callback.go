@@ -0,0 +1,84 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+)
+
+// deliveryCallbackTimeout bounds each callback request
+const deliveryCallbackTimeout = 10 * time.Second
+
+// DeliveryCallbackWorker notifies the configured monitoring endpoint of terminal deliveries
+// and of events whose processing failed
+type DeliveryCallbackWorker struct {
+	river.WorkerDefaults[jobs.DeliveryCallbackArgs]
+	client *http.Client
+}
+
+// NewDeliveryCallbackWorker creates a new delivery callback worker
+func NewDeliveryCallbackWorker() *DeliveryCallbackWorker {
+	return &DeliveryCallbackWorker{
+		client: &http.Client{Timeout: deliveryCallbackTimeout},
+	}
+}
+
+// deliveryCallbackPayload is the JSON body posted to the callback URL
+type deliveryCallbackPayload struct {
+	DeliveryID string `json:"delivery_id"`
+	WebhookID  string `json:"webhook_id"`
+	EventID    string `json:"event_id"`
+	Namespace  string `json:"namespace"`
+	Event      string `json:"event"`
+	Status     string `json:"status"`
+	StatusCode int    `json:"status_code"`
+	Error      string `json:"error,omitempty"`
+}
+
+// Work posts the delivery summary to the callback URL
+func (w *DeliveryCallbackWorker) Work(ctx context.Context, job *river.Job[jobs.DeliveryCallbackArgs]) error {
+	log := logger.NewLogger("delivery-callback-worker")
+	args := job.Args
+
+	body, err := json.Marshal(deliveryCallbackPayload{
+		DeliveryID: args.DeliveryID,
+		WebhookID:  args.WebhookID,
+		EventID:    args.EventID,
+		Namespace:  args.Namespace,
+		Event:      args.Event,
+		Status:     args.Status,
+		StatusCode: args.StatusCode,
+		Error:      args.Error,
+	})
+	if err != nil {
+		return river.JobCancel(fmt.Errorf("failed to marshal callback payload: %w", err))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", args.URL, bytes.NewReader(body))
+	if err != nil {
+		return river.JobCancel(fmt.Errorf("failed to create callback request: %w", err))
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		log.Warn("Delivery callback failed", "delivery_id", args.DeliveryID, "error", err)
+		return fmt.Errorf("failed to send delivery callback: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		log.Warn("Delivery callback rejected", "delivery_id", args.DeliveryID, "status_code", resp.StatusCode)
+		return fmt.Errorf("delivery callback returned HTTP %d", resp.StatusCode)
+	}
+
+	return nil
+}
@@ -2,6 +2,9 @@ package workers
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
@@ -9,58 +12,120 @@ import (
 	"github.com/riverqueue/river"
 	"github.com/sarathsp06/sparrow/internal/jobs"
 	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/observability"
 	"github.com/sarathsp06/sparrow/internal/webhooks"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // EventProcessingWorker processes events and triggers webhook deliveries
 type EventProcessingWorker struct {
 	river.WorkerDefaults[jobs.EventArgs]
-	webhookRepo *webhooks.Repository
-	riverClient *river.Client[pgx.Tx]
+	webhookRepo        webhooks.WebhookStore
+	riverClient        *river.Client[pgx.Tx]
+	maxDeliveryTimeout time.Duration
+	maxFanout          int
+	callbackURL        string
+	tracer             trace.Tracer
 }
 
-// NewEventProcessingWorker creates a new event processing worker with a river client
-func NewEventProcessingWorker(webhookRepo *webhooks.Repository, riverClient *river.Client[pgx.Tx]) *EventProcessingWorker {
+// eventProcessingFailedStatus is the callback status for events whose processing failed for good
+const eventProcessingFailedStatus = "event_processing_failed"
+
+// defaultMaxFanoutPerEvent is used when the worker is created without a fan-out limit
+const defaultMaxFanoutPerEvent = 500
+
+// NewEventProcessingWorker creates a new event processing worker with a river client.
+// maxDeliveryTimeout caps per-event timeout overrides. maxFanout caps the deliveries
+// scheduled by a single job; larger fan-outs continue in a follow-up job. callbackURL, when
+// set, is notified of events whose processing failed for good.
+func NewEventProcessingWorker(webhookRepo webhooks.WebhookStore, riverClient *river.Client[pgx.Tx], maxDeliveryTimeout time.Duration, maxFanout int, callbackURL string) *EventProcessingWorker {
+	if maxFanout <= 0 {
+		maxFanout = defaultMaxFanoutPerEvent
+	}
 	return &EventProcessingWorker{
-		webhookRepo: webhookRepo,
-		riverClient: riverClient,
+		webhookRepo:        webhookRepo,
+		riverClient:        riverClient,
+		maxDeliveryTimeout: maxDeliveryTimeout,
+		maxFanout:          maxFanout,
+		callbackURL:        callbackURL,
+		tracer:             observability.GetTracer("sparrow.workers.event"),
 	}
 }
 
 // Work processes an event and creates webhook delivery jobs
-func (w *EventProcessingWorker) Work(ctx context.Context, job *river.Job[jobs.EventArgs]) error {
+func (w *EventProcessingWorker) Work(ctx context.Context, job *river.Job[jobs.EventArgs]) (err error) {
 	log := logger.NewLogger("event-worker")
 	args := job.Args
 
+	// Continue the trace of the push; deliveries scheduled below continue this span's trace
+	ctx = observability.ExtractTraceContext(ctx, args.TraceContext)
+	ctx, span := w.tracer.Start(ctx, "event.process",
+		trace.WithAttributes(
+			attribute.String("event_id", args.EventID),
+			attribute.String("namespace", args.Namespace),
+			attribute.String("event", args.Event),
+			attribute.String("fanout_cursor", args.FanoutCursor),
+		),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(otelcodes.Error, "event processing failed")
+			if job.Attempt >= job.MaxAttempts {
+				w.recordFailure(context.WithoutCancel(ctx), job, err)
+			}
+		}
+		span.End()
+	}()
+
+	// A panic fails the job like any other error, so it's retried and recorded above
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = recoveredPanic(span, log, recovered)
+		}
+	}()
+
 	log.Info("Processing event",
 		"event_id", args.EventID,
 		"namespace", args.Namespace,
 		"event", args.Event,
+		"fanout_cursor", args.FanoutCursor,
 	)
 
-	// Store the event record
+	// Store the event record; continuation jobs skip this, the first job already stored it
 	eventRecord := &webhooks.EventRecord{
-		ID:        args.EventID,
-		Namespace: args.Namespace,
-		Event:     args.Event,
-		Payload:   args.Payload,
-		TTL:       args.TTLSeconds,
-		Metadata:  args.Metadata,
-		CreatedAt: args.CreatedAt,
+		ID:          args.EventID,
+		Namespace:   args.Namespace,
+		Event:       args.Event,
+		Payload:     string(args.Payload),
+		ContentType: args.ContentType,
+		TTL:         args.TTLSeconds,
+		Metadata:    args.Metadata,
+		CreatedAt:   args.CreatedAt,
 	}
 
-	if err := w.webhookRepo.StoreEvent(ctx, eventRecord); err != nil {
-		log.Error("Failed to store event record", "error", err, "event_id", args.EventID)
-		return err
+	if args.FanoutCursor == "" {
+		if err := w.webhookRepo.StoreEvent(ctx, eventRecord); err != nil {
+			log.Error("Failed to store event record", "error", err, "event_id", args.EventID)
+			return err
+		}
 	}
 
-	// Find all registered webhooks for this namespace/event
-	registeredWebhooks, err := w.webhookRepo.GetWebhooksByEvent(ctx, args.Namespace, args.Event)
+	// Find the next batch of registered webhooks for this namespace/event. One extra row
+	// tells us whether the fan-out has to continue in another job.
+	registeredWebhooks, err := w.webhookRepo.GetWebhooksByEventPage(ctx, args.Namespace, args.Event, args.FanoutCursor, w.maxFanout+1)
 	if err != nil {
 		log.Error("Failed to get registered webhooks", "error", err)
 		return err
 	}
 
+	hasMore := len(registeredWebhooks) > w.maxFanout
+	if hasMore {
+		registeredWebhooks = registeredWebhooks[:w.maxFanout]
+	}
+
 	if len(registeredWebhooks) == 0 {
 		log.Info("No webhooks registered for event",
 			"namespace", args.Namespace,
@@ -75,52 +140,109 @@ func (w *EventProcessingWorker) Work(ctx context.Context, job *river.Job[jobs.Ev
 		"event", args.Event,
 	)
 
-	// Create webhook delivery jobs for each registered webhook
-	expiresAt := time.Now().Add(time.Duration(args.TTLSeconds) * time.Second)
+	// Deliveries can't be attempted past the event's TTL, so a fan-out still running by then
+	// stops scheduling them
+	fanoutCtx, cancel := context.WithDeadline(ctx, eventExpiresAt(args))
+	defer cancel()
+	scheduled, failed, expired := w.scheduleDeliveries(fanoutCtx, log, args, registeredWebhooks)
+	if expired > 0 {
+		hasMore = false
+		span.SetAttributes(attribute.Int("deliveries_expired", expired))
+		log.Warn("Event expired during fan-out; skipped its remaining deliveries",
+			"event_id", args.EventID,
+			"expires_at", eventExpiresAt(args),
+			"deliveries_expired", expired,
+		)
+	}
+
+	log.Info("Event processing completed",
+		"event_id", args.EventID,
+		"webhooks_scheduled", scheduled,
+		"webhooks_failed", failed,
+		"webhooks_expired", expired,
+		"fanout_continues", hasMore,
+	)
 
-	for _, webhook := range registeredWebhooks {
-		deliveryID := uuid.New().String()
+	// Retry the event so failed deliveries get scheduled; existing ones are skipped
+	if failed > 0 {
+		return fmt.Errorf("failed to schedule %d of %d webhook deliveries", failed, len(registeredWebhooks))
+	}
 
-		// Create webhook delivery record
-		delivery := &webhooks.WebhookDelivery{
-			ID:          deliveryID,
-			WebhookID:   webhook.ID,
-			EventID:     args.EventID,
-			Status:      webhooks.StatusPending,
-			MaxAttempts: 3, // Default max attempts
-			ExpiresAt:   expiresAt,
+	if hasMore {
+		if err := w.continueFanout(ctx, job, registeredWebhooks[len(registeredWebhooks)-1].ID); err != nil {
+			log.Error("Failed to continue event fan-out", "error", err, "event_id", args.EventID)
+			return err
 		}
+	}
+	return nil
+}
 
-		if err := w.webhookRepo.CreateDelivery(ctx, delivery); err != nil {
-			log.Error("Failed to create delivery record", "error", err, "webhook_id", webhook.ID)
-			continue
+// scheduleDeliveries creates a delivery and its job for each webhook, skipping webhooks
+// whose metadata filter doesn't match the event and webhooks that already have a delivery
+// for the event. Once ctx's deadline passes, the remaining deliveries are skipped as
+// expired. It returns how many deliveries it scheduled, how many failed to schedule and
+// how many expired.
+func (w *EventProcessingWorker) scheduleDeliveries(ctx context.Context, log *slog.Logger, args jobs.EventArgs, registeredWebhooks []*webhooks.WebhookRegistration) (scheduled, failed, expired int) {
+	matching := webhooks.FilterByMetadata(registeredWebhooks, args.Metadata)
+	if skipped := len(registeredWebhooks) - len(matching); skipped > 0 {
+		log.Info("Skipped webhooks with non-matching metadata filters",
+			"event_id", args.EventID,
+			"skipped", skipped,
+		)
+	}
+	registeredWebhooks = matching
+	if len(registeredWebhooks) == 0 {
+		return 0, 0, 0
+	}
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return 0, 0, len(registeredWebhooks)
+	}
+
+	defaults, err := w.webhookRepo.GetNamespaceDefaults(ctx, args.Namespace)
+	if err != nil {
+		log.Error("Failed to load namespace defaults", "error", err, "namespace", args.Namespace)
+		return 0, len(registeredWebhooks), 0
+	}
+
+	for i, webhook := range registeredWebhooks {
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return scheduled, failed, len(registeredWebhooks) - i
 		}
 
-		// Create webhook delivery job
-		webhookArgs := jobs.WebhookArgs{
-			DeliveryID: deliveryID,
-			WebhookID:  webhook.ID,
-			EventID:    args.EventID,
-			URL:        webhook.URL,
-			Headers:    webhook.Headers,
-			Payload:    args.Payload,
-			Timeout:    webhook.Timeout,
-			ExpiresAt:  expiresAt,
-			Namespace:  args.Namespace,
-			Event:      args.Event,
+		delivery, webhookArgs, opts := w.NewDelivery(ctx, args, webhook, defaults.Headers)
+		deliveryID := delivery.ID
+
+		// Batching webhooks share one job per batch window instead of a job per delivery.
+		// Scheduled events are delivered on their own, at their time.
+		var jobArgs river.JobArgs = webhookArgs
+		if webhook.BatchWindowMs > 0 && opts.ScheduledAt.IsZero() {
+			delivery.Batched = true
+			jobArgs, opts = batchJob(webhook, time.Now())
 		}
 
-		_, err := w.riverClient.Insert(ctx, webhookArgs, &river.InsertOpts{
-			Queue: "webhooks",
-		})
+		created, err := w.scheduleDelivery(ctx, delivery, jobArgs, opts)
+		if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			// Interrupted by the deadline; the transaction was rolled back
+			return scheduled, failed, len(registeredWebhooks) - i
+		}
 		if err != nil {
 			log.Error("Failed to schedule webhook delivery job",
 				"error", err,
 				"webhook_id", webhook.ID,
 				"delivery_id", deliveryID,
 			)
+			failed++
 			continue
 		}
+		if !created {
+			// A previous run of this job already scheduled the delivery
+			log.Info("Webhook delivery already scheduled",
+				"webhook_id", webhook.ID,
+				"event_id", args.EventID,
+			)
+			continue
+		}
+		scheduled++
 
 		log.Info("Scheduled webhook delivery",
 			"webhook_id", webhook.ID,
@@ -128,11 +250,223 @@ func (w *EventProcessingWorker) Work(ctx context.Context, job *river.Job[jobs.Ev
 			"url", webhook.URL,
 		)
 	}
+	return scheduled, failed, expired
+}
 
-	log.Info("Event processing completed",
-		"event_id", args.EventID,
-		"webhooks_scheduled", len(registeredWebhooks),
-	)
+// NewDelivery builds the delivery record of an event for a webhook, along with the job args
+// and insert options of its delivery job. defaultHeaders are the namespace's default headers,
+// sent unless the webhook sets the same header.
+func (w *EventProcessingWorker) NewDelivery(ctx context.Context, args jobs.EventArgs, webhook *webhooks.WebhookRegistration, defaultHeaders map[string]string) (*webhooks.WebhookDelivery, jobs.WebhookArgs, *river.InsertOpts) {
+	expiresAt := eventExpiresAt(args)
+	deliveryID := uuid.New().String()
 
+	delivery := &webhooks.WebhookDelivery{
+		ID:          deliveryID,
+		WebhookID:   webhook.ID,
+		EventID:     args.EventID,
+		Status:      webhooks.StatusPending,
+		MaxAttempts: webhook.MaxAttempts,
+		ExpiresAt:   expiresAt,
+	}
+
+	webhookArgs := jobs.WebhookArgs{
+		DeliveryID:  deliveryID,
+		WebhookID:   webhook.ID,
+		EventID:     args.EventID,
+		URL:         webhook.URL,
+		Headers:     webhooks.MergeHeaders(defaultHeaders, webhook.Headers),
+		Metadata:    args.Metadata,
+		Payload:     args.Payload,
+		ContentType: webhooks.ResolveContentType(args.ContentType, webhook.ContentType),
+		Timeout:     deliveryTimeout(webhook.Timeout, args.TimeoutOverride, w.maxDeliveryTimeout),
+		ExpiresAt:   expiresAt,
+		Namespace:   args.Namespace,
+		Event:       args.Event,
+
+		TraceContext:            observability.InjectTraceContext(ctx),
+		MaxResponseBytes:        webhook.MaxStoredResponseBytes,
+		DisableTracePropagation: webhook.DisableTracePropagation,
+		Ordered:                 webhook.Ordered,
+		IncludeFields:           webhook.IncludeFields,
+		ExcludeFields:           webhook.ExcludeFields,
+		FallbackURLs:            webhook.FallbackURLs,
+		SuccessBodyMatcher:      webhook.SuccessBodyMatcher,
+		AuthType:                webhook.AuthType,
+		Signed:                  webhook.Signed,
+		MaxInFlight:             webhook.MaxInFlight,
+		CompressBody:            webhook.CompressBody,
+		BypassProxy:             webhook.BypassProxy,
+		RetrySchedule:           webhook.RetrySchedule,
+		QueryParams:             webhook.QueryParams,
+	}
+
+	// Ordered webhooks go through a single-worker queue to avoid needless contention
+	queueName := "webhooks"
+	if webhook.Ordered {
+		queueName = "webhooks_ordered"
+	}
+
+	opts := &river.InsertOpts{
+		Queue:       queueName,
+		MaxAttempts: webhook.MaxAttempts,
+		Priority:    webhooks.ResolvePriority(args.Priority, webhook.Priority),
+	}
+	if args.DeliverAt.After(time.Now()) {
+		opts.ScheduledAt = args.DeliverAt
+	}
+	return delivery, webhookArgs, opts
+}
+
+// eventExpiresAt returns when an event's deliveries expire. The TTL counts from when the
+// event was pushed, so scheduled events don't live longer.
+func eventExpiresAt(args jobs.EventArgs) time.Time {
+	pushedAt := args.CreatedAt
+	if pushedAt.IsZero() {
+		pushedAt = time.Now()
+	}
+	return pushedAt.Add(time.Duration(args.TTLSeconds) * time.Second)
+}
+
+// ReplayEvent schedules deliveries of a stored event to the webhooks currently registered
+// for it, paging through them like the fan-out does. Webhooks that already have a delivery
+// for the event are skipped, so replaying an event twice schedules nothing new. It returns
+// how many deliveries were scheduled.
+func (w *EventProcessingWorker) ReplayEvent(ctx context.Context, event *webhooks.EventRecord) (int, error) {
+	log := logger.NewLogger("event-worker")
+
+	args := jobs.EventArgs{
+		EventID:     event.ID,
+		Namespace:   event.Namespace,
+		Event:       event.Event,
+		Payload:     jobs.Payload(event.Payload),
+		ContentType: event.ContentType,
+		TTLSeconds:  event.TTL,
+		Metadata:    event.Metadata,
+		CreatedAt:   event.CreatedAt,
+	}
+
+	fanoutCtx, cancel := context.WithDeadline(ctx, eventExpiresAt(args))
+	defer cancel()
+
+	total := 0
+	for {
+		registeredWebhooks, err := w.webhookRepo.GetWebhooksByEventPage(ctx, args.Namespace, args.Event, args.FanoutCursor, w.maxFanout)
+		if err != nil {
+			return total, fmt.Errorf("failed to get registered webhooks: %w", err)
+		}
+		if len(registeredWebhooks) == 0 {
+			return total, nil
+		}
+
+		scheduled, failed, expired := w.scheduleDeliveries(fanoutCtx, log, args, registeredWebhooks)
+		total += scheduled
+		if failed > 0 {
+			return total, fmt.Errorf("failed to schedule %d of %d webhook deliveries", failed, len(registeredWebhooks))
+		}
+		if expired > 0 {
+			log.Warn("Event expired during replay; skipped its remaining deliveries",
+				"event_id", args.EventID,
+				"deliveries_expired", expired,
+			)
+			return total, nil
+		}
+		if len(registeredWebhooks) < w.maxFanout {
+			return total, nil
+		}
+		args.FanoutCursor = registeredWebhooks[len(registeredWebhooks)-1].ID
+	}
+}
+
+// recordFailure records an event processing job that won't be retried and notifies the
+// callback URL. Failures are logged and never change the job's outcome.
+func (w *EventProcessingWorker) recordFailure(ctx context.Context, job *river.Job[jobs.EventArgs], jobErr error) {
+	log := logger.NewLogger("event-worker")
+	args := job.Args
+
+	err := w.webhookRepo.RecordEventProcessingFailure(ctx, &webhooks.EventProcessingFailure{
+		EventID:   args.EventID,
+		Namespace: args.Namespace,
+		Event:     args.Event,
+		Error:     jobErr.Error(),
+		Attempts:  job.Attempt,
+	})
+	if err != nil {
+		log.Error("Failed to record event processing failure", "error", err, "event_id", args.EventID)
+	}
+
+	if w.callbackURL == "" || w.riverClient == nil {
+		return
+	}
+	_, err = w.riverClient.Insert(ctx, jobs.DeliveryCallbackArgs{
+		URL:       w.callbackURL,
+		EventID:   args.EventID,
+		Namespace: args.Namespace,
+		Event:     args.Event,
+		Status:    eventProcessingFailedStatus,
+		Error:     jobErr.Error(),
+	}, &river.InsertOpts{MaxAttempts: deliveryCallbackMaxAttempts})
+	if err != nil {
+		log.Warn("Failed to enqueue event failure callback", "event_id", args.EventID, "error", err)
+	}
+}
+
+// continueFanout enqueues a follow-up event processing job that schedules the deliveries
+// for webhooks after lastWebhookID. It is unique by args, so a retried batch can't enqueue
+// the same continuation twice.
+func (w *EventProcessingWorker) continueFanout(ctx context.Context, job *river.Job[jobs.EventArgs], lastWebhookID string) error {
+	next := job.Args
+	next.FanoutCursor = lastWebhookID
+
+	_, err := w.riverClient.Insert(ctx, next, &river.InsertOpts{
+		Queue:       job.Queue,
+		Priority:    job.Priority,
+		MaxAttempts: job.MaxAttempts,
+		UniqueOpts:  river.UniqueOpts{ByArgs: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to insert fan-out continuation job: %w", err)
+	}
 	return nil
 }
+
+// scheduleDelivery creates the delivery record and its job (a delivery or batch job) in a
+// single transaction, so a crash between the two can't leave a delivery that will never be
+// attempted. It reports false if the webhook already had a delivery for the event, in which
+// case no job is added.
+func (w *EventProcessingWorker) scheduleDelivery(ctx context.Context, delivery *webhooks.WebhookDelivery, args river.JobArgs, opts *river.InsertOpts) (bool, error) {
+	tx, err := w.webhookRepo.BeginTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	created, err := w.webhookRepo.CreateDeliveryTx(ctx, tx, delivery)
+	if err != nil {
+		return false, fmt.Errorf("failed to create delivery record: %w", err)
+	}
+	if !created {
+		return false, nil
+	}
+
+	if _, err := w.riverClient.InsertTx(ctx, tx, args, opts); err != nil {
+		return false, fmt.Errorf("failed to insert delivery job: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// deliveryTimeout returns the timeout in seconds for a delivery: the event's override when
+// set, otherwise the webhook's own timeout, capped at maxTimeout
+func deliveryTimeout(webhookTimeout, override int, maxTimeout time.Duration) int {
+	timeout := webhookTimeout
+	if override > 0 {
+		timeout = override
+	}
+	if maxSeconds := int(maxTimeout / time.Second); maxSeconds > 0 && timeout > maxSeconds {
+		return maxSeconds
+	}
+	return timeout
+}
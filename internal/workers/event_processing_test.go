@@ -0,0 +1,60 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+func TestDeliveryTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		webhook  int
+		override int
+		max      time.Duration
+		want     int
+	}{
+		{"no override uses webhook timeout", 30, 0, 5 * time.Minute, 30},
+		{"override replaces webhook timeout", 30, 120, 5 * time.Minute, 120},
+		{"override is capped", 30, 900, 5 * time.Minute, 300},
+		{"override may be shorter than webhook timeout", 30, 5, 5 * time.Minute, 5},
+		{"webhook timeout is capped", 600, 0, 2 * time.Minute, 120},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := deliveryTimeout(tt.webhook, tt.override, tt.max); got != tt.want {
+				t.Errorf("deliveryTimeout(%d, %d, %v) = %d, want %d", tt.webhook, tt.override, tt.max, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestScheduleDeliveriesAfterExpiry(t *testing.T) {
+	store := webhooks.NewMemoryStore()
+	worker := NewEventProcessingWorker(store, nil, time.Minute, 10, "")
+	args := jobs.EventArgs{
+		EventID:    "evt-1",
+		Namespace:  "orders",
+		Event:      "order.created",
+		TTLSeconds: 60,
+		CreatedAt:  time.Now().Add(-2 * time.Minute),
+	}
+
+	expiresAt := eventExpiresAt(args)
+	if want := args.CreatedAt.Add(time.Minute); !expiresAt.Equal(want) {
+		t.Fatalf("eventExpiresAt = %v, want %v", expiresAt, want)
+	}
+
+	ctx, cancel := context.WithDeadline(context.Background(), expiresAt)
+	defer cancel()
+	hooks := []*webhooks.WebhookRegistration{{ID: "wh-1"}, {ID: "wh-2"}}
+	scheduled, failed, expired := worker.scheduleDeliveries(ctx, logger.NewLogger("test"), args, hooks)
+	if scheduled != 0 || failed != 0 || expired != 2 {
+		t.Errorf("scheduleDeliveries = %d scheduled, %d failed, %d expired; want 0, 0, 2", scheduled, failed, expired)
+	}
+}
@@ -0,0 +1,24 @@
+package workers
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime/debug"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recoveredPanic converts a panic recovered in a worker's Work to an error, so River retries
+// the job instead of one bad payload taking down the worker. It must be called from the
+// deferred function that recovered, while the panicking stack is still there to log and add
+// to span.
+func recoveredPanic(span trace.Span, log *slog.Logger, recovered any) error {
+	stack := string(debug.Stack())
+	log.Error("Worker panicked", "panic", fmt.Sprint(recovered), "stack", stack)
+	span.AddEvent("panic", trace.WithAttributes(
+		attribute.String("exception.message", fmt.Sprint(recovered)),
+		attribute.String("exception.stacktrace", stack),
+	))
+	return fmt.Errorf("worker panicked: %v", recovered)
+}
@@ -0,0 +1,130 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/riverqueue/river"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+// reconcileBatchSize limits how many stuck deliveries one sweep handles
+const reconcileBatchSize = 500
+
+// DeliveryReconcileWorker reschedules deliveries stuck in "sending" after a worker crash
+type DeliveryReconcileWorker struct {
+	river.WorkerDefaults[jobs.ReconcileDeliveriesArgs]
+	webhookRepo webhooks.WebhookStore
+	riverClient *river.Client[pgx.Tx]
+	threshold   time.Duration
+}
+
+// NewDeliveryReconcileWorker creates a new delivery reconcile worker. Deliveries are only
+// considered stuck once they've been sending for longer than threshold.
+func NewDeliveryReconcileWorker(webhookRepo webhooks.WebhookStore, riverClient *river.Client[pgx.Tx], threshold time.Duration) *DeliveryReconcileWorker {
+	return &DeliveryReconcileWorker{
+		webhookRepo: webhookRepo,
+		riverClient: riverClient,
+		threshold:   threshold,
+	}
+}
+
+// Work finds stuck deliveries and either re-enqueues them or, when no attempts or time
+// remain, marks them failed or expired
+func (w *DeliveryReconcileWorker) Work(ctx context.Context, job *river.Job[jobs.ReconcileDeliveriesArgs]) error {
+	log := logger.NewLogger("reconcile-worker")
+	now := time.Now()
+
+	stuck, err := w.webhookRepo.FindStuckDeliveries(ctx, now.Add(-w.threshold), reconcileBatchSize)
+	if err != nil {
+		log.Error("Failed to find stuck deliveries", "error", err)
+		return err
+	}
+
+	var requeued, finalized int
+	for _, d := range stuck {
+		var (
+			status  webhooks.WebhookDeliveryStatus
+			message string
+		)
+		switch {
+		case d.JobArgs == nil:
+			status, message = webhooks.StatusFailed, "Delivery was interrupted and its job no longer exists"
+		case !d.ExpiresAt.After(now):
+			status, message = webhooks.StatusExpired, "Delivery was interrupted and has expired"
+		case d.AttemptCount >= d.MaxAttempts:
+			status, message = webhooks.StatusFailed, "Delivery was interrupted on its final attempt"
+		}
+
+		if status != "" {
+			ok, err := w.webhookRepo.ResolveStuckDelivery(ctx, d.ID, status, message)
+			if err != nil {
+				log.Error("Failed to resolve stuck delivery", "delivery_id", d.ID, "error", err)
+				continue
+			}
+			if ok {
+				finalized++
+			}
+			continue
+		}
+
+		ok, err := w.requeue(ctx, d)
+		if err != nil {
+			log.Error("Failed to requeue stuck delivery", "delivery_id", d.ID, "error", err)
+			continue
+		}
+		if ok {
+			requeued++
+		}
+	}
+
+	log.Info("Delivery reconciliation completed",
+		"job_id", job.ID,
+		"stuck", len(stuck),
+		"requeued", requeued,
+		"finalized", finalized,
+	)
+
+	return nil
+}
+
+// requeue marks a stuck delivery as retrying and inserts a new job for its remaining
+// attempts in the same transaction
+func (w *DeliveryReconcileWorker) requeue(ctx context.Context, d *webhooks.DeliveryJob) (bool, error) {
+	var args jobs.WebhookArgs
+	if err := json.Unmarshal(d.JobArgs, &args); err != nil {
+		return false, fmt.Errorf("failed to decode delivery job args: %w", err)
+	}
+
+	tx, err := w.webhookRepo.BeginTx(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	ok, err := w.webhookRepo.ResolveStuckDeliveryTx(ctx, tx, d.ID, webhooks.StatusRetrying,
+		"Delivery was interrupted; rescheduled")
+	if err != nil || !ok {
+		return false, err
+	}
+
+	_, err = w.riverClient.InsertTx(ctx, tx, args, &river.InsertOpts{
+		Queue:       d.JobQueue,
+		Priority:    d.JobPriority,
+		MaxAttempts: d.MaxAttempts - d.AttemptCount,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to insert delivery job: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, err
+	}
+	return true, nil
+}
@@ -0,0 +1,39 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+// SecretRotationWorker removes a webhook's previous signing secret when its grace period ends
+type SecretRotationWorker struct {
+	river.WorkerDefaults[jobs.ClearPreviousSecretArgs]
+	webhookRepo webhooks.WebhookStore
+}
+
+// NewSecretRotationWorker creates a new secret rotation worker
+func NewSecretRotationWorker(webhookRepo webhooks.WebhookStore) *SecretRotationWorker {
+	return &SecretRotationWorker{webhookRepo: webhookRepo}
+}
+
+// Work clears the previous secret. A secret rotated again since the job was scheduled has a
+// later expiry and is left for that rotation's job.
+func (w *SecretRotationWorker) Work(ctx context.Context, job *river.Job[jobs.ClearPreviousSecretArgs]) error {
+	log := logger.NewLogger("secret-rotation-worker")
+
+	cleared, err := w.webhookRepo.ClearExpiredPreviousSecret(ctx, job.Args.WebhookID, time.Now())
+	if err != nil {
+		log.Error("Failed to clear previous signing secret", "webhook_id", job.Args.WebhookID, "error", err)
+		return err
+	}
+	if cleared {
+		log.Info("Cleared previous signing secret", "webhook_id", job.Args.WebhookID)
+	}
+	return nil
+}
@@ -0,0 +1,54 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// simulatedHeader marks the responses of simulated deliveries, so stored deliveries from a
+// load test can't be mistaken for real ones
+const simulatedHeader = "X-Sparrow-Simulated"
+
+// simulatedDeliverer stands in for every target when SIMULATE_DELIVERIES is set. Nothing is
+// sent; each attempt waits a random latency and then succeeds or fails at random, so the
+// rest of the delivery path (status updates, retries, metrics) runs as usual.
+type simulatedDeliverer struct {
+	minLatency  time.Duration
+	maxLatency  time.Duration
+	failureRate float64
+}
+
+// Deliver waits between minLatency and maxLatency, or until ctx ends, then returns a 500 at
+// failureRate and a 200 otherwise
+func (d *simulatedDeliverer) Deliver(ctx context.Context, target, payload string, headers http.Header) (Result, error) {
+	latency := d.minLatency
+	if spread := d.maxLatency - d.minLatency; spread > 0 {
+		latency += rand.N(spread + 1)
+	}
+	timer := time.NewTimer(latency)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return Result{}, ctx.Err()
+	case <-timer.C:
+	}
+
+	statusCode := http.StatusOK
+	if rand.Float64() < d.failureRate {
+		statusCode = http.StatusInternalServerError
+	}
+	return Result{
+		StatusCode: statusCode,
+		Status:     fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode)),
+		Header:     http.Header{simulatedHeader: {"true"}},
+		Body:       io.NopCloser(strings.NewReader("simulated delivery")),
+		URL:        target,
+
+		RequestHeader: headers,
+	}, nil
+}
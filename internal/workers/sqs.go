@@ -0,0 +1,142 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// sqsDeliverer sends deliveries to sqs://<queue-name> targets as SQS messages, using the
+// SQS JSON API. The SendMessage response stands in for the receiver's response.
+type sqsDeliverer struct {
+	client   *http.Client
+	endpoint string
+	region   string
+	creds    awsCredentials
+
+	// queueURLs caches queue URLs by queue name
+	queueURLs sync.Map
+}
+
+// newSQSDeliverer creates an SQS deliverer for region. An empty endpoint uses the
+// regional SQS endpoint.
+func newSQSDeliverer(client *http.Client, region, endpoint string, creds awsCredentials) *sqsDeliverer {
+	if endpoint == "" {
+		endpoint = "https://sqs." + region + ".amazonaws.com"
+	}
+	return &sqsDeliverer{
+		client:   client,
+		endpoint: strings.TrimSuffix(endpoint, "/"),
+		region:   region,
+		creds:    creds,
+	}
+}
+
+// sqsMessageAttribute is a string message attribute in the SQS JSON API
+type sqsMessageAttribute struct {
+	DataType    string `json:"DataType"`
+	StringValue string `json:"StringValue"`
+}
+
+// Deliver sends the payload as a message to the target queue. The content type and the
+// delivery and webhook IDs travel as message attributes; FIFO queues group messages by
+// webhook and deduplicate them by delivery. Other headers don't apply to SQS.
+func (d *sqsDeliverer) Deliver(ctx context.Context, target, payload string, headers http.Header) (Result, error) {
+	u, err := url.Parse(target)
+	if err != nil {
+		return Result{}, fmt.Errorf("invalid SQS target %q: %w", target, err)
+	}
+	queueName := u.Host
+
+	args, ok := deliveryFromContext(ctx)
+	if !ok {
+		return Result{}, fmt.Errorf("SQS delivery to %q has no delivery in context", target)
+	}
+
+	queueURL, err := d.queueURL(ctx, queueName)
+	if err != nil {
+		return Result{}, err
+	}
+
+	input := map[string]any{
+		"QueueUrl":    queueURL,
+		"MessageBody": payload,
+		"MessageAttributes": map[string]sqsMessageAttribute{
+			"ContentType": {DataType: "String", StringValue: headers.Get("Content-Type")},
+			"DeliveryId":  {DataType: "String", StringValue: args.DeliveryID},
+			"WebhookId":   {DataType: "String", StringValue: args.WebhookID},
+		},
+	}
+	if strings.HasSuffix(queueName, ".fifo") {
+		input["MessageGroupId"] = args.WebhookID
+		input["MessageDeduplicationId"] = args.DeliveryID
+	}
+
+	resp, err := d.call(ctx, "SendMessage", input)
+	if err != nil {
+		return Result{}, err
+	}
+	return Result{
+		StatusCode: resp.StatusCode,
+		Status:     resp.Status,
+		Header:     resp.Header,
+		Body:       resp.Body,
+	}, nil
+}
+
+// queueURL resolves a queue name to its URL with GetQueueUrl, caching the result
+func (d *sqsDeliverer) queueURL(ctx context.Context, queueName string) (string, error) {
+	if cached, ok := d.queueURLs.Load(queueName); ok {
+		return cached.(string), nil
+	}
+
+	resp, err := d.call(ctx, "GetQueueUrl", map[string]any{"QueueName": queueName})
+	if err != nil {
+		return "", fmt.Errorf("failed to get SQS queue URL: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 64*1024))
+	if err != nil {
+		return "", fmt.Errorf("failed to read SQS response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get SQS queue URL for %q: %s: %s", queueName, resp.Status, body)
+	}
+
+	var out struct {
+		QueueUrl string `json:"QueueUrl"`
+	}
+	if err := json.Unmarshal(body, &out); err != nil || out.QueueUrl == "" {
+		return "", fmt.Errorf("unexpected SQS GetQueueUrl response: %s", body)
+	}
+
+	d.queueURLs.Store(queueName, out.QueueUrl)
+	return out.QueueUrl, nil
+}
+
+// call invokes an SQS JSON API action, returning the raw response
+func (d *sqsDeliverer) call(ctx context.Context, action string, input map[string]any) (*http.Response, error) {
+	body, err := json.Marshal(input)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode SQS %s request: %w", action, err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", d.endpoint+"/", bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-amz-json-1.0")
+	req.Header.Set("X-Amz-Target", "AmazonSQS."+action)
+
+	signV4(req, body, d.creds, d.region, "sqs", time.Now())
+
+	return d.client.Do(req)
+}
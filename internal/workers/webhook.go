@@ -2,33 +2,87 @@ package workers
 
 import (
 	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
+	"strconv"
+	"sync"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
+	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/propagation"
 	"go.opentelemetry.io/otel/trace"
 
+	"github.com/sarathsp06/sparrow/internal/config"
 	"github.com/sarathsp06/sparrow/internal/jobs"
 	"github.com/sarathsp06/sparrow/internal/logger"
 	"github.com/sarathsp06/sparrow/internal/observability"
 	"github.com/sarathsp06/sparrow/internal/webhooks"
+	"github.com/sarathsp06/sparrow/pkg/verify"
 )
 
+// orderedSnoozeInterval is how long an ordered delivery waits for earlier deliveries to finish
+const orderedSnoozeInterval = 5 * time.Second
+
+// inFlightSnoozeInterval is how long a delivery waits when its webhook is at max_in_flight
+const inFlightSnoozeInterval = 2 * time.Second
+
+// truncatedBodyMarker is appended to stored response bodies that exceeded the capture limit
+const truncatedBodyMarker = "...[truncated]"
+
+// deliveryCallbackMaxAttempts limits retries of best-effort delivery callbacks
+const deliveryCallbackMaxAttempts = 3
+
 // WebhookWorker handles webhook delivery jobs
 type WebhookWorker struct {
 	river.WorkerDefaults[jobs.WebhookArgs]
-	webhookRepo *webhooks.Repository
+	webhookRepo webhooks.WebhookStore
+	riverClient *river.Client[pgx.Tx]
 	tracer      trace.Tracer
 	metrics     *observability.SparrowMetrics
+
+	// deliverer sends each attempt to its target
+	deliverer Deliverer
+
+	callbackURL string
+
+	// environment is sent as the X-Sparrow-Environment header unless the webhook sets it
+	environment string
+
+	maxResponseBytes      int
+	maxRetryAfter         time.Duration
+	maxDeliveryTimeout    time.Duration
+	bodyReadTimeout       time.Duration
+	compressBodyThreshold int
+
+	// deliverySLO is the attempt duration above which a delivery violates the SLO; 0 = none
+	deliverySLO time.Duration
+
+	// allowedPorts are the ports http(s) deliveries may use; empty = any port
+	allowedPorts []int
+
+	// healthWindow is the rolling window of each webhook's health; webhooks failing more than
+	// autoDisableFailureRate of at least autoDisableMinAttempts attempts in it are disabled
+	healthWindow           time.Duration
+	autoDisableFailureRate float64
+	autoDisableMinAttempts int
+
+	// retryAt holds retry times requested by receivers via Retry-After or set by the
+	// webhook's retry schedule, keyed by job ID, until River asks for them in NextRetry
+	retryAt sync.Map
 }
 
 // NewWebhookWorker creates a new webhook worker
-func NewWebhookWorker(webhookRepo *webhooks.Repository) *WebhookWorker {
+func NewWebhookWorker(webhookRepo webhooks.WebhookStore, riverClient *river.Client[pgx.Tx], cfg *config.Config, deliverer Deliverer) *WebhookWorker {
 	metrics, err := observability.NewSparrowMetrics()
 	if err != nil {
 		// Log error but continue without metrics
@@ -38,15 +92,45 @@ func NewWebhookWorker(webhookRepo *webhooks.Repository) *WebhookWorker {
 
 	return &WebhookWorker{
 		webhookRepo: webhookRepo,
+		riverClient: riverClient,
 		tracer:      observability.GetTracer("sparrow.workers.webhook"),
 		metrics:     metrics,
+
+		deliverer: deliverer,
+
+		callbackURL: cfg.DeliveryCallbackURL,
+		environment: cfg.Environment,
+
+		maxResponseBytes:      cfg.MaxStoredResponseBytes,
+		maxRetryAfter:         cfg.MaxRetryAfter,
+		maxDeliveryTimeout:    cfg.MaxDeliveryTimeout,
+		bodyReadTimeout:       cfg.HTTPBodyReadTimeout,
+		compressBodyThreshold: cfg.CompressBodyThreshold,
+
+		deliverySLO:  cfg.DeliverySLO,
+		allowedPorts: cfg.AllowedWebhookPorts,
+
+		healthWindow:           cfg.WebhookHealthWindow,
+		autoDisableFailureRate: cfg.WebhookAutoDisableFailureRate,
+		autoDisableMinAttempts: cfg.WebhookAutoDisableMinAttempts,
 	}
 }
 
+// NextRetry schedules the next attempt at the time requested by the receiver's Retry-After
+// header or the webhook's retry schedule, if any; otherwise River's default backoff applies
+func (w *WebhookWorker) NextRetry(job *river.Job[jobs.WebhookArgs]) time.Time {
+	if retryAt, ok := w.retryAt.LoadAndDelete(job.ID); ok {
+		return retryAt.(time.Time)
+	}
+	return time.Time{}
+}
+
 // Work processes the webhook delivery job
-func (w *WebhookWorker) Work(ctx context.Context, job *river.Job[jobs.WebhookArgs]) error {
+func (w *WebhookWorker) Work(ctx context.Context, job *river.Job[jobs.WebhookArgs]) (err error) {
 	args := job.Args
 
+	// Continue the trace of the event processing that scheduled this delivery
+	ctx = observability.ExtractTraceContext(ctx, args.TraceContext)
 	ctx, span := w.tracer.Start(ctx, "webhook.delivery",
 		trace.WithAttributes(
 			attribute.String("delivery_id", args.DeliveryID),
@@ -61,6 +145,35 @@ func (w *WebhookWorker) Work(ctx context.Context, job *river.Job[jobs.WebhookArg
 
 	log := logger.NewLogger("webhook-worker")
 
+	// A panic, for example in templating, fails the attempt like any other error
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = recoveredPanic(span, log, recovered)
+			w.recordPanic(ctx, job, err)
+		}
+	}()
+
+	// A retry of a delivery the receiver already acknowledged, for example after the job's
+	// completion was lost, must not send it again
+	delivered, err := w.webhookRepo.IsDelivered(ctx, args.DeliveryID)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		log.Warn("Webhook delivery no longer exists", "job_id", job.ID, "delivery_id", args.DeliveryID)
+		return river.JobCancel(fmt.Errorf("webhook delivery %s no longer exists", args.DeliveryID))
+	}
+	if err != nil {
+		log.Error("Failed to check whether delivery was delivered", "error", err, "delivery_id", args.DeliveryID)
+		return fmt.Errorf("failed to check whether delivery was delivered: %w", err)
+	}
+	if delivered {
+		log.Info("Webhook delivery already delivered, not sending again",
+			"job_id", job.ID,
+			"delivery_id", args.DeliveryID,
+			"webhook_id", args.WebhookID,
+		)
+		span.SetAttributes(attribute.Bool("already_delivered", true))
+		return nil
+	}
+
 	// Check if the delivery has expired
 	if time.Now().After(args.ExpiresAt) {
 		span.SetStatus(otelcodes.Error, "webhook delivery expired")
@@ -76,7 +189,82 @@ func (w *WebhookWorker) Work(ctx context.Context, job *river.Job[jobs.WebhookArg
 		if err != nil {
 			log.Error("Failed to update delivery status to expired", "error", err)
 		}
-		return fmt.Errorf("webhook delivery expired")
+		w.notifyCallback(ctx, args, webhooks.StatusExpired, 0)
+		w.recordDelivery(ctx, args, webhooks.StatusExpired, 0, 0)
+		return river.JobCancel(fmt.Errorf("webhook delivery expired"))
+	}
+
+	// The delivery's stored max_attempts is authoritative, including for jobs rescheduled by
+	// the stuck delivery sweep or ExtendDeliveryTTL, which River counts from one again
+	attemptCount, maxAttempts, err := w.webhookRepo.GetDeliveryAttempts(ctx, args.DeliveryID)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		log.Warn("Webhook delivery no longer exists", "job_id", job.ID, "delivery_id", args.DeliveryID)
+		return river.JobCancel(fmt.Errorf("webhook delivery %s no longer exists", args.DeliveryID))
+	}
+	if err != nil {
+		log.Error("Failed to load delivery attempts", "error", err, "delivery_id", args.DeliveryID)
+		return fmt.Errorf("failed to load delivery attempts: %w", err)
+	}
+	if attemptCount >= maxAttempts {
+		span.SetStatus(otelcodes.Error, "webhook delivery attempts exhausted")
+		log.Warn("Webhook delivery attempts exhausted",
+			"job_id", job.ID,
+			"delivery_id", args.DeliveryID,
+			"attempt_count", attemptCount,
+			"max_attempts", maxAttempts,
+		)
+
+		err := w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
+			webhooks.StatusFailed, 0, "", "Delivery attempts exhausted")
+		if err != nil {
+			log.Error("Failed to update delivery status to failed", "error", err)
+		}
+		w.notifyCallback(ctx, args, webhooks.StatusFailed, 0)
+		w.recordDelivery(ctx, args, webhooks.StatusFailed, 0, 0)
+		return river.JobCancel(fmt.Errorf("webhook delivery attempts exhausted"))
+	}
+
+	// A failure is final when River won't retry or this is the delivery's last allowed attempt
+	final := isFinalAttempt(job) || attemptCount+1 >= maxAttempts
+
+	// Ordered webhooks only deliver once every earlier delivery has reached a terminal state
+	if args.Ordered {
+		blocked, err := w.webhookRepo.HasEarlierUndeliveredDelivery(ctx, args.WebhookID, args.DeliveryID)
+		if err != nil {
+			log.Error("Failed to check delivery order", "error", err, "delivery_id", args.DeliveryID)
+			return fmt.Errorf("failed to check delivery order: %w", err)
+		}
+		if blocked {
+			log.Info("Waiting for earlier deliveries of ordered webhook",
+				"job_id", job.ID,
+				"delivery_id", args.DeliveryID,
+				"webhook_id", args.WebhookID,
+			)
+			span.SetAttributes(attribute.Bool("ordered_wait", true))
+			return river.JobSnooze(orderedSnoozeInterval)
+		}
+	}
+
+	// Webhooks with max_in_flight wait for a free slot instead of piling onto the receiver.
+	// Taking the slot marks the delivery as sending.
+	markedSending := false
+	if args.MaxInFlight > 0 {
+		started, err := w.webhookRepo.StartDeliveryWithinLimit(ctx, args.WebhookID, args.DeliveryID, args.MaxInFlight)
+		if err != nil {
+			log.Error("Failed to check in-flight deliveries", "error", err, "delivery_id", args.DeliveryID)
+			return fmt.Errorf("failed to check in-flight deliveries: %w", err)
+		}
+		if !started {
+			log.Info("Waiting for in-flight deliveries of webhook",
+				"job_id", job.ID,
+				"delivery_id", args.DeliveryID,
+				"webhook_id", args.WebhookID,
+				"max_in_flight", args.MaxInFlight,
+			)
+			span.SetAttributes(attribute.Bool("in_flight_wait", true))
+			return river.JobSnooze(inFlightSnoozeInterval)
+		}
+		markedSending = true
 	}
 
 	log.Info("Processing webhook delivery",
@@ -91,110 +279,401 @@ func (w *WebhookWorker) Work(ctx context.Context, job *river.Job[jobs.WebhookArg
 	)
 
 	// Update delivery status to sending
-	if err := w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
-		webhooks.StatusSending, 0, "", ""); err != nil {
-		log.Error("Failed to update delivery status to sending", "error", err)
+	if !markedSending {
+		if err := w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
+			webhooks.StatusSending, 0, "", ""); err != nil {
+			log.Error("Failed to update delivery status to sending", "error", err)
+		}
+	}
+
+	result := w.deliver(ctx, log.With("job_id", job.ID), args, final)
+	switch {
+	case result.Err == nil:
+		return nil
+	case result.Permanent:
+		return river.JobCancel(result.Err)
 	}
 
-	// Create HTTP request (always POST for webhooks)
-	req, err := http.NewRequestWithContext(ctx, "POST", args.URL, bytes.NewBuffer([]byte(args.Payload)))
+	// River only asks for a retry time when attempts remain
+	if !final {
+		if result.RetryAfter > 0 {
+			w.retryAt.Store(job.ID, time.Now().Add(result.RetryAfter))
+			log.Info("Honoring Retry-After from receiver",
+				"job_id", job.ID,
+				"delivery_id", args.DeliveryID,
+				"retry_after", result.RetryAfter,
+			)
+		} else if delay, ok := webhooks.ScheduledRetryDelay(args.RetrySchedule, attemptCount+1); ok {
+			w.retryAt.Store(job.ID, time.Now().Add(delay))
+		}
+	}
+	return retryUnlessFinal(job, final, result.Err)
+}
+
+// attemptResult is the outcome of one delivery attempt
+type attemptResult struct {
+	Status     webhooks.WebhookDeliveryStatus // success, or failed/retrying after a failure
+	StatusCode int                            // Response status; 0 when none was received
+	Err        error                          // Why the attempt failed; nil on success
+
+	// Permanent failures won't succeed on retry
+	Permanent bool
+
+	// RetryAfter is the delay the receiver asked for before the next attempt; 0 = none
+	RetryAfter time.Duration
+}
+
+// deliver makes one attempt of a delivery already marked as sending: it sends the payload to
+// the webhook's URL (then its fallback URLs), and records the outcome, callback and metrics.
+// final reports whether a failure would be the delivery's last attempt.
+func (w *WebhookWorker) deliver(ctx context.Context, log *slog.Logger, args jobs.WebhookArgs, final bool) attemptResult {
+	span := trace.SpanFromContext(ctx)
+
+	// Apply the webhook's field selection; if it can't be applied, fail rather than leak fields
+	payload, err := webhooks.SelectFields(string(args.Payload), args.IncludeFields, args.ExcludeFields)
 	if err != nil {
-		log.Error("Failed to create request",
-			"job_id", job.ID,
+		log.Error("Failed to apply field selection",
 			"delivery_id", args.DeliveryID,
-			"url", args.URL,
-			"method", "POST",
 			"error", err,
 		)
 
-		w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
-			webhooks.StatusFailed, 0, "", fmt.Sprintf("Failed to create request: %v", err))
-		return fmt.Errorf("failed to create request: %w", err)
+		w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+			Status:        webhooks.StatusFailed,
+			ErrorMessage:  fmt.Sprintf("Failed to apply field selection: %v", err),
+			FailureReason: webhooks.FailureOther,
+		})
+		w.notifyCallback(ctx, args, webhooks.StatusFailed, 0)
+		return attemptResult{
+			Status:    webhooks.StatusFailed,
+			Err:       fmt.Errorf("failed to apply field selection: %w", err),
+			Permanent: true,
+		}
 	}
 
-	// Set default Content-Type
-	req.Header.Set("Content-Type", "application/json")
+	// Render templated headers; a template that can't be rendered won't succeed on retry
+	args.Headers, err = webhooks.RenderHeaders(args.Headers, webhooks.HeaderTemplateData(
+		args.EventID, args.Namespace, args.Event, args.DeliveryID, args.WebhookID, args.Metadata))
+	if err != nil {
+		log.Error("Failed to render header templates",
+			"delivery_id", args.DeliveryID,
+			"error", err,
+		)
 
-	// Add custom headers
-	for key, value := range args.Headers {
-		req.Header.Set(key, value)
+		w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+			Status:        webhooks.StatusFailed,
+			ErrorMessage:  fmt.Sprintf("Failed to render header templates: %v", err),
+			FailureReason: webhooks.FailureHeaderTemplate,
+		})
+		w.notifyCallback(ctx, args, webhooks.StatusFailed, 0)
+		return attemptResult{
+			Status:    webhooks.StatusFailed,
+			Err:       fmt.Errorf("failed to render header templates: %w", err),
+			Permanent: true,
+		}
+	}
+
+	// Load credentials at delivery time so secrets never sit in job args
+	var authorization string
+	if args.AuthType != "" {
+		authorization, err = w.authorization(ctx, args)
+		if err != nil {
+			log.Error("Failed to load webhook credentials",
+				"delivery_id", args.DeliveryID,
+				"error", err,
+			)
+
+			w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+				Status:        webhooks.StatusFailed,
+				ErrorMessage:  fmt.Sprintf("Failed to load credentials: %v", err),
+				FailureReason: webhooks.FailureOther,
+			})
+			if final {
+				w.notifyCallback(ctx, args, webhooks.StatusFailed, 0)
+			}
+			return attemptResult{
+				Status: attemptStatus(final),
+				Err:    fmt.Errorf("failed to load credentials: %w", err),
+			}
+		}
+	}
+
+	// Signing secrets are loaded at delivery time too, so a rotation applies to pending deliveries
+	var signingSecrets []string
+	if args.Signed {
+		signingSecrets, err = w.signingSecrets(ctx, args)
+		if err != nil {
+			log.Error("Failed to load webhook signing secrets",
+				"delivery_id", args.DeliveryID,
+				"error", err,
+			)
+
+			w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+				Status:        webhooks.StatusFailed,
+				ErrorMessage:  fmt.Sprintf("Failed to load signing secrets: %v", err),
+				FailureReason: webhooks.FailureOther,
+			})
+			if final {
+				w.notifyCallback(ctx, args, webhooks.StatusFailed, 0)
+			}
+			return attemptResult{
+				Status: attemptStatus(final),
+				Err:    fmt.Errorf("failed to load signing secrets: %w", err),
+			}
+		}
+	}
+
+	// Try the primary URL, then each fallback URL, moving on after a connection failure or 5xx
+	urls := append([]string{args.URL}, args.FallbackURLs...)
+
+	// Add the webhook's query parameters to every URL; like headers, they can't succeed on retry
+	// if they can't be rendered
+	if len(args.QueryParams) > 0 {
+		urls, err = withQueryParams(urls, args.QueryParams, webhooks.HeaderTemplateData(
+			args.EventID, args.Namespace, args.Event, args.DeliveryID, args.WebhookID, args.Metadata))
+		if err != nil {
+			log.Error("Failed to render query parameters",
+				"delivery_id", args.DeliveryID,
+				"error", err,
+			)
+
+			w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+				Status:        webhooks.StatusFailed,
+				ErrorMessage:  fmt.Sprintf("Failed to render query parameters: %v", err),
+				FailureReason: webhooks.FailureHeaderTemplate,
+			})
+			w.notifyCallback(ctx, args, webhooks.StatusFailed, 0)
+			return attemptResult{
+				Status:    webhooks.StatusFailed,
+				Err:       fmt.Errorf("failed to render query parameters: %w", err),
+				Permanent: true,
+			}
+		}
+	}
+
+	// The allowed ports may have changed since the webhook registered; like a template, a
+	// disallowed port won't succeed on retry
+	if err := checkPorts(urls, w.allowedPorts); err != nil {
+		log.Error("Webhook URL uses a disallowed port",
+			"delivery_id", args.DeliveryID,
+			"error", err,
+		)
+
+		w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+			Status:        webhooks.StatusFailed,
+			ErrorMessage:  err.Error(),
+			FailureReason: webhooks.FailurePortNotAllowed,
+		})
+		w.notifyCallback(ctx, args, webhooks.StatusFailed, 0)
+		return attemptResult{
+			Status:    webhooks.StatusFailed,
+			Err:       err,
+			Permanent: true,
+		}
 	}
 
-	// Create HTTP client with timeout
-	client := &http.Client{
-		Timeout: time.Duration(args.Timeout) * time.Second,
+	headers := deliveryHeaders(ctx, args, w.environment, authorization)
+	span.SetAttributes(attribute.String("environment", headers.Get(webhooks.EnvironmentHeader)))
+
+	// Webhooks with compress_body get large bodies gzipped on http(s) targets. The body is
+	// compressed once and every such attempt sends the same bytes.
+	var (
+		gzipped     string
+		gzipHeaders http.Header
+	)
+	if args.CompressBody && len(payload) >= w.compressBodyThreshold {
+		gzipped, err = gzipBody(payload)
+		if err != nil {
+			log.Warn("Failed to compress webhook body, sending it uncompressed",
+				"delivery_id", args.DeliveryID,
+				"error", err,
+			)
+		} else {
+			gzipHeaders = headers.Clone()
+			gzipHeaders.Set("Content-Encoding", "gzip")
+		}
 	}
 
-	// Send the request
-	startTime := time.Now()
-	resp, err := client.Do(req)
-	duration := time.Since(startTime)
+	var (
+		resp          Result
+		targetURL     string
+		duration      time.Duration
+		sentHeaders   map[string]string
+		cancelRequest context.CancelFunc
+	)
+	for i, u := range urls {
+		targetURL = u
+
+		// Bound each request, including reading the response, by the webhook's timeout. Jobs
+		// enqueued before a lower MAX_DELIVERY_TIMEOUT still can't hold a worker longer.
+		reqCtx, cancel := context.WithTimeout(ctx, w.requestTimeout(args.Timeout))
+		defer cancel()
+		cancelRequest = cancel
+
+		reqBody, reqHeaders := payload, headers
+		if gzipHeaders != nil && webhooks.TargetType(u) == webhooks.TargetHTTP {
+			reqBody, reqHeaders = gzipped, gzipHeaders
+		}
+		if signingSecrets != nil {
+			reqHeaders = signHeaders(reqHeaders, signingSecrets, reqBody)
+		}
+
+		startTime := time.Now()
+		resp, err = w.deliverer.Deliver(withDelivery(reqCtx, args), u, reqBody, reqHeaders)
+		duration = time.Since(startTime)
+
+		// Capture the headers the transport actually sent, when it reports them
+		sent := reqHeaders
+		if err == nil && resp.RequestHeader != nil {
+			sent = resp.RequestHeader
+		}
+		sentHeaders = webhooks.CaptureHeaders(sent)
+
+		if i == len(urls)-1 || (err == nil && resp.StatusCode < http.StatusInternalServerError) {
+			break
+		}
+
+		var failure string
+		if err != nil {
+			failure = err.Error()
+		} else {
+			failure = resp.Status
+			io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+			resp.Body.Close()
+		}
+		log.Warn("Webhook URL failed, trying fallback URL",
+			"delivery_id", args.DeliveryID,
+			"url", u,
+			"fallback_url", urls[i+1],
+			"duration_ms", duration.Milliseconds(),
+			"error", failure,
+		)
+	}
 
 	if err != nil {
+		failureReason := webhooks.ClassifyRequestError(err)
 		log.Error("Failed to send webhook",
-			"job_id", job.ID,
 			"delivery_id", args.DeliveryID,
-			"url", args.URL,
+			"url", targetURL,
 			"method", "POST",
 			"duration_ms", duration.Milliseconds(),
+			"failure_reason", failureReason,
 			"error", err,
 		)
 
-		w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
-			webhooks.StatusFailed, 0, "", fmt.Sprintf("Request failed: %v", err))
-		return fmt.Errorf("failed to send webhook: %w", err)
+		w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+			Status:         webhooks.StatusFailed,
+			ResponseURL:    targetURL,
+			ErrorMessage:   fmt.Sprintf("Request failed: %v", err),
+			FailureReason:  failureReason,
+			Duration:       duration,
+			RequestHeaders: sentHeaders,
+		})
+		if final {
+			w.notifyCallback(ctx, args, webhooks.StatusFailed, 0)
+		}
+		w.recordDelivery(ctx, args, attemptStatus(final), 0, duration)
+		w.trackHealth(ctx, args.WebhookID, args.Namespace, false)
+		return attemptResult{
+			Status: attemptStatus(final),
+			Err:    fmt.Errorf("failed to send webhook: %w", err),
+		}
 	}
 	defer resp.Body.Close()
+	defer w.limitBodyRead(cancelRequest)()
+
+	// Record where a followed redirect ended up
+	if resp.URL != "" {
+		targetURL = resp.URL
+	}
+
+	// Read response body up to the configured capture limit
+	limit := args.MaxResponseBytes
+	if limit <= 0 {
+		limit = w.maxResponseBytes
+	}
+	bodyReader := io.Reader(resp.Body)
+
+	// A success body matcher sees up to the maximum capture size, regardless of the limit
+	var matchBody []byte
+	if args.SuccessBodyMatcher != nil {
+		matchBody, err = io.ReadAll(io.LimitReader(resp.Body, webhooks.MaxStoredResponseBytesLimit+1))
+		if err != nil {
+			log.Warn("Failed to read response body for matching", "error", err)
+		}
+		bodyReader = bytes.NewReader(matchBody)
+	}
 
-	// Read response body (limit to first 1000 chars for logging)
-	body, err := io.ReadAll(io.LimitReader(resp.Body, 1000))
+	body, truncated, err := readResponseBody(bodyReader, limit)
 	if err != nil {
-		log.Warn("Failed to read response body", "error", err)
-		body = []byte("Failed to read response body")
+		log.Warn("Failed to read response body", "error", err, "response_truncated", truncated)
+		if body == "" {
+			body = "Failed to read response body"
+		}
+	}
+	if truncated {
+		w.recordTruncated(ctx, args.Namespace)
 	}
+	responseContentType := resp.Header.Get("Content-Type")
 
 	log.Info("Webhook response received",
-		"job_id", job.ID,
 		"delivery_id", args.DeliveryID,
-		"url", args.URL,
+		"url", targetURL,
 		"method", "POST",
 		"status_code", resp.StatusCode,
 		"status", resp.Status,
 		"duration_ms", duration.Milliseconds(),
+		"response_content_type", responseContentType,
+		"response_truncated", truncated,
 	)
 
-	// Consider 2xx status codes as success
-	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+	// Consider 2xx status codes as success, unless the body fails the webhook's matcher
+	var matchErr error
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 && args.SuccessBodyMatcher != nil {
+		matchErr = args.SuccessBodyMatcher.Check(matchBody)
+	}
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 && matchErr == nil {
 		span.SetAttributes(
 			attribute.Int("status_code", resp.StatusCode),
 			attribute.Float64("duration_seconds", duration.Seconds()),
 		)
 		span.SetStatus(otelcodes.Ok, "webhook delivered successfully")
 
-		// Record metrics
-		if w.metrics != nil {
-			w.metrics.WebhookDeliveries.Add(ctx, 1)
-			w.metrics.DeliveryDuration.Record(ctx, duration.Seconds())
-		}
+		w.recordDelivery(ctx, args, webhooks.StatusSuccess, resp.StatusCode, duration)
+		w.trackHealth(ctx, args.WebhookID, args.Namespace, true)
 
 		log.Info("Webhook delivered successfully",
-			"job_id", job.ID,
 			"delivery_id", args.DeliveryID,
-			"url", args.URL,
+			"url", targetURL,
 			"status_code", resp.StatusCode,
 			"duration_ms", duration.Milliseconds(),
 		)
 
-		err := w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
-			webhooks.StatusSuccess, resp.StatusCode, string(body), "")
+		err := w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+			Status:              webhooks.StatusSuccess,
+			ResponseCode:        resp.StatusCode,
+			ResponseBody:        body,
+			ResponseContentType: responseContentType,
+			ResponseURL:         targetURL,
+			ResponseTruncated:   truncated,
+			Duration:            duration,
+			RequestHeaders:      sentHeaders,
+			ResponseHeaders:     webhooks.CaptureHeaders(resp.Header),
+		})
 		if err != nil {
 			log.Error("Failed to update delivery status to success", "error", err)
 		}
-		return nil
+		w.notifyCallback(ctx, args, webhooks.StatusSuccess, resp.StatusCode)
+		return attemptResult{Status: webhooks.StatusSuccess, StatusCode: resp.StatusCode}
 	}
 
 	// For non-2xx responses, update status and return error for retry
 	errorMessage := fmt.Sprintf("HTTP %d: %s", resp.StatusCode, resp.Status)
+	failureReason := webhooks.ClassifyStatusCode(resp.StatusCode)
+	if matchErr != nil {
+		errorMessage = fmt.Sprintf("HTTP %d: response body did not match: %v", resp.StatusCode, matchErr)
+		failureReason = webhooks.FailureBodyMatch
+	}
 
 	span.SetAttributes(
 		attribute.Int("status_code", resp.StatusCode),
@@ -203,26 +682,360 @@ func (w *WebhookWorker) Work(ctx context.Context, job *river.Job[jobs.WebhookArg
 	span.RecordError(fmt.Errorf("webhook delivery failed: %s", errorMessage))
 	span.SetStatus(otelcodes.Error, "webhook delivery failed")
 
-	// Record metrics
-	if w.metrics != nil {
-		w.metrics.WebhookDeliveries.Add(ctx, 1)
-		w.metrics.DeliveryDuration.Record(ctx, duration.Seconds())
-	}
+	w.recordDelivery(ctx, args, attemptStatus(final), resp.StatusCode, duration)
+	w.trackHealth(ctx, args.WebhookID, args.Namespace, false)
 
 	log.Warn("Webhook delivery failed",
-		"job_id", job.ID,
 		"delivery_id", args.DeliveryID,
-		"url", args.URL,
+		"url", targetURL,
 		"status_code", resp.StatusCode,
 		"status", resp.Status,
 		"duration_ms", duration.Milliseconds(),
+		"failure_reason", failureReason,
 	)
 
-	err = w.webhookRepo.UpdateDeliveryStatus(ctx, args.DeliveryID,
-		webhooks.StatusFailed, resp.StatusCode, string(body), errorMessage)
+	err = w.webhookRepo.RecordDeliveryAttempt(ctx, args.DeliveryID, &webhooks.DeliveryAttempt{
+		Status:              webhooks.StatusFailed,
+		ResponseCode:        resp.StatusCode,
+		ResponseBody:        body,
+		ResponseContentType: responseContentType,
+		ResponseURL:         targetURL,
+		ResponseTruncated:   truncated,
+		ErrorMessage:        errorMessage,
+		FailureReason:       failureReason,
+		Duration:            duration,
+		RequestHeaders:      sentHeaders,
+		ResponseHeaders:     webhooks.CaptureHeaders(resp.Header),
+	})
 	if err != nil {
 		log.Error("Failed to update delivery status to failed", "error", err)
 	}
+	if final {
+		w.notifyCallback(ctx, args, webhooks.StatusFailed, resp.StatusCode)
+	}
+
+	// Receivers that are rate limiting or unavailable may tell us when to come back
+	var retryAfter time.Duration
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			retryAfter = min(delay, w.maxRetryAfter)
+		}
+	}
+
+	return attemptResult{
+		Status:     attemptStatus(final),
+		StatusCode: resp.StatusCode,
+		Err:        fmt.Errorf("webhook delivery failed: %s", errorMessage),
+		RetryAfter: retryAfter,
+	}
+}
+
+// authorization builds the Authorization header from the webhook's stored credentials
+func (w *WebhookWorker) authorization(ctx context.Context, args jobs.WebhookArgs) (string, error) {
+	creds, err := w.webhookRepo.GetWebhookCredentials(ctx, args.WebhookID)
+	if err != nil {
+		return "", err
+	}
+	return webhooks.AuthorizationHeader(args.AuthType, creds)
+}
+
+// recordPanic records a delivery attempt that panicked as failed, or retrying when River will
+// run it again
+func (w *WebhookWorker) recordPanic(ctx context.Context, job *river.Job[jobs.WebhookArgs], err error) {
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(otelcodes.Error, "webhook delivery panicked")
+
+	final := isFinalAttempt(job)
+	recordErr := w.webhookRepo.RecordDeliveryAttempt(ctx, job.Args.DeliveryID, &webhooks.DeliveryAttempt{
+		Status:        attemptStatus(final),
+		ErrorMessage:  err.Error(),
+		FailureReason: webhooks.FailureOther,
+	})
+	if recordErr != nil {
+		logger.NewLogger("webhook-worker").Error("Failed to record panicked delivery attempt",
+			"delivery_id", job.Args.DeliveryID,
+			"error", recordErr,
+		)
+	}
+	if final {
+		w.notifyCallback(ctx, job.Args, webhooks.StatusFailed, 0)
+	}
+	w.recordDelivery(ctx, job.Args, attemptStatus(final), 0, 0)
+}
+
+// signingSecrets loads the secrets a signed webhook's deliveries are currently signed with
+func (w *WebhookWorker) signingSecrets(ctx context.Context, args jobs.WebhookArgs) ([]string, error) {
+	secrets, err := w.webhookRepo.GetSigningSecrets(ctx, args.WebhookID)
+	if err != nil {
+		return nil, err
+	}
+	return secrets.Active(time.Now()), nil
+}
+
+// signHeaders returns a copy of headers with the timestamp and signature of body, as sent,
+// signed with each of secrets
+func signHeaders(headers http.Header, secrets []string, body string) http.Header {
+	timestamp, signature := webhooks.SignatureHeaders(secrets, []byte(body), time.Now())
+	signed := headers.Clone()
+	signed.Set(verify.TimestampHeader, timestamp)
+	signed.Set(verify.SignatureHeader, signature)
+	return signed
+}
+
+// limitBodyRead cancels a request whose response body takes longer than the body read
+// timeout to arrive, so a receiver trickling its body can't hold the delivery for its full
+// timeout. The returned function stops the timer.
+func (w *WebhookWorker) limitBodyRead(cancel context.CancelFunc) func() bool {
+	if w.bodyReadTimeout <= 0 {
+		return func() bool { return false }
+	}
+	return time.AfterFunc(w.bodyReadTimeout, cancel).Stop
+}
+
+// requestTimeout bounds a request by the delivery's timeout in seconds, clamped to the
+// configured ceiling; a delivery without a timeout gets the ceiling
+func (w *WebhookWorker) requestTimeout(seconds int) time.Duration {
+	timeout := time.Duration(seconds) * time.Second
+	if timeout <= 0 || (w.maxDeliveryTimeout > 0 && timeout > w.maxDeliveryTimeout) {
+		return w.maxDeliveryTimeout
+	}
+	return timeout
+}
+
+// withQueryParams renders params against data and adds them to each of urls
+func withQueryParams(urls []string, params map[string]string, data map[string]any) ([]string, error) {
+	rendered, err := webhooks.RenderQueryParams(params, data)
+	if err != nil {
+		return nil, err
+	}
+	withParams := make([]string, len(urls))
+	for i, u := range urls {
+		if withParams[i], err = webhooks.AddQueryParams(u, rendered); err != nil {
+			return nil, err
+		}
+	}
+	return withParams, nil
+}
+
+// deliveryHeaders builds the headers sent with every attempt of a delivery: its content
+// type, environment, custom headers, trace context (unless disabled) and authorization
+func deliveryHeaders(ctx context.Context, args jobs.WebhookArgs, environment, authorization string) http.Header {
+	headers := make(http.Header)
+
+	// Tag the delivery with the environment; a custom header below takes precedence
+	if environment != "" {
+		headers.Set(webhooks.EnvironmentHeader, environment)
+	}
+
+	// Set default Content-Type; an explicit Content-Type header below takes precedence
+	contentType := args.ContentType
+	if contentType == "" {
+		contentType = webhooks.DefaultContentType
+	}
+	headers.Set("Content-Type", contentType)
+
+	// Propagate the trace context so instrumented receivers can continue the trace
+	if !args.DisableTracePropagation {
+		otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(headers))
+	}
+
+	// Add custom headers
+	for key, value := range args.Headers {
+		headers.Set(key, value)
+	}
+
+	if authorization != "" {
+		headers.Set("Authorization", authorization)
+	}
+	return headers
+}
+
+// gzipBody gzips a delivery body
+func gzipBody(payload string) (string, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := io.WriteString(zw, payload); err != nil {
+		return "", err
+	}
+	if err := zw.Close(); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
 
-	return fmt.Errorf("webhook delivery failed: %s", errorMessage)
+// checkPorts checks that every URL a delivery may be sent to uses an allowed port
+func checkPorts(urls []string, allowed []int) error {
+	for _, u := range urls {
+		if err := webhooks.CheckWebhookPort(u, allowed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attemptStatus is the outcome of a failed attempt: retrying unless it was the final one
+func attemptStatus(final bool) webhooks.WebhookDeliveryStatus {
+	if final {
+		return webhooks.StatusFailed
+	}
+	return webhooks.StatusRetrying
+}
+
+// recordDelivery records delivery metrics by namespace, outcome and HTTP status class.
+// statusCode is 0 when no response was received; duration is 0 when nothing was sent.
+func (w *WebhookWorker) recordDelivery(ctx context.Context, args jobs.WebhookArgs, status webhooks.WebhookDeliveryStatus, statusCode int, duration time.Duration) {
+	if w.violatesSLO(duration) {
+		w.recordSLOViolation(ctx, args, duration)
+	}
+	if w.metrics == nil {
+		return
+	}
+
+	attrs := metric.WithAttributes(
+		attribute.String("namespace", args.Namespace),
+		attribute.String("status", string(status)),
+		attribute.String("http_status_class", httpStatusClass(statusCode)),
+	)
+
+	w.metrics.WebhookDeliveries.Add(ctx, 1, attrs)
+	if duration > 0 {
+		w.metrics.DeliveryDuration.Record(ctx, duration.Seconds(), attrs)
+	}
+	if statusCode > 0 {
+		w.metrics.ResponseStatusCode.Record(ctx, int64(statusCode), metric.WithAttributes(
+			attribute.String("namespace", args.Namespace),
+		))
+	}
+}
+
+// violatesSLO reports whether an attempt that took duration exceeded the delivery SLO
+func (w *WebhookWorker) violatesSLO(duration time.Duration) bool {
+	return w.deliverySLO > 0 && duration > w.deliverySLO
+}
+
+// recordSLOViolation logs and counts an attempt that took longer than the delivery SLO, so
+// slow receivers show up even without a metrics backend
+func (w *WebhookWorker) recordSLOViolation(ctx context.Context, args jobs.WebhookArgs, duration time.Duration) {
+	log := logger.NewLogger("webhook-worker")
+	log.Warn("Webhook delivery exceeded the delivery SLO",
+		"delivery_id", args.DeliveryID,
+		"webhook_id", args.WebhookID,
+		"namespace", args.Namespace,
+		"duration_ms", duration.Milliseconds(),
+		"slo_ms", w.deliverySLO.Milliseconds(),
+	)
+
+	if w.metrics == nil {
+		return
+	}
+	w.metrics.SLOViolations.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("namespace", args.Namespace),
+		attribute.String("webhook_id", args.WebhookID),
+	))
+}
+
+// recordTruncated counts a response whose body exceeded the capture limit
+func (w *WebhookWorker) recordTruncated(ctx context.Context, namespace string) {
+	if w.metrics == nil {
+		return
+	}
+	w.metrics.ResponseTruncated.Add(ctx, 1, metric.WithAttributes(
+		attribute.String("namespace", namespace),
+	))
+}
+
+// httpStatusClass groups a status code as "2xx", "4xx" and so on, or "error" without a response
+func httpStatusClass(statusCode int) string {
+	if statusCode < 100 || statusCode > 599 {
+		return "error"
+	}
+	return fmt.Sprintf("%dxx", statusCode/100)
+}
+
+// retryUnlessFinal returns err for River to retry, or cancels the job when the failed attempt
+// was the delivery's last but River still has attempts left
+func retryUnlessFinal(job *river.Job[jobs.WebhookArgs], final bool, err error) error {
+	if final && !isFinalAttempt(job) {
+		return river.JobCancel(err)
+	}
+	return err
+}
+
+// isFinalAttempt reports whether River won't retry the job if this attempt fails
+func isFinalAttempt(job *river.Job[jobs.WebhookArgs]) bool {
+	return job.Attempt >= job.MaxAttempts
+}
+
+// notifyCallback enqueues a best-effort notification that a delivery reached a terminal state.
+// Failures are logged and never affect the delivery itself.
+func (w *WebhookWorker) notifyCallback(ctx context.Context, args jobs.WebhookArgs, status webhooks.WebhookDeliveryStatus, statusCode int) {
+	if w.callbackURL == "" || w.riverClient == nil {
+		return
+	}
+
+	_, err := w.riverClient.Insert(ctx, jobs.DeliveryCallbackArgs{
+		URL:        w.callbackURL,
+		DeliveryID: args.DeliveryID,
+		WebhookID:  args.WebhookID,
+		EventID:    args.EventID,
+		Namespace:  args.Namespace,
+		Event:      args.Event,
+		Status:     string(status),
+		StatusCode: statusCode,
+	}, &river.InsertOpts{MaxAttempts: deliveryCallbackMaxAttempts})
+	if err != nil {
+		logger.NewLogger("webhook-worker").Warn("Failed to enqueue delivery callback",
+			"delivery_id", args.DeliveryID,
+			"error", err,
+		)
+	}
+}
+
+// parseRetryAfter parses a Retry-After header given either as delay-seconds or an HTTP-date.
+// Dates in the past yield a zero delay.
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	retryAt, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if delay := retryAt.Sub(now); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+// readResponseBody reads at most limit bytes of the body (capped at MaxStoredResponseBytesLimit),
+// reporting whether the body was longer and marking the returned text if so. A body whose
+// reading fails partway, for example at the delivery timeout, is returned as read so far,
+// marked as truncated, along with the error.
+func readResponseBody(r io.Reader, limit int) (string, bool, error) {
+	if limit <= 0 || limit > webhooks.MaxStoredResponseBytesLimit {
+		limit = webhooks.MaxStoredResponseBytesLimit
+	}
+
+	// Read one extra byte to detect truncation
+	body, err := io.ReadAll(io.LimitReader(r, int64(limit)+1))
+	if err != nil {
+		if len(body) == 0 {
+			return "", false, err
+		}
+		return string(body[:min(len(body), limit)]) + truncatedBodyMarker, true, err
+	}
+
+	if len(body) > limit {
+		return string(body[:limit]) + truncatedBodyMarker, true, nil
+	}
+	return string(body), false, nil
 }
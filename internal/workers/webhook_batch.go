@@ -0,0 +1,433 @@
+package workers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/riverqueue/river"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+// WebhookBatchWorker sends a batching webhook's waiting deliveries as one NDJSON request,
+// one line per delivery. Each delivery keeps its own status and attempt count; a failed
+// batch is retried by a follow-up batch job rather than by River.
+type WebhookBatchWorker struct {
+	river.WorkerDefaults[jobs.WebhookBatchArgs]
+
+	// delivery provides the deliverer, callbacks, metrics and limits of single deliveries
+	delivery *WebhookWorker
+
+	// staleAfter is how long a claimed delivery may stay sending before another batch
+	// assumes its batch was interrupted and claims it again
+	staleAfter time.Duration
+}
+
+// NewWebhookBatchWorker creates a batch worker that sends through the webhook worker's
+// deliverer
+func NewWebhookBatchWorker(delivery *WebhookWorker, staleAfter time.Duration) *WebhookBatchWorker {
+	return &WebhookBatchWorker{delivery: delivery, staleAfter: staleAfter}
+}
+
+// batchJob returns the batch job that sends a webhook's deliveries waiting at flushAt,
+// rounded up to the end of its batch window. Jobs are unique per webhook and window, so
+// deliveries in one window share a job.
+func batchJob(webhook *webhooks.WebhookRegistration, flushAt time.Time) (jobs.WebhookBatchArgs, *river.InsertOpts) {
+	flushAt = webhooks.BatchFlushAt(flushAt, time.Duration(webhook.BatchWindowMs)*time.Millisecond)
+	return jobs.WebhookBatchArgs{WebhookID: webhook.ID, FlushAt: flushAt}, &river.InsertOpts{
+		Queue:       "webhooks",
+		Priority:    webhooks.ResolvePriority(0, webhook.Priority),
+		ScheduledAt: flushAt,
+		UniqueOpts:  river.UniqueOpts{ByArgs: true},
+	}
+}
+
+// batchRetryDelay is how long a failed batch waits before its deliveries are tried again,
+// following River's default backoff for the deliveries' attempt count
+func batchRetryDelay(attempt int) time.Duration {
+	return time.Duration(attempt*attempt*attempt*attempt) * time.Second
+}
+
+// Work sends the webhook's waiting deliveries
+func (w *WebhookBatchWorker) Work(ctx context.Context, job *river.Job[jobs.WebhookBatchArgs]) error {
+	log := logger.NewLogger("webhook-batch-worker")
+	repo := w.delivery.webhookRepo
+
+	webhook, err := repo.GetWebhookByID(ctx, job.Args.WebhookID)
+	if errors.Is(err, webhooks.ErrNotFound) {
+		return river.JobCancel(fmt.Errorf("webhook %s no longer exists", job.Args.WebhookID))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load webhook: %w", err)
+	}
+
+	ctx, span := w.delivery.tracer.Start(ctx, "webhook.batch",
+		trace.WithAttributes(
+			attribute.String("webhook_id", webhook.ID),
+			attribute.String("url", webhook.URL),
+			attribute.String("namespace", webhook.Namespace),
+		),
+	)
+	defer span.End()
+
+	// Deliveries that waited past their TTL expire rather than being sent
+	expired, err := repo.ExpireBatchDeliveries(ctx, webhook.ID)
+	if err != nil {
+		return fmt.Errorf("failed to expire batched deliveries: %w", err)
+	}
+	for _, d := range expired {
+		args := w.deliveryArgs(ctx, webhook, d, nil)
+		w.delivery.notifyCallback(ctx, args, webhooks.StatusExpired, 0)
+		w.delivery.recordDelivery(ctx, args, webhooks.StatusExpired, 0, 0)
+	}
+
+	// Loaded before claiming, so a failure doesn't leave claimed deliveries sending
+	defaults, err := repo.GetNamespaceDefaults(ctx, webhook.Namespace)
+	if err != nil {
+		return fmt.Errorf("failed to load namespace defaults: %w", err)
+	}
+
+	limit := webhook.BatchMaxSize
+	if limit <= 0 {
+		limit = webhooks.DefaultBatchMaxSize
+	}
+	claimed, err := repo.ClaimBatchDeliveries(ctx, webhook.ID, limit, time.Now().Add(-w.staleAfter))
+	if err != nil {
+		return fmt.Errorf("failed to claim batched deliveries: %w", err)
+	}
+	if len(claimed) == 0 {
+		return nil
+	}
+	span.SetAttributes(attribute.Int("batch_size", len(claimed)))
+
+	// A full batch means more deliveries may be waiting; they go in the next window
+	if len(claimed) == limit {
+		w.schedule(ctx, webhook, time.Now())
+	}
+
+	batch, body := w.buildBody(ctx, webhook, claimed)
+	if len(batch) == 0 {
+		return nil
+	}
+
+	log.Info("Sending webhook batch",
+		"job_id", job.ID,
+		"webhook_id", webhook.ID,
+		"url", webhook.URL,
+		"batch_size", len(batch),
+	)
+
+	// Templated headers and query parameters render without event fields, since a batch spans
+	// several events
+	templateData := webhooks.HeaderTemplateData("", webhook.Namespace, "", "", webhook.ID, nil)
+	rendered, err := webhooks.RenderHeaders(webhooks.MergeHeaders(defaults.Headers, webhook.Headers), templateData)
+	if err != nil {
+		span.SetStatus(otelcodes.Error, "failed to render header templates")
+		w.recordBatch(ctx, webhook, batch, true, &webhooks.DeliveryAttempt{
+			Status:        webhooks.StatusFailed,
+			ErrorMessage:  fmt.Sprintf("Failed to render header templates: %v", err),
+			FailureReason: webhooks.FailureHeaderTemplate,
+		})
+		return nil
+	}
+
+	targetURL := webhook.URL
+	if len(webhook.QueryParams) > 0 {
+		urls, err := withQueryParams([]string{webhook.URL}, webhook.QueryParams, templateData)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "failed to render query parameters")
+			w.recordBatch(ctx, webhook, batch, true, &webhooks.DeliveryAttempt{
+				Status:        webhooks.StatusFailed,
+				ErrorMessage:  fmt.Sprintf("Failed to render query parameters: %v", err),
+				FailureReason: webhooks.FailureHeaderTemplate,
+			})
+			return nil
+		}
+		targetURL = urls[0]
+	}
+	if err := webhooks.CheckWebhookPort(targetURL, w.delivery.allowedPorts); err != nil {
+		span.SetStatus(otelcodes.Error, "webhook URL uses a disallowed port")
+		w.recordBatch(ctx, webhook, batch, true, &webhooks.DeliveryAttempt{
+			Status:        webhooks.StatusFailed,
+			ErrorMessage:  err.Error(),
+			FailureReason: webhooks.FailurePortNotAllowed,
+		})
+		return nil
+	}
+
+	headerArgs := jobs.WebhookArgs{
+		WebhookID:               webhook.ID,
+		Namespace:               webhook.Namespace,
+		Headers:                 rendered,
+		ContentType:             webhooks.BatchContentType,
+		DisableTracePropagation: webhook.DisableTracePropagation,
+		AuthType:                webhook.AuthType,
+		Signed:                  webhook.Signed,
+		BypassProxy:             webhook.BypassProxy,
+	}
+	var authorization string
+	if webhook.AuthType != "" {
+		authorization, err = w.delivery.authorization(ctx, headerArgs)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "failed to load webhook credentials")
+			w.retryBatch(ctx, webhook, batch, 0, &webhooks.DeliveryAttempt{
+				ErrorMessage:  fmt.Sprintf("Failed to load credentials: %v", err),
+				FailureReason: webhooks.FailureOther,
+			})
+			return nil
+		}
+	}
+	headers := deliveryHeaders(ctx, headerArgs, w.delivery.environment, authorization)
+	span.SetAttributes(attribute.String("environment", headers.Get(webhooks.EnvironmentHeader)))
+
+	if webhook.CompressBody && len(body) >= w.delivery.compressBodyThreshold {
+		if gzipped, err := gzipBody(body); err == nil {
+			body = gzipped
+			headers.Set("Content-Encoding", "gzip")
+		}
+	}
+
+	if webhook.Signed {
+		secrets, err := w.delivery.signingSecrets(ctx, headerArgs)
+		if err != nil {
+			span.SetStatus(otelcodes.Error, "failed to load webhook signing secrets")
+			w.retryBatch(ctx, webhook, batch, 0, &webhooks.DeliveryAttempt{
+				ErrorMessage:  fmt.Sprintf("Failed to load signing secrets: %v", err),
+				FailureReason: webhooks.FailureOther,
+			})
+			return nil
+		}
+		headers = signHeaders(headers, secrets, body)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, w.delivery.requestTimeout(webhook.Timeout))
+	defer cancel()
+
+	startTime := time.Now()
+	resp, err := w.delivery.deliverer.Deliver(withDelivery(reqCtx, headerArgs), targetURL, body, headers)
+	duration := time.Since(startTime)
+
+	sent := headers
+	if err == nil && resp.RequestHeader != nil {
+		sent = resp.RequestHeader
+	}
+	sentHeaders := webhooks.CaptureHeaders(sent)
+
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(otelcodes.Error, "webhook batch failed")
+		log.Error("Failed to send webhook batch", "webhook_id", webhook.ID, "error", err)
+		w.delivery.trackHealth(ctx, webhook.ID, webhook.Namespace, false)
+
+		w.retryBatch(ctx, webhook, batch, 0, &webhooks.DeliveryAttempt{
+			ResponseURL:    targetURL,
+			ErrorMessage:   fmt.Sprintf("Request failed: %v", err),
+			FailureReason:  webhooks.ClassifyRequestError(err),
+			Duration:       duration,
+			RequestHeaders: sentHeaders,
+		})
+		return nil
+	}
+	defer resp.Body.Close()
+	defer w.delivery.limitBodyRead(cancel)()
+
+	if resp.URL != "" {
+		targetURL = resp.URL
+	}
+
+	limitBytes := webhook.MaxStoredResponseBytes
+	if limitBytes <= 0 {
+		limitBytes = w.delivery.maxResponseBytes
+	}
+	respBody, truncated, err := readResponseBody(resp.Body, limitBytes)
+	if err != nil && respBody == "" {
+		respBody = "Failed to read response body"
+	}
+	if truncated {
+		w.delivery.recordTruncated(ctx, webhook.Namespace)
+	}
+
+	attempt := &webhooks.DeliveryAttempt{
+		ResponseCode:        resp.StatusCode,
+		ResponseBody:        respBody,
+		ResponseContentType: resp.Header.Get("Content-Type"),
+		ResponseURL:         targetURL,
+		ResponseTruncated:   truncated,
+		Duration:            duration,
+		RequestHeaders:      sentHeaders,
+		ResponseHeaders:     webhooks.CaptureHeaders(resp.Header),
+	}
+	span.SetAttributes(attribute.Int("status_code", resp.StatusCode))
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		span.SetStatus(otelcodes.Ok, "webhook batch delivered")
+		log.Info("Webhook batch delivered",
+			"webhook_id", webhook.ID,
+			"batch_size", len(batch),
+			"status_code", resp.StatusCode,
+			"duration_ms", duration.Milliseconds(),
+		)
+
+		w.delivery.trackHealth(ctx, webhook.ID, webhook.Namespace, true)
+		attempt.Status = webhooks.StatusSuccess
+		w.recordBatch(ctx, webhook, batch, true, attempt)
+		return nil
+	}
+
+	span.SetStatus(otelcodes.Error, "webhook batch failed")
+	log.Warn("Webhook batch failed",
+		"webhook_id", webhook.ID,
+		"batch_size", len(batch),
+		"status_code", resp.StatusCode,
+	)
+
+	// Receivers that are rate limiting or unavailable may tell us when to come back
+	var retryAfter time.Duration
+	if resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusServiceUnavailable {
+		if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+			retryAfter = min(delay, w.delivery.maxRetryAfter)
+		}
+	}
+
+	w.delivery.trackHealth(ctx, webhook.ID, webhook.Namespace, false)
+	attempt.ErrorMessage = fmt.Sprintf("HTTP %d: %s", resp.StatusCode, resp.Status)
+	attempt.FailureReason = webhooks.ClassifyStatusCode(resp.StatusCode)
+	w.retryBatch(ctx, webhook, batch, retryAfter, attempt)
+	return nil
+}
+
+// batchEntry is a claimed delivery with its event
+type batchEntry struct {
+	delivery *webhooks.WebhookDelivery
+	event    *webhooks.EventRecord
+}
+
+// buildBody encodes the claimed deliveries as NDJSON. Deliveries whose event is gone or
+// whose field selection can't be applied fail on their own and are left out.
+func (w *WebhookBatchWorker) buildBody(ctx context.Context, webhook *webhooks.WebhookRegistration, claimed []*webhooks.WebhookDelivery) ([]batchEntry, string) {
+	var (
+		batch []batchEntry
+		body  bytes.Buffer
+	)
+	for _, d := range claimed {
+		event, err := w.delivery.webhookRepo.GetEvent(ctx, d.EventID)
+		if err != nil {
+			w.recordBatch(ctx, webhook, []batchEntry{{delivery: d}}, true, &webhooks.DeliveryAttempt{
+				Status:        webhooks.StatusFailed,
+				ErrorMessage:  fmt.Sprintf("Failed to load event: %v", err),
+				FailureReason: webhooks.FailureOther,
+			})
+			continue
+		}
+
+		entry := batchEntry{delivery: d, event: event}
+		payload, err := webhooks.SelectFields(event.Payload, webhook.IncludeFields, webhook.ExcludeFields)
+		if err == nil {
+			var line []byte
+			line, err = webhooks.EncodeBatchLine(d.ID, event.ID, event.Event, payload)
+			body.Write(line)
+		}
+		if err != nil {
+			w.recordBatch(ctx, webhook, []batchEntry{entry}, true, &webhooks.DeliveryAttempt{
+				Status:        webhooks.StatusFailed,
+				ErrorMessage:  fmt.Sprintf("Failed to apply field selection: %v", err),
+				FailureReason: webhooks.FailureOther,
+			})
+			continue
+		}
+		batch = append(batch, entry)
+	}
+	return batch, body.String()
+}
+
+// retryBatch records a failed attempt for each delivery in the batch. Deliveries with
+// attempts left are retried by a follow-up batch job after the webhook's scheduled delay or
+// a backoff, or after retryAfter if the receiver asked for longer.
+func (w *WebhookBatchWorker) retryBatch(ctx context.Context, webhook *webhooks.WebhookRegistration, batch []batchEntry, retryAfter time.Duration, attempt *webhooks.DeliveryAttempt) {
+	var retrying []batchEntry
+	var failed []batchEntry
+	maxAttempt := 0
+	for _, entry := range batch {
+		if entry.delivery.AttemptCount >= entry.delivery.MaxAttempts {
+			failed = append(failed, entry)
+			continue
+		}
+		retrying = append(retrying, entry)
+		maxAttempt = max(maxAttempt, entry.delivery.AttemptCount)
+	}
+
+	final := *attempt
+	final.Status = webhooks.StatusFailed
+	w.recordBatch(ctx, webhook, failed, true, &final)
+
+	if len(retrying) == 0 {
+		return
+	}
+	retry := *attempt
+	retry.Status = webhooks.StatusRetrying
+	w.recordBatch(ctx, webhook, retrying, false, &retry)
+	delay, ok := webhooks.ScheduledRetryDelay(webhook.RetrySchedule, maxAttempt)
+	if !ok {
+		delay = batchRetryDelay(maxAttempt)
+	}
+	w.schedule(ctx, webhook, time.Now().Add(max(delay, retryAfter)))
+}
+
+// recordBatch stores the same attempt outcome for each delivery in the batch and records
+// its metrics. Terminal outcomes notify the callback URL.
+func (w *WebhookBatchWorker) recordBatch(ctx context.Context, webhook *webhooks.WebhookRegistration, batch []batchEntry, terminal bool, attempt *webhooks.DeliveryAttempt) {
+	for _, entry := range batch {
+		if err := w.delivery.webhookRepo.RecordDeliveryAttempt(ctx, entry.delivery.ID, attempt); err != nil {
+			logger.NewLogger("webhook-batch-worker").Error("Failed to record batched delivery attempt",
+				"delivery_id", entry.delivery.ID,
+				"error", err,
+			)
+		}
+
+		args := w.deliveryArgs(ctx, webhook, entry.delivery, entry.event)
+		if terminal {
+			w.delivery.notifyCallback(ctx, args, attempt.Status, attempt.ResponseCode)
+		}
+		w.delivery.recordDelivery(ctx, args, attempt.Status, attempt.ResponseCode, attempt.Duration)
+	}
+}
+
+// deliveryArgs describes a batched delivery for callbacks and metrics. The event is
+// loaded when not given; its name is left empty if that fails.
+func (w *WebhookBatchWorker) deliveryArgs(ctx context.Context, webhook *webhooks.WebhookRegistration, d *webhooks.WebhookDelivery, event *webhooks.EventRecord) jobs.WebhookArgs {
+	args := jobs.WebhookArgs{
+		DeliveryID: d.ID,
+		WebhookID:  webhook.ID,
+		EventID:    d.EventID,
+		Namespace:  webhook.Namespace,
+	}
+	if event == nil && w.delivery.callbackURL != "" {
+		event, _ = w.delivery.webhookRepo.GetEvent(ctx, d.EventID)
+	}
+	if event != nil {
+		args.Event = event.Event
+	}
+	return args
+}
+
+// schedule enqueues a batch job for the webhook's deliveries waiting at flushAt. A failure
+// is only logged: the deliveries stay waiting and the webhook's next batch picks them up.
+func (w *WebhookBatchWorker) schedule(ctx context.Context, webhook *webhooks.WebhookRegistration, flushAt time.Time) {
+	args, opts := batchJob(webhook, flushAt)
+	if _, err := w.delivery.riverClient.Insert(ctx, args, opts); err != nil {
+		logger.NewLogger("webhook-batch-worker").Error("Failed to schedule webhook batch",
+			"webhook_id", webhook.ID,
+			"flush_at", args.FlushAt,
+			"error", err,
+		)
+	}
+}
@@ -0,0 +1,58 @@
+package workers
+
+import (
+	"context"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+	"github.com/sarathsp06/sparrow/internal/observability"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
+)
+
+// WebhookExpiryWorker deactivates webhooks registered with an expiry once it has passed
+type WebhookExpiryWorker struct {
+	river.WorkerDefaults[jobs.ExpireWebhooksArgs]
+	webhookRepo webhooks.WebhookStore
+	metrics     *observability.SparrowMetrics
+}
+
+// NewWebhookExpiryWorker creates a new webhook expiry worker
+func NewWebhookExpiryWorker(webhookRepo webhooks.WebhookStore) *WebhookExpiryWorker {
+	metrics, err := observability.NewSparrowMetrics()
+	if err != nil {
+		// Log error but continue without metrics
+		log := logger.NewLogger("webhook-expiry-worker")
+		log.Error("Failed to initialize metrics", "error", err)
+	}
+
+	return &WebhookExpiryWorker{
+		webhookRepo: webhookRepo,
+		metrics:     metrics,
+	}
+}
+
+// Work marks expired webhooks inactive. Deliveries already scheduled for them still run.
+func (w *WebhookExpiryWorker) Work(ctx context.Context, job *river.Job[jobs.ExpireWebhooksArgs]) error {
+	log := logger.NewLogger("webhook-expiry-worker")
+
+	deactivated, err := w.webhookRepo.DeactivateExpiredWebhooks(ctx, time.Now())
+	if err != nil {
+		log.Error("Failed to deactivate expired webhooks", "error", err)
+		return err
+	}
+
+	if deactivated > 0 {
+		if w.metrics != nil {
+			w.metrics.ActiveWebhooks.Add(ctx, -deactivated)
+		}
+		log.Info("Deactivated expired webhooks",
+			"job_id", job.ID,
+			"deactivated", deactivated,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/riverqueue/river"
+
+	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/logger"
+)
+
+// webhookDisabledStatus is the callback status sent when a webhook is disabled for failing
+const webhookDisabledStatus = "webhook_disabled"
+
+// trackHealth counts an attempt that reached a webhook's receiver, or failed to, towards the
+// webhook's health, and disables the webhook once its failure rate exceeds the configured
+// threshold. Errors are logged and never affect the delivery itself.
+func (w *WebhookWorker) trackHealth(ctx context.Context, webhookID, namespace string, success bool) {
+	log := logger.NewLogger("webhook-worker")
+
+	now := time.Now()
+	health, err := w.webhookRepo.RecordWebhookHealth(ctx, webhookID, success, now, w.healthWindow)
+	if err != nil {
+		log.Warn("Failed to record webhook health", "webhook_id", webhookID, "error", err)
+		return
+	}
+	if success || w.autoDisableFailureRate <= 0 {
+		return
+	}
+
+	attempts, successRate := health.Score(now, w.healthWindow)
+	failureRate := 1 - successRate
+	if attempts < float64(w.autoDisableMinAttempts) || failureRate <= w.autoDisableFailureRate {
+		return
+	}
+
+	disabled, err := w.webhookRepo.DisableUnhealthyWebhook(ctx, webhookID, now)
+	if err != nil {
+		log.Error("Failed to disable unhealthy webhook", "webhook_id", webhookID, "error", err)
+		return
+	}
+	if !disabled {
+		return
+	}
+
+	log.Warn("Disabled unhealthy webhook",
+		"webhook_id", webhookID,
+		"namespace", namespace,
+		"failure_rate", failureRate,
+		"attempts", attempts,
+		"window", w.healthWindow,
+	)
+	if w.metrics != nil {
+		w.metrics.ActiveWebhooks.Add(ctx, -1)
+	}
+
+	if w.callbackURL == "" || w.riverClient == nil {
+		return
+	}
+	_, err = w.riverClient.Insert(ctx, jobs.DeliveryCallbackArgs{
+		URL:       w.callbackURL,
+		WebhookID: webhookID,
+		Namespace: namespace,
+		Status:    webhookDisabledStatus,
+		Error: fmt.Sprintf("%.0f%% of %.0f attempts in the last %s failed, above the %.0f%% threshold",
+			failureRate*100, attempts, w.healthWindow, w.autoDisableFailureRate*100),
+	}, &river.InsertOpts{MaxAttempts: deliveryCallbackMaxAttempts})
+	if err != nil {
+		log.Warn("Failed to enqueue webhook disabled callback", "webhook_id", webhookID, "error", err)
+	}
+}
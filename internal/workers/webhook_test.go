@@ -1,9 +1,23 @@
 package workers
 
 import (
+	"compress/gzip"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
+	"testing/iotest"
+	"time"
 
+	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/rivertype"
+
+	"github.com/sarathsp06/sparrow/internal/config"
 	"github.com/sarathsp06/sparrow/internal/jobs"
+	"github.com/sarathsp06/sparrow/internal/webhooks"
 )
 
 func TestWebhookWorkerDefaults(t *testing.T) {
@@ -31,3 +45,529 @@ func TestWebhookWorkerDefaults(t *testing.T) {
 		t.Errorf("Expected URL to be 'https://example.com', got '%s'", args.URL)
 	}
 }
+
+func TestReadResponseBody(t *testing.T) {
+	body, truncated, err := readResponseBody(strings.NewReader("short"), 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if truncated || body != "short" {
+		t.Errorf("Expected untruncated 'short', got '%s' (truncated=%v)", body, truncated)
+	}
+
+	body, truncated, err = readResponseBody(strings.NewReader("0123456789abcdef"), 10)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !truncated || body != "0123456789"+truncatedBodyMarker {
+		t.Errorf("Expected truncated body, got '%s' (truncated=%v)", body, truncated)
+	}
+
+	// Exactly at the limit is not truncated
+	body, truncated, _ = readResponseBody(strings.NewReader("0123456789"), 10)
+	if truncated || body != "0123456789" {
+		t.Errorf("Expected body at limit to be untruncated, got '%s' (truncated=%v)", body, truncated)
+	}
+
+	// A body cut off partway keeps what was read
+	slow := io.MultiReader(strings.NewReader("partial"), iotest.ErrReader(context.DeadlineExceeded))
+	body, truncated, err = readResponseBody(slow, 10)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Expected deadline error, got %v", err)
+	}
+	if !truncated || body != "partial"+truncatedBodyMarker {
+		t.Errorf("Expected partial truncated body, got '%s' (truncated=%v)", body, truncated)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"empty", "", 0, false},
+		{"seconds", "120", 2 * time.Minute, true},
+		{"negative seconds", "-5", 0, false},
+		{"http date", "Mon, 01 Jan 2024 12:00:30 GMT", 30 * time.Second, true},
+		{"http date in the past", "Mon, 01 Jan 2024 11:00:00 GMT", 0, true},
+		{"garbage", "soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value, now)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("parseRetryAfter(%q) = %v, %v; want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestHTTPStatusClass(t *testing.T) {
+	tests := map[int]string{
+		0:   "error",
+		200: "2xx",
+		204: "2xx",
+		302: "3xx",
+		429: "4xx",
+		503: "5xx",
+		999: "error",
+	}
+
+	for code, want := range tests {
+		if got := httpStatusClass(code); got != want {
+			t.Errorf("httpStatusClass(%d) = %q, want %q", code, got, want)
+		}
+	}
+}
+
+// fakeDeliverer records the attempts it is given and answers with a fixed status
+type fakeDeliverer struct {
+	statusCode int
+	targets    []string
+	payloads   []string
+	headers    []http.Header
+}
+
+func (d *fakeDeliverer) Deliver(ctx context.Context, target, payload string, headers http.Header) (Result, error) {
+	d.targets = append(d.targets, target)
+	d.payloads = append(d.payloads, payload)
+	d.headers = append(d.headers, headers)
+	return Result{
+		StatusCode: d.statusCode,
+		Status:     http.StatusText(d.statusCode),
+		Header:     make(http.Header),
+		Body:       io.NopCloser(strings.NewReader("")),
+	}, nil
+}
+
+func TestTargetDeliverers(t *testing.T) {
+	httpTargets := &fakeDeliverer{statusCode: http.StatusOK}
+	sqsTargets := &fakeDeliverer{statusCode: http.StatusOK}
+	deliverers := targetDeliverers{
+		webhooks.TargetHTTP: httpTargets,
+		webhooks.TargetSQS:  sqsTargets,
+	}
+
+	for _, target := range []string{"https://example.com/hook", "sqs://orders", "http://localhost:8080"} {
+		if _, err := deliverers.Deliver(context.Background(), target, "{}", nil); err != nil {
+			t.Fatalf("Deliver(%q) error = %v", target, err)
+		}
+	}
+	if len(httpTargets.targets) != 2 || len(sqsTargets.targets) != 1 || sqsTargets.targets[0] != "sqs://orders" {
+		t.Errorf("Unexpected routing: http=%v sqs=%v", httpTargets.targets, sqsTargets.targets)
+	}
+
+	// Without SQS configured, SQS targets fail instead of being sent over HTTP
+	delete(deliverers, webhooks.TargetSQS)
+	if _, err := deliverers.Deliver(context.Background(), "sqs://orders", "{}", nil); err == nil {
+		t.Error("Expected an error for an unconfigured target type")
+	}
+}
+
+func TestDeliveryHeaders(t *testing.T) {
+	fake := &fakeDeliverer{statusCode: http.StatusOK}
+
+	args := jobs.WebhookArgs{
+		Headers:                 map[string]string{"X-Custom": "yes"},
+		DisableTracePropagation: true,
+	}
+	fake.Deliver(context.Background(), "https://example.com", "{}", deliveryHeaders(context.Background(), args, "production", "Bearer token"))
+
+	got := fake.headers[0]
+	if got.Get("Content-Type") != webhooks.DefaultContentType {
+		t.Errorf("Content-Type = %q, want default %q", got.Get("Content-Type"), webhooks.DefaultContentType)
+	}
+	if got.Get("X-Custom") != "yes" || got.Get("Authorization") != "Bearer token" {
+		t.Errorf("Unexpected headers: %v", got)
+	}
+	if env := got.Get(webhooks.EnvironmentHeader); env != "production" {
+		t.Errorf("%s = %q, want production", webhooks.EnvironmentHeader, env)
+	}
+
+	// A webhook's own environment header overrides the configured one
+	args.Headers["x-sparrow-environment"] = "staging"
+	if env := deliveryHeaders(context.Background(), args, "production", "").Get(webhooks.EnvironmentHeader); env != "staging" {
+		t.Errorf("%s = %q, want staging", webhooks.EnvironmentHeader, env)
+	}
+	delete(args.Headers, "x-sparrow-environment")
+
+	// The webhook's content type and an explicit Content-Type header override the default
+	args.ContentType = "text/plain"
+	if ct := deliveryHeaders(context.Background(), args, "", "").Get("Content-Type"); ct != "text/plain" {
+		t.Errorf("Content-Type = %q, want text/plain", ct)
+	}
+	args.Headers["Content-Type"] = "application/xml"
+	if ct := deliveryHeaders(context.Background(), args, "", "").Get("Content-Type"); ct != "application/xml" {
+		t.Errorf("Content-Type = %q, want application/xml", ct)
+	}
+}
+
+func TestHTTPDeliverer(t *testing.T) {
+	var gotUserAgent, gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotUserAgent = r.Header.Get("User-Agent")
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer server.Close()
+
+	deliverer := NewHTTPDeliverer(server.Client(), "Sparrow/test")
+
+	result, err := deliverer.Deliver(context.Background(), server.URL, `{"ok":true}`, make(http.Header))
+	if err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	result.Body.Close()
+	if result.StatusCode != http.StatusAccepted || gotUserAgent != "Sparrow/test" || gotBody != `{"ok":true}` {
+		t.Errorf("Got status %d, User-Agent %q, body %q", result.StatusCode, gotUserAgent, gotBody)
+	}
+
+	// A User-Agent in the delivery's headers takes precedence
+	headers := make(http.Header)
+	headers.Set("User-Agent", "custom")
+	result, err = deliverer.Deliver(context.Background(), server.URL, "{}", headers)
+	if err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	result.Body.Close()
+	if gotUserAgent != "custom" {
+		t.Errorf("User-Agent = %q, want custom", gotUserAgent)
+	}
+
+	// A redirect that keeps the method resends the same body
+	redirect := httptest.NewServer(http.RedirectHandler(server.URL, http.StatusTemporaryRedirect))
+	defer redirect.Close()
+	result, err = deliverer.Deliver(context.Background(), redirect.URL, `{"redirected":true}`, make(http.Header))
+	if err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	result.Body.Close()
+	if result.StatusCode != http.StatusAccepted || gotBody != `{"redirected":true}` {
+		t.Errorf("Got status %d, body %q after a redirect", result.StatusCode, gotBody)
+	}
+}
+
+func TestCheckRedirect(t *testing.T) {
+	request := func(raw string) *http.Request {
+		req, err := http.NewRequest(http.MethodPost, raw, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+	via := []*http.Request{request("https://example.com/hook")}
+
+	tests := []struct {
+		policy, target string
+		follow         bool
+	}{
+		{config.RedirectNone, "https://example.com/other", false},
+		{"", "https://example.com/other", false},
+		{config.RedirectSameHost, "https://example.com/other", true},
+		{config.RedirectSameHost, "http://example.com/other", false},
+		{config.RedirectSameHost, "https://evil.example.net/hook", false},
+		{config.RedirectAll, "https://evil.example.net/hook", true},
+	}
+	for _, tt := range tests {
+		err := checkRedirect(tt.policy, nil)(request(tt.target), via)
+		if tt.follow && err != nil {
+			t.Errorf("%q to %s: got %v, want followed", tt.policy, tt.target, err)
+		}
+		if !tt.follow && err != http.ErrUseLastResponse {
+			t.Errorf("%q to %s: got %v, want ErrUseLastResponse", tt.policy, tt.target, err)
+		}
+	}
+
+	// Followed redirects are still limited
+	for len(via) < maxRedirects {
+		via = append(via, request("https://example.com/hook"))
+	}
+	if err := checkRedirect(config.RedirectAll, nil)(request("https://example.com/other"), via); err == nil {
+		t.Error("expected an error after too many redirects")
+	}
+
+	// Followed redirects must stay on allowed ports
+	err := checkRedirect(config.RedirectAll, []int{443})(request("https://example.com:8443/other"), via[:1])
+	if !errors.Is(err, webhooks.ErrPortNotAllowed) {
+		t.Errorf("redirect to a disallowed port: got %v, want ErrPortNotAllowed", err)
+	}
+}
+
+func TestDeliveryProxy(t *testing.T) {
+	request := func(ctx context.Context) *http.Request {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://example.com/hook", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return req
+	}
+
+	proxy := deliveryProxy("http://proxy.internal:3128")
+	got, err := proxy(request(context.Background()))
+	if err != nil || got == nil || got.Host != "proxy.internal:3128" {
+		t.Errorf("got %v, %v; want the configured proxy", got, err)
+	}
+
+	// Webhooks with bypass_proxy connect directly
+	bypass := withDelivery(context.Background(), jobs.WebhookArgs{BypassProxy: true})
+	if got, err := proxy(request(bypass)); err != nil || got != nil {
+		t.Errorf("got %v, %v; want no proxy", got, err)
+	}
+}
+
+func TestGzipBody(t *testing.T) {
+	payload := strings.Repeat(`{"key":"value"}`, 100)
+
+	compressed, err := gzipBody(payload)
+	if err != nil {
+		t.Fatalf("gzipBody() error = %v", err)
+	}
+	if len(compressed) >= len(payload) {
+		t.Errorf("Expected compressed body to be smaller, got %d >= %d bytes", len(compressed), len(payload))
+	}
+
+	zr, err := gzip.NewReader(strings.NewReader(compressed))
+	if err != nil {
+		t.Fatalf("gzip.NewReader() error = %v", err)
+	}
+	decompressed, err := io.ReadAll(zr)
+	if err != nil || string(decompressed) != payload {
+		t.Errorf("Round trip failed: %v", err)
+	}
+}
+
+func TestViolatesSLO(t *testing.T) {
+	w := &WebhookWorker{deliverySLO: 500 * time.Millisecond}
+	tests := []struct {
+		duration time.Duration
+		want     bool
+	}{
+		{0, false},
+		{500 * time.Millisecond, false},
+		{501 * time.Millisecond, true},
+		{3 * time.Second, true},
+	}
+	for _, tt := range tests {
+		if got := w.violatesSLO(tt.duration); got != tt.want {
+			t.Errorf("violatesSLO(%v) = %v, want %v", tt.duration, got, tt.want)
+		}
+	}
+
+	// Without an SLO nothing violates it
+	if (&WebhookWorker{}).violatesSLO(time.Hour) {
+		t.Error("expected no violation without an SLO")
+	}
+}
+
+func TestRetryUnlessFinal(t *testing.T) {
+	failure := errors.New("boom")
+	job := &river.Job[jobs.WebhookArgs]{JobRow: &rivertype.JobRow{Attempt: 1, MaxAttempts: 3}}
+
+	if err := retryUnlessFinal(job, false, failure); err != failure {
+		t.Errorf("Expected the error to be returned for a retry, got %v", err)
+	}
+
+	// The delivery's last attempt cancels the job even though River has attempts left
+	var cancel *river.JobCancelError
+	if err := retryUnlessFinal(job, true, failure); !errors.As(err, &cancel) {
+		t.Errorf("Expected a JobCancel error, got %v", err)
+	}
+
+	// On River's own last attempt the error is returned as is
+	job.Attempt = 3
+	if err := retryUnlessFinal(job, true, failure); err != failure {
+		t.Errorf("Expected the error on River's final attempt, got %v", err)
+	}
+}
+
+func TestRequestTimeout(t *testing.T) {
+	w := &WebhookWorker{maxDeliveryTimeout: 2 * time.Minute}
+
+	tests := map[int]time.Duration{
+		30:  30 * time.Second,
+		120: 2 * time.Minute,
+		600: 2 * time.Minute,
+		0:   2 * time.Minute,
+	}
+	for seconds, want := range tests {
+		if got := w.requestTimeout(seconds); got != want {
+			t.Errorf("requestTimeout(%d) = %v, want %v", seconds, got, want)
+		}
+	}
+}
+
+func TestLimitBodyRead(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte("partial"))
+		w.(http.Flusher).Flush()
+		<-release
+	}))
+	defer server.Close()
+	defer close(release)
+
+	w := &WebhookWorker{bodyReadTimeout: 50 * time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	result, err := NewHTTPDeliverer(server.Client(), "").Deliver(ctx, server.URL, "{}", make(http.Header))
+	if err != nil {
+		t.Fatalf("Deliver() error = %v", err)
+	}
+	defer result.Body.Close()
+	defer w.limitBodyRead(cancel)()
+
+	start := time.Now()
+	body, truncated, err := readResponseBody(result.Body, 1024)
+	if err == nil || !truncated || body != "partial"+truncatedBodyMarker {
+		t.Errorf("Got body %q, truncated %v, error %v; want the partial body cut off", body, truncated, err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Reading the body took %v, want it stopped by the body read timeout", elapsed)
+	}
+
+	// Without a body read timeout the timer is a no-op
+	if (&WebhookWorker{}).limitBodyRead(func() { t.Error("cancel called") })() {
+		t.Error("Expected no timer to stop")
+	}
+}
+
+// panickingDeliverer panics on every attempt, like a bug in a delivery path would
+type panickingDeliverer struct{}
+
+func (panickingDeliverer) Deliver(ctx context.Context, target, payload string, headers http.Header) (Result, error) {
+	var headerValues map[string]string
+	headerValues["boom"] = target
+	return Result{}, nil
+}
+
+func TestWebhookWorkerRecoversPanics(t *testing.T) {
+	ctx := context.Background()
+	store := webhooks.NewMemoryStore()
+	webhook := &webhooks.WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com/hook", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreEvent(ctx, &webhooks.EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 60}); err != nil {
+		t.Fatal(err)
+	}
+	delivery := &webhooks.WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, ExpiresAt: time.Now().Add(time.Minute)}
+	if _, err := store.CreateDelivery(ctx, delivery); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{MaxDeliveryTimeout: 30 * time.Second, WebhookHealthWindow: time.Hour}
+	worker := NewWebhookWorker(store, nil, cfg, panickingDeliverer{})
+	job := &river.Job[jobs.WebhookArgs]{
+		JobRow: &rivertype.JobRow{ID: 1, Attempt: 1, MaxAttempts: 3},
+		Args: jobs.WebhookArgs{
+			DeliveryID: delivery.ID,
+			WebhookID:  webhook.ID,
+			EventID:    "evt-1",
+			URL:        webhook.URL,
+			Payload:    "{}",
+			ExpiresAt:  delivery.ExpiresAt,
+			Namespace:  "orders",
+			Event:      "order.created",
+		},
+	}
+
+	err := worker.Work(ctx, job)
+	if err == nil || !strings.Contains(err.Error(), "panicked") {
+		t.Fatalf("Work error = %v, want a panic error", err)
+	}
+	deliveries, _ := store.GetDeliveriesByWebhook(ctx, webhook.ID)
+	if len(deliveries) != 1 || deliveries[0].Status != webhooks.StatusRetrying || !strings.Contains(deliveries[0].ErrorMessage, "panicked") {
+		t.Errorf("delivery = %+v, want it retrying with the panic recorded", deliveries[0])
+	}
+}
+
+func TestWebhookWorkerRejectsDisallowedPorts(t *testing.T) {
+	ctx := context.Background()
+	store := webhooks.NewMemoryStore()
+	webhook := &webhooks.WebhookRegistration{Namespace: "orders", Events: []string{"order.created"}, URL: "https://example.com:8443/hook", Active: true}
+	if err := store.RegisterWebhook(ctx, webhook); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.StoreEvent(ctx, &webhooks.EventRecord{ID: "evt-1", Namespace: "orders", Event: "order.created", TTL: 60}); err != nil {
+		t.Fatal(err)
+	}
+	delivery := &webhooks.WebhookDelivery{WebhookID: webhook.ID, EventID: "evt-1", MaxAttempts: 3, ExpiresAt: time.Now().Add(time.Minute)}
+	if _, err := store.CreateDelivery(ctx, delivery); err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := &config.Config{MaxDeliveryTimeout: 30 * time.Second, WebhookHealthWindow: time.Hour, AllowedWebhookPorts: []int{80, 443}}
+	deliverer := &fakeDeliverer{statusCode: http.StatusOK}
+	worker := NewWebhookWorker(store, nil, cfg, deliverer)
+	job := &river.Job[jobs.WebhookArgs]{
+		JobRow: &rivertype.JobRow{ID: 1, Attempt: 1, MaxAttempts: 3},
+		Args: jobs.WebhookArgs{
+			DeliveryID: delivery.ID,
+			WebhookID:  webhook.ID,
+			EventID:    "evt-1",
+			URL:        webhook.URL,
+			Payload:    "{}",
+			ExpiresAt:  delivery.ExpiresAt,
+			Namespace:  "orders",
+			Event:      "order.created",
+		},
+	}
+
+	// The delivery fails for good without being sent
+	var cancel *river.JobCancelError
+	if err := worker.Work(ctx, job); !errors.As(err, &cancel) {
+		t.Fatalf("Work error = %v, want the job cancelled", err)
+	}
+	if len(deliverer.targets) != 0 {
+		t.Errorf("delivery was sent to %v", deliverer.targets)
+	}
+	deliveries, _ := store.GetDeliveriesByWebhook(ctx, webhook.ID)
+	if len(deliveries) != 1 || deliveries[0].Status != webhooks.StatusFailed || deliveries[0].FailureReason != webhooks.FailurePortNotAllowed {
+		t.Errorf("delivery = %+v, want it failed with port_not_allowed", deliveries[0])
+	}
+}
+
+func TestSimulatedDeliverer(t *testing.T) {
+	cfg := &config.Config{SimulateDeliveries: true, SimulatedLatencyMin: 5 * time.Millisecond, SimulatedLatencyMax: 10 * time.Millisecond}
+	deliverer, ok := NewDeliverer(cfg).(*simulatedDeliverer)
+	if !ok {
+		t.Fatal("NewDeliverer didn't simulate deliveries with SimulateDeliveries set")
+	}
+
+	for _, tt := range []struct {
+		failureRate float64
+		want        int
+	}{
+		{0, http.StatusOK},
+		{1, http.StatusInternalServerError},
+	} {
+		deliverer.failureRate = tt.failureRate
+		start := time.Now()
+		result, err := deliverer.Deliver(context.Background(), "https://example.com/hook", "{}", nil)
+		if err != nil {
+			t.Fatalf("Deliver: %v", err)
+		}
+		result.Body.Close()
+		if elapsed := time.Since(start); elapsed < cfg.SimulatedLatencyMin {
+			t.Errorf("Deliver took %v, want at least %v", elapsed, cfg.SimulatedLatencyMin)
+		}
+		if result.StatusCode != tt.want || result.Header.Get(simulatedHeader) != "true" {
+			t.Errorf("failure rate %g: result = %d %v, want %d marked as simulated", tt.failureRate, result.StatusCode, result.Header, tt.want)
+		}
+	}
+
+	// An attempt that times out fails like a real one
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if _, err := deliverer.Deliver(ctx, "https://example.com/hook", "{}", nil); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Deliver error = %v, want the deadline exceeded", err)
+	}
+}
@@ -11,64 +11,75 @@ import (
 	"syscall"
 	"time"
 
+	"connectrpc.com/connect"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 
+	"github.com/sarathsp06/sparrow/internal/auth"
+	"github.com/sarathsp06/sparrow/internal/config"
 	connectserver "github.com/sarathsp06/sparrow/internal/connect"
 	grpcserver "github.com/sarathsp06/sparrow/internal/grpc"
+	"github.com/sarathsp06/sparrow/internal/logger"
 	"github.com/sarathsp06/sparrow/internal/observability"
 	"github.com/sarathsp06/sparrow/internal/queue"
+	"github.com/sarathsp06/sparrow/internal/rest"
 	pb "github.com/sarathsp06/sparrow/proto"
+	"github.com/sarathsp06/sparrow/proto/protoconnect"
 )
 
 func main() {
 	ctx := context.Background()
 
-	// Configure OpenTelemetry
-	otelConfig := observability.DefaultConfig()
-
-	if env := os.Getenv("ENVIRONMENT"); env != "" {
-		otelConfig.Environment = env
+	// Load application configuration
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
 	}
-
-	if otlpEndpoint := os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"); otlpEndpoint != "" {
-		otelConfig.OTLPEndpoint = otlpEndpoint
+	if err := logger.Configure(cfg.LogLevel, cfg.LogFormat); err != nil {
+		log.Fatalf("Invalid logging configuration: %v", err)
+	}
+	if cfg.SimulateDeliveries {
+		log.Printf("⚠️  SIMULATE_DELIVERIES is enabled (environment: %s): no webhooks will be delivered, every attempt is simulated", cfg.Environment)
 	}
+	if cfg.DatabaseURL == config.DefaultDatabaseURL {
+		fmt.Println("🔧 Using default database URL. Set DATABASE_URL environment variable for custom connection.")
+	}
+
+	// Configure OpenTelemetry
+	otelConfig := observability.DefaultConfig()
+	otelConfig.Environment = cfg.Environment
+	otelConfig.OTLPEndpoint = cfg.OTLPEndpoint
+	otelConfig.OTLPHeaders = cfg.OTLPHeaders
+	otelConfig.OTLPInsecure = cfg.OTLPInsecure
+	otelConfig.SampleRate = cfg.TraceSampleRate
+	otelConfig.NamespaceSampleRates = cfg.NamespaceTraceSampleRates
+	otelConfig.EnableTracing = cfg.TracingEnabled
+	otelConfig.EnableMetrics = cfg.MetricsEnabled
 
 	// Initialize OpenTelemetry
 	fmt.Println("🔭 Initializing OpenTelemetry...")
 	otelShutdown, err := observability.Setup(ctx, otelConfig)
+	otelExporting := err == nil && (otelConfig.EnableTracing || otelConfig.EnableMetrics)
 	if err != nil {
 		log.Printf("⚠️  Failed to setup OpenTelemetry: %v", err)
 		fmt.Println("🚀 Continuing without OpenTelemetry...")
 	} else {
-		defer func() {
-			shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
-			defer cancel()
-			if err := otelShutdown(shutdownCtx); err != nil {
-				log.Printf("Failed to shutdown OpenTelemetry: %v", err)
-			}
-		}()
-		fmt.Printf("✅ OpenTelemetry initialized (endpoint: %s, env: %s)\n",
-			otelConfig.OTLPEndpoint, otelConfig.Environment)
-	}
-
-	// Database connection URL
-	databaseURL := os.Getenv("DATABASE_URL")
-	if databaseURL == "" {
-		databaseURL = "postgres://localhost/riverqueue?sslmode=disable"
-		fmt.Println("🔧 Using default database URL. Set DATABASE_URL environment variable for custom connection.")
+		fmt.Printf("✅ OpenTelemetry initialized (endpoint: %s, env: %s, tracing: %t, metrics: %t)\n",
+			otelConfig.OTLPEndpoint, otelConfig.Environment, otelConfig.EnableTracing, otelConfig.EnableMetrics)
 	}
 
 	// Initialize queue manager
-	queueManager, err := queue.NewManager(ctx, databaseURL)
+	queueManager, err := queue.NewManager(ctx, cfg)
 	if err != nil {
 		log.Fatalf("Failed to create queue manager: %v", err)
 	}
-	defer queueManager.Stop(ctx)
 
 	// Start the queue processing
 	if err := queueManager.Start(ctx); err != nil {
@@ -80,20 +91,84 @@ func main() {
 	// Get webhook repository from queue manager
 	webhookRepo := queueManager.GetWebhookRepo()
 
-	// Initialize gRPC server with OpenTelemetry instrumentation
-	grpcServer := grpc.NewServer(
+	// Load the TLS certificate, if configured; otherwise serve plaintext for local development
+	tlsConfig, err := cfg.TLSConfig()
+	if err != nil {
+		log.Fatalf("Failed to configure TLS: %v", err)
+	}
+
+	// Configure API key authentication
+	grpcOpts := []grpc.ServerOption{
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
-	)
-	webhookGRPCServer := grpcserver.NewWebhookServer(queueManager, webhookRepo)
+	}
+	if tlsConfig != nil {
+		grpcOpts = append(grpcOpts, grpc.Creds(credentials.NewTLS(tlsConfig.Clone())))
+		fmt.Println("🔒 TLS enabled")
+	}
+	var connectInterceptors []connect.Interceptor
+	var restInterceptors []grpc.UnaryServerInterceptor
+	if cfg.AuthEnabled {
+		if cfg.AdminAPIKey == "" {
+			log.Printf("⚠️  AUTH_ENABLED is set without ADMIN_API_KEY; API keys can't be managed")
+		}
+		authenticator := auth.NewAuthenticator(webhookRepo, cfg.AdminAPIKey,
+			protoconnect.WebhookServiceCreateAPIKeyProcedure,
+			protoconnect.WebhookServiceRevokeAPIKeyProcedure,
+			protoconnect.WebhookServiceCreateNamespaceProcedure,
+			protoconnect.WebhookServiceExtendDeliveryTTLProcedure,
+			protoconnect.WebhookServiceListAllWebhooksProcedure,
+		)
+		// Load balancers and tools like grpcurl probe these without credentials
+		authenticator.AllowUnauthenticated(
+			healthpb.Health_ServiceDesc.ServiceName,
+			"grpc.reflection.v1.ServerReflection",
+			"grpc.reflection.v1alpha.ServerReflection",
+		)
+		grpcOpts = append(grpcOpts,
+			grpc.ChainUnaryInterceptor(authenticator.UnaryServerInterceptor()),
+			grpc.ChainStreamInterceptor(authenticator.StreamServerInterceptor()),
+		)
+		connectInterceptors = append(connectInterceptors, authenticator.ConnectInterceptor())
+		restInterceptors = append(restInterceptors, authenticator.UnaryServerInterceptor())
+		fmt.Println("🔐 API key authentication enabled")
+	}
+
+	// Initialize gRPC server with OpenTelemetry instrumentation
+	grpcServer := grpc.NewServer(grpcOpts...)
+	webhookGRPCServer := grpcserver.NewWebhookServer(queueManager, webhookRepo, cfg.MaxDeliveryTimeout)
+	webhookGRPCServer.LimitWebhookPorts(cfg.AllowedWebhookPorts)
 	pb.RegisterWebhookServiceServer(grpcServer, webhookGRPCServer)
 
+	// Register the standard health service, backed by the same checks as /ready,
+	// and reflection so tools like grpcurl can introspect the server
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
+	reflection.Register(grpcServer)
+
+	healthCtx, stopHealthChecks := context.WithCancel(ctx)
+	defer stopHealthChecks()
+	go grpcserver.RunHealthChecks(healthCtx, healthServer, queueManager.Ready, 5*time.Second)
+
 	// Initialize Connect-RPC server
-	webhookConnectServer := connectserver.NewWebhookConnectServer(queueManager, webhookRepo)
-	connectPath, connectHandler := webhookConnectServer.Handler()
+	webhookConnectServer := connectserver.NewWebhookConnectServer(queueManager, webhookRepo, cfg.MaxDeliveryTimeout)
+	webhookConnectServer.LimitWebhookPorts(cfg.AllowedWebhookPorts)
+	connectPath, connectHandler := webhookConnectServer.Handler(connectInterceptors...)
 
 	// Create HTTP mux for Connect-RPC
 	mux := http.NewServeMux()
-	mux.Handle(connectPath, connectHandler)
+	// Browsers at CORS_ALLOWED_ORIGINS may call the API; preflight requests skip authentication
+	mux.Handle(connectPath, connectserver.CORS(connectserver.CORSConfig{
+		AllowedOrigins: cfg.CORSAllowedOrigins,
+		AllowedMethods: cfg.CORSAllowedMethods,
+		AllowedHeaders: cfg.CORSAllowedHeaders,
+	}, connectHandler))
+
+	// Serve the same API as JSON REST under /v1/, calling the gRPC server's methods
+	mux.Handle("/v1/", connectserver.CORS(connectserver.CORSConfig{
+		AllowedOrigins: cfg.CORSAllowedOrigins,
+		AllowedMethods: cfg.CORSAllowedMethods,
+		AllowedHeaders: cfg.CORSAllowedHeaders,
+	}, rest.NewGateway(webhookGRPCServer, restInterceptors...)))
 
 	// Add health check endpoint
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
@@ -102,27 +177,51 @@ func main() {
 		w.Write([]byte(`{"status":"healthy","version":"1.0.0"}`))
 	})
 
+	// Add readiness endpoint: the queue is running and the database is reachable
+	mux.HandleFunc("/ready", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if err := queueManager.Ready(r.Context()); err != nil {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			w.Write([]byte(`{"status":"not ready"}`))
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"status":"ready"}`))
+	})
+
+	// Plaintext HTTP/2 needs h2c; with TLS, HTTP/2 is negotiated during the handshake
+	var handler http.Handler = logger.HTTPMiddleware(mux)
+	if tlsConfig == nil {
+		handler = h2c.NewHandler(handler, &http2.Server{})
+	}
+
 	// Create HTTP server with OpenTelemetry instrumentation
 	httpServer := &http.Server{
-		Addr: ":8080",
-		Handler: otelhttp.NewHandler(
-			h2c.NewHandler(mux, &http2.Server{}),
-			"sparrow-connect",
-		),
+		Addr:         cfg.HTTPAddr,
+		Handler:      otelhttp.NewHandler(handler, "sparrow-connect"),
 		ReadTimeout:  30 * time.Second,
-		WriteTimeout: 30 * time.Second,
+		WriteTimeout: cfg.HTTPWriteTimeout,
 		IdleTimeout:  120 * time.Second,
 	}
+	if tlsConfig != nil {
+		httpServer.TLSConfig = tlsConfig.Clone()
+	}
 
 	// Start gRPC server
-	lis, err := net.Listen("tcp", ":50051")
+	lis, err := net.Listen("tcp", cfg.GRPCAddr)
+	if err != nil {
+		log.Fatalf("Failed to listen on %s: %v", cfg.GRPCAddr, err)
+	}
+	httpLis, err := net.Listen("tcp", cfg.HTTPAddr)
 	if err != nil {
-		log.Fatalf("Failed to listen on port 50051: %v", err)
+		log.Fatalf("Failed to listen on %s: %v", cfg.HTTPAddr, err)
 	}
 
+	// The listeners' addresses include the ports picked for :0
+	grpcAddr, httpAddr := lis.Addr().String(), httpLis.Addr().String()
 	fmt.Println("🌐 Starting servers...")
-	fmt.Println("   gRPC server: localhost:50051")
-	fmt.Println("   Connect-RPC (HTTP): localhost:8080")
+	fmt.Printf("   gRPC server: %s\n", grpcAddr)
+	fmt.Printf("   Connect-RPC (HTTP): %s\n", httpAddr)
 
 	// Start gRPC server in a goroutine
 	go func() {
@@ -133,7 +232,14 @@ func main() {
 
 	// Start HTTP server in a goroutine
 	go func() {
-		if err := httpServer.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+		var err error
+		if tlsConfig != nil {
+			// The certificate is already loaded into httpServer.TLSConfig
+			err = httpServer.ServeTLS(httpLis, "", "")
+		} else {
+			err = httpServer.Serve(httpLis)
+		}
+		if err != nil && err != http.ErrServerClosed {
 			log.Fatalf("Failed to serve HTTP: %v", err)
 		}
 	}()
@@ -143,10 +249,12 @@ func main() {
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 
 	fmt.Println("🎯 HTTP Queue Server is running...")
-	fmt.Println("   gRPC server: localhost:50051")
-	fmt.Println("   Connect-RPC (HTTP): localhost:8080")
-	fmt.Println("   Health check: http://localhost:8080/health")
-	if otelShutdown != nil {
+	fmt.Printf("   gRPC server: %s\n", grpcAddr)
+	fmt.Printf("   Connect-RPC (HTTP): %s\n", httpAddr)
+	fmt.Printf("   REST API: http://%s/v1/\n", httpAddr)
+	fmt.Printf("   Health check: http://%s/health\n", httpAddr)
+	fmt.Printf("   Readiness check: http://%s/ready\n", httpAddr)
+	if otelExporting {
 		fmt.Printf("   OTLP endpoint: %s\n", otelConfig.OTLPEndpoint)
 	}
 	fmt.Println("   Press Ctrl+C to stop...")
@@ -154,8 +262,9 @@ func main() {
 
 	fmt.Println("\n🛑 Shutting down...")
 
-	// Graceful shutdown
-	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	// Graceful shutdown: every step shares one budget. Telemetry is flushed last, so the spans
+	// and metrics of requests and deliveries still in flight are exported.
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
 	defer cancel()
 
 	// Shutdown HTTP server
@@ -163,8 +272,35 @@ func main() {
 		log.Printf("HTTP server shutdown error: %v", err)
 	}
 
-	// Shutdown gRPC server
-	grpcServer.GracefulStop()
-	queueManager.Stop(shutdownCtx)
+	// Shutdown gRPC server, telling health watchers first
+	stopHealthChecks()
+	healthServer.Shutdown()
+	gracefulStopGRPC(shutdownCtx, grpcServer)
+
+	// Let the workers finish the jobs they're running
+	if err := queueManager.Stop(shutdownCtx); err != nil {
+		log.Printf("Queue shutdown error: %v", err)
+	}
+
+	if err := otelShutdown(shutdownCtx); err != nil {
+		log.Printf("Failed to shutdown OpenTelemetry: %v", err)
+	}
 	fmt.Println("👋 Shutdown complete")
 }
+
+// gracefulStopGRPC waits for the server's in-flight RPCs to finish, closing their connections
+// once ctx is done
+func gracefulStopGRPC(ctx context.Context, server *grpc.Server) {
+	stopped := make(chan struct{})
+	go func() {
+		server.GracefulStop()
+		close(stopped)
+	}()
+
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		server.Stop()
+		<-stopped
+	}
+}
@@ -0,0 +1,215 @@
+// Package client is a Connect-RPC client for Sparrow's webhook service that retries calls
+// failing with a transient error.
+//
+// Unary calls that fail with Unavailable or DeadlineExceeded, including HTTP 502, 503 and 504
+// responses from a proxy in front of the server, are retried with exponential backoff until
+// they succeed, fail for another reason, run out of attempts, or their context ends. Each
+// attempt can be bounded on its own with Config.RequestTimeout. Streaming calls are not
+// retried.
+//
+// A retried call may have reached the server before failing, so a retried PushEvent can
+// push the event twice; set Config.MaxAttempts to 1 to disable retries.
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+
+	"github.com/sarathsp06/sparrow/proto/protoconnect"
+)
+
+// Retry defaults, used for zero Config fields
+const (
+	DefaultMaxAttempts    = 3
+	DefaultInitialBackoff = 100 * time.Millisecond
+	DefaultMaxBackoff     = 5 * time.Second
+)
+
+// Config configures a Client. The zero value is usable.
+type Config struct {
+	// HTTPClient sends the requests; nil = http.DefaultClient
+	HTTPClient *http.Client
+
+	// APIKey, when set, is sent as a bearer token with every call
+	APIKey string
+
+	// MaxAttempts is how many times a unary call is attempted in all; 0 = DefaultMaxAttempts
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry, doubling for each one after it up
+	// to MaxBackoff; each delay is jittered down by up to half. 0 = the defaults.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// RequestTimeout bounds each attempt; 0 = bounded only by the call's context
+	RequestTimeout time.Duration
+
+	// Options are passed on to the generated client, after the client's own interceptors
+	Options []connect.ClientOption
+}
+
+// Client calls the webhook service, retrying transient failures
+type Client struct {
+	protoconnect.WebhookServiceClient
+
+	baseURL    string
+	httpClient *http.Client
+	cfg        Config
+}
+
+// New creates a client for the server at baseURL, such as "http://localhost:8080"
+func New(baseURL string, cfg Config) *Client {
+	if cfg.HTTPClient == nil {
+		cfg.HTTPClient = http.DefaultClient
+	}
+	if cfg.MaxAttempts <= 0 {
+		cfg.MaxAttempts = DefaultMaxAttempts
+	}
+	if cfg.InitialBackoff <= 0 {
+		cfg.InitialBackoff = DefaultInitialBackoff
+	}
+	if cfg.MaxBackoff <= 0 {
+		cfg.MaxBackoff = DefaultMaxBackoff
+	}
+	baseURL = strings.TrimSuffix(baseURL, "/")
+
+	interceptors := []connect.Interceptor{retryInterceptor(cfg)}
+	if cfg.APIKey != "" {
+		interceptors = append(interceptors, apiKeyInterceptor{apiKey: cfg.APIKey})
+	}
+	options := append([]connect.ClientOption{connect.WithInterceptors(interceptors...)}, cfg.Options...)
+
+	return &Client{
+		WebhookServiceClient: protoconnect.NewWebhookServiceClient(cfg.HTTPClient, baseURL, options...),
+		baseURL:              baseURL,
+		httpClient:           cfg.HTTPClient,
+		cfg:                  cfg,
+	}
+}
+
+// Dial creates a client like New, then waits until the server reports itself ready on its
+// /ready endpoint, retrying with the client's backoff until ctx ends
+func Dial(ctx context.Context, baseURL string, cfg Config) (*Client, error) {
+	c := New(baseURL, cfg)
+	for attempt := 1; ; attempt++ {
+		err := c.checkReady(ctx)
+		if err == nil {
+			return c, nil
+		}
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("server at %s not ready: %w", c.baseURL, err)
+		case <-time.After(backoff(c.cfg, attempt)):
+		}
+	}
+}
+
+// checkReady makes one readiness check
+func (c *Client) checkReady(ctx context.Context) error {
+	if c.cfg.RequestTimeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.cfg.RequestTimeout)
+		defer cancel()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/ready", nil)
+	if err != nil {
+		return err
+	}
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("readiness check returned %s", resp.Status)
+	}
+	return nil
+}
+
+// backoff returns the delay before retrying after the given attempt: InitialBackoff doubled
+// for each earlier retry, capped at MaxBackoff, less up to half of it at random
+func backoff(cfg Config, attempt int) time.Duration {
+	delay := cfg.MaxBackoff
+	if shift := attempt - 1; shift < 32 && cfg.InitialBackoff<<shift < cfg.MaxBackoff {
+		delay = cfg.InitialBackoff << shift
+	}
+	return delay - rand.N(delay/2+1)
+}
+
+// Retryable reports whether a call that failed with err may succeed if retried
+func Retryable(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeUnavailable, connect.CodeDeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
+
+// retryInterceptor retries unary calls that fail with a retryable error, bounding each
+// attempt with the request timeout
+func retryInterceptor(cfg Config) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			for attempt := 1; ; attempt++ {
+				resp, err := attemptCall(ctx, next, req, cfg.RequestTimeout)
+				// A call whose own context ended isn't retried, even if it timed out
+				if err == nil || attempt >= cfg.MaxAttempts || !Retryable(err) || ctx.Err() != nil {
+					return resp, err
+				}
+				select {
+				case <-ctx.Done():
+					return nil, err
+				case <-time.After(backoff(cfg, attempt)):
+				}
+			}
+		}
+	}
+}
+
+// attemptCall makes one attempt of a call, bounded by timeout when it's set
+func attemptCall(ctx context.Context, next connect.UnaryFunc, req connect.AnyRequest, timeout time.Duration) (connect.AnyResponse, error) {
+	if timeout <= 0 {
+		return next(ctx, req)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	resp, err := next(ctx, req)
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) && connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+		// Report a timed out attempt as such, however the transport failed
+		return nil, connect.NewError(connect.CodeDeadlineExceeded, err)
+	}
+	return resp, err
+}
+
+// apiKeyInterceptor sends an API key as a bearer token with unary and streaming calls
+type apiKeyInterceptor struct {
+	apiKey string
+}
+
+func (i apiKeyInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		req.Header().Set("Authorization", "Bearer "+i.apiKey)
+		return next(ctx, req)
+	}
+}
+
+func (i apiKeyInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		conn.RequestHeader().Set("Authorization", "Bearer "+i.apiKey)
+		return conn
+	}
+}
+
+func (i apiKeyInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
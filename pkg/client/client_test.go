@@ -0,0 +1,217 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	pb "github.com/sarathsp06/sparrow/proto"
+	"github.com/sarathsp06/sparrow/proto/protoconnect"
+)
+
+// fakeService fails the first failures GetWebhook calls with code, sleeping delay in each call
+type fakeService struct {
+	protoconnect.UnimplementedWebhookServiceHandler
+
+	failures int32
+	code     connect.Code
+	delay    time.Duration
+	calls    atomic.Int32
+	auth     atomic.Value
+}
+
+func (s *fakeService) GetWebhook(ctx context.Context, req *connect.Request[pb.GetWebhookRequest]) (*connect.Response[pb.GetWebhookResponse], error) {
+	call := s.calls.Add(1)
+	s.auth.Store(req.Header().Get("Authorization"))
+	if s.delay > 0 {
+		select {
+		case <-ctx.Done():
+			return nil, connect.NewError(connect.CodeDeadlineExceeded, ctx.Err())
+		case <-time.After(s.delay):
+		}
+	}
+	if call <= s.failures {
+		return nil, connect.NewError(s.code, nil)
+	}
+	return connect.NewResponse(&pb.GetWebhookResponse{Success: true}), nil
+}
+
+func newTestClient(t *testing.T, handler http.Handler, cfg Config) *Client {
+	t.Helper()
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	cfg.HTTPClient = srv.Client()
+	cfg.InitialBackoff = time.Millisecond
+	cfg.MaxBackoff = 5 * time.Millisecond
+	return New(srv.URL+"/", cfg)
+}
+
+func serve(svc *fakeService) http.Handler {
+	mux := http.NewServeMux()
+	mux.Handle(protoconnect.NewWebhookServiceHandler(svc))
+	return mux
+}
+
+func getWebhook(c *Client) error {
+	_, err := c.GetWebhook(context.Background(), connect.NewRequest(&pb.GetWebhookRequest{WebhookId: "wh"}))
+	return err
+}
+
+func TestClientRetries(t *testing.T) {
+	tests := []struct {
+		name        string
+		failures    int32
+		code        connect.Code
+		maxAttempts int
+		wantCode    connect.Code
+		wantCalls   int32
+	}{
+		{"unavailable then success", 2, connect.CodeUnavailable, 3, 0, 3},
+		{"deadline exceeded then success", 1, connect.CodeDeadlineExceeded, 0, 0, 2},
+		{"out of attempts", 5, connect.CodeUnavailable, 3, connect.CodeUnavailable, 3},
+		{"retries disabled", 1, connect.CodeUnavailable, 1, connect.CodeUnavailable, 1},
+		{"not retryable", 1, connect.CodeNotFound, 3, connect.CodeNotFound, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc := &fakeService{failures: tt.failures, code: tt.code}
+			c := newTestClient(t, serve(svc), Config{MaxAttempts: tt.maxAttempts})
+
+			err := getWebhook(c)
+			if tt.wantCode == 0 && err != nil {
+				t.Fatalf("GetWebhook: %v", err)
+			}
+			if tt.wantCode != 0 && connect.CodeOf(err) != tt.wantCode {
+				t.Fatalf("GetWebhook error = %v, want code %v", err, tt.wantCode)
+			}
+			if got := svc.calls.Load(); got != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", got, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestClientRetriesUnavailableHTTPStatus(t *testing.T) {
+	svc := &fakeService{}
+	var rejected atomic.Int32
+	handler := serve(svc)
+	c := newTestClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A load balancer in front of a restarting server
+		if rejected.Add(1) == 1 {
+			http.Error(w, "no healthy upstream", http.StatusServiceUnavailable)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}), Config{})
+
+	if err := getWebhook(c); err != nil {
+		t.Fatalf("GetWebhook: %v", err)
+	}
+	if got := svc.calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+}
+
+func TestClientRequestTimeout(t *testing.T) {
+	svc := &fakeService{delay: time.Second}
+	c := newTestClient(t, serve(svc), Config{MaxAttempts: 2, RequestTimeout: 20 * time.Millisecond})
+
+	start := time.Now()
+	err := getWebhook(c)
+	if connect.CodeOf(err) != connect.CodeDeadlineExceeded {
+		t.Fatalf("GetWebhook error = %v, want deadline exceeded", err)
+	}
+	if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+		t.Errorf("GetWebhook took %v, want each attempt cut off by the request timeout", elapsed)
+	}
+	if got := svc.calls.Load(); got != 2 {
+		t.Errorf("calls = %d, want 2", got)
+	}
+}
+
+func TestClientStopsWhenContextEnds(t *testing.T) {
+	svc := &fakeService{delay: time.Second}
+	c := newTestClient(t, serve(svc), Config{MaxAttempts: 5})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	_, err := c.GetWebhook(ctx, connect.NewRequest(&pb.GetWebhookRequest{WebhookId: "wh"}))
+	if err == nil {
+		t.Fatal("GetWebhook succeeded, want an error")
+	}
+	if got := svc.calls.Load(); got != 1 {
+		t.Errorf("calls = %d, want 1 once the call's own context ended", got)
+	}
+}
+
+func TestClientSendsAPIKey(t *testing.T) {
+	svc := &fakeService{}
+	c := newTestClient(t, serve(svc), Config{APIKey: "spk_test"})
+
+	if err := getWebhook(c); err != nil {
+		t.Fatalf("GetWebhook: %v", err)
+	}
+	if got := svc.auth.Load(); got != "Bearer spk_test" {
+		t.Errorf("Authorization = %q, want %q", got, "Bearer spk_test")
+	}
+}
+
+func TestDial(t *testing.T) {
+	var checks atomic.Int32
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /ready", func(w http.ResponseWriter, r *http.Request) {
+		if checks.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	cfg := Config{HTTPClient: srv.Client(), InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if _, err := Dial(ctx, srv.URL, cfg); err != nil {
+		t.Fatalf("Dial: %v", err)
+	}
+	if got := checks.Load(); got != 3 {
+		t.Errorf("readiness checks = %d, want 3", got)
+	}
+}
+
+func TestDialGivesUpWhenContextEnds(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer srv.Close()
+
+	cfg := Config{HTTPClient: srv.Client(), InitialBackoff: time.Millisecond, MaxBackoff: 5 * time.Millisecond}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Millisecond)
+	defer cancel()
+	if _, err := Dial(ctx, srv.URL, cfg); err == nil {
+		t.Fatal("Dial succeeded against a server that never became ready")
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	cfg := Config{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+	for attempt, want := range map[int]time.Duration{
+		1:  100 * time.Millisecond,
+		2:  200 * time.Millisecond,
+		4:  800 * time.Millisecond,
+		5:  time.Second,
+		40: time.Second,
+	} {
+		for range 20 {
+			if got := backoff(cfg, attempt); got < want/2 || got > want {
+				t.Fatalf("backoff(attempt %d) = %v, want between %v and %v", attempt, got, want/2, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,111 @@
+// Package verify checks the HMAC signatures on Sparrow webhook deliveries, so receivers can
+// tell a delivery came from Sparrow and reject replays of old ones.
+//
+// A signed delivery carries two headers:
+//
+//	X-Sparrow-Timestamp: 1700000000
+//	X-Sparrow-Signature: v1=f4b51341b91fc7e9457a367ed5f470f3e001b274562c26a9bbcd42a8d3b2eb9b
+//
+// The timestamp is the Unix time in seconds the delivery was signed. Each v1 signature is the
+// lowercase hex HMAC-SHA256, keyed with the webhook's secret, of the timestamp, a '.', and the
+// raw request body. The signature header may hold several space- or comma-separated
+// signatures, for example while a secret is being rotated; a delivery is valid if any of them
+// matches. Unknown versions are ignored.
+package verify
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader carries a delivery's signatures
+	SignatureHeader = "X-Sparrow-Signature"
+
+	// TimestampHeader carries the Unix time a delivery was signed
+	TimestampHeader = "X-Sparrow-Timestamp"
+
+	// DefaultTolerance is how far a delivery's timestamp may be from the receiver's clock
+	// when VerifySignature is given no tolerance
+	DefaultTolerance = 5 * time.Minute
+
+	// signatureVersion prefixes the signatures produced by the current scheme
+	signatureVersion = "v1"
+)
+
+var (
+	// ErrMissingSignature means the delivery has no signature or timestamp header
+	ErrMissingSignature = errors.New("missing signature or timestamp")
+
+	// ErrInvalidTimestamp means the timestamp header is not a Unix time in seconds
+	ErrInvalidTimestamp = errors.New("invalid signature timestamp")
+
+	// ErrTimestampOutOfTolerance means the delivery was signed too long ago, or too far in
+	// the future, and may be a replay
+	ErrTimestampOutOfTolerance = errors.New("signature timestamp outside tolerance")
+
+	// ErrSignatureMismatch means no v1 signature matches the body and secret
+	ErrSignatureMismatch = errors.New("signature does not match")
+)
+
+// Sign returns the signature header value for a body signed with secret at timestamp, as
+// Sparrow sends it
+func Sign(secret string, body []byte, timestamp time.Time) string {
+	return signatureVersion + "=" + hex.EncodeToString(mac(secret, body, strconv.FormatInt(timestamp.Unix(), 10)))
+}
+
+// VerifySignature checks a delivery's signature and timestamp header values against its raw
+// body. It returns nil if one of the signatures was made with secret over the body, and the
+// timestamp is within tolerance of now; a tolerance of 0 uses DefaultTolerance.
+func VerifySignature(secret string, body []byte, signatureHeader, timestampHeader string, tolerance time.Duration) error {
+	return verifyAt(time.Now(), secret, body, signatureHeader, timestampHeader, tolerance)
+}
+
+// verifyAt is VerifySignature with the receiver's clock reading now
+func verifyAt(now time.Time, secret string, body []byte, signatureHeader, timestampHeader string, tolerance time.Duration) error {
+	if signatureHeader == "" || timestampHeader == "" {
+		return ErrMissingSignature
+	}
+
+	seconds, err := strconv.ParseInt(strings.TrimSpace(timestampHeader), 10, 64)
+	if err != nil {
+		return ErrInvalidTimestamp
+	}
+	if tolerance <= 0 {
+		tolerance = DefaultTolerance
+	}
+	if skew := now.Sub(time.Unix(seconds, 0)).Abs(); skew > tolerance {
+		return ErrTimestampOutOfTolerance
+	}
+
+	// Sign the timestamp exactly as received, so the check doesn't depend on its formatting
+	expected := mac(secret, body, strings.TrimSpace(timestampHeader))
+	for _, field := range strings.FieldsFunc(signatureHeader, func(r rune) bool { return r == ',' || r == ' ' }) {
+		version, value, ok := strings.Cut(field, "=")
+		if !ok || version != signatureVersion {
+			continue
+		}
+		signature, err := hex.DecodeString(value)
+		if err != nil {
+			continue
+		}
+		if hmac.Equal(signature, expected) {
+			return nil
+		}
+	}
+	return ErrSignatureMismatch
+}
+
+// mac computes the HMAC-SHA256 of "<timestamp>.<body>" keyed with secret
+func mac(secret string, body []byte, timestamp string) []byte {
+	h := hmac.New(sha256.New, []byte(secret))
+	h.Write([]byte(timestamp))
+	h.Write([]byte("."))
+	h.Write(body)
+	return h.Sum(nil)
+}
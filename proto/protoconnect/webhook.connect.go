@@ -40,15 +40,86 @@ const (
 	// WebhookServiceUnregisterWebhookProcedure is the fully-qualified name of the WebhookService's
 	// UnregisterWebhook RPC.
 	WebhookServiceUnregisterWebhookProcedure = "/webhook.WebhookService/UnregisterWebhook"
+	// WebhookServicePauseWebhookProcedure is the fully-qualified name of the WebhookService's
+	// PauseWebhook RPC.
+	WebhookServicePauseWebhookProcedure = "/webhook.WebhookService/PauseWebhook"
+	// WebhookServiceResumeWebhookProcedure is the fully-qualified name of the WebhookService's
+	// ResumeWebhook RPC.
+	WebhookServiceResumeWebhookProcedure = "/webhook.WebhookService/ResumeWebhook"
+	// WebhookServiceRotateSecretProcedure is the fully-qualified name of the WebhookService's
+	// RotateSecret RPC.
+	WebhookServiceRotateSecretProcedure = "/webhook.WebhookService/RotateSecret"
+	// WebhookServiceDeleteWebhooksProcedure is the fully-qualified name of the WebhookService's
+	// DeleteWebhooks RPC.
+	WebhookServiceDeleteWebhooksProcedure = "/webhook.WebhookService/DeleteWebhooks"
 	// WebhookServicePushEventProcedure is the fully-qualified name of the WebhookService's PushEvent
 	// RPC.
 	WebhookServicePushEventProcedure = "/webhook.WebhookService/PushEvent"
+	// WebhookServiceRegisterEventSchemaProcedure is the fully-qualified name of the WebhookService's
+	// RegisterEventSchema RPC.
+	WebhookServiceRegisterEventSchemaProcedure = "/webhook.WebhookService/RegisterEventSchema"
+	// WebhookServicePushEventsProcedure is the fully-qualified name of the WebhookService's PushEvents
+	// RPC.
+	WebhookServicePushEventsProcedure = "/webhook.WebhookService/PushEvents"
 	// WebhookServiceGetWebhookStatusProcedure is the fully-qualified name of the WebhookService's
 	// GetWebhookStatus RPC.
 	WebhookServiceGetWebhookStatusProcedure = "/webhook.WebhookService/GetWebhookStatus"
+	// WebhookServiceGetWebhookStatusBatchProcedure is the fully-qualified name of the WebhookService's
+	// GetWebhookStatusBatch RPC.
+	WebhookServiceGetWebhookStatusBatchProcedure = "/webhook.WebhookService/GetWebhookStatusBatch"
+	// WebhookServiceWatchWebhookStatusProcedure is the fully-qualified name of the WebhookService's
+	// WatchWebhookStatus RPC.
+	WebhookServiceWatchWebhookStatusProcedure = "/webhook.WebhookService/WatchWebhookStatus"
 	// WebhookServiceListWebhooksProcedure is the fully-qualified name of the WebhookService's
 	// ListWebhooks RPC.
 	WebhookServiceListWebhooksProcedure = "/webhook.WebhookService/ListWebhooks"
+	// WebhookServiceListEventsProcedure is the fully-qualified name of the WebhookService's ListEvents
+	// RPC.
+	WebhookServiceListEventsProcedure = "/webhook.WebhookService/ListEvents"
+	// WebhookServiceReplayEventsProcedure is the fully-qualified name of the WebhookService's
+	// ReplayEvents RPC.
+	WebhookServiceReplayEventsProcedure = "/webhook.WebhookService/ReplayEvents"
+	// WebhookServiceListEventFailuresProcedure is the fully-qualified name of the WebhookService's
+	// ListEventFailures RPC.
+	WebhookServiceListEventFailuresProcedure = "/webhook.WebhookService/ListEventFailures"
+	// WebhookServiceListDeliveriesProcedure is the fully-qualified name of the WebhookService's
+	// ListDeliveries RPC.
+	WebhookServiceListDeliveriesProcedure = "/webhook.WebhookService/ListDeliveries"
+	// WebhookServiceGetWebhookProcedure is the fully-qualified name of the WebhookService's GetWebhook
+	// RPC.
+	WebhookServiceGetWebhookProcedure = "/webhook.WebhookService/GetWebhook"
+	// WebhookServiceCreateAPIKeyProcedure is the fully-qualified name of the WebhookService's
+	// CreateAPIKey RPC.
+	WebhookServiceCreateAPIKeyProcedure = "/webhook.WebhookService/CreateAPIKey"
+	// WebhookServiceRevokeAPIKeyProcedure is the fully-qualified name of the WebhookService's
+	// RevokeAPIKey RPC.
+	WebhookServiceRevokeAPIKeyProcedure = "/webhook.WebhookService/RevokeAPIKey"
+	// WebhookServiceGetDeliveryStatsProcedure is the fully-qualified name of the WebhookService's
+	// GetDeliveryStats RPC.
+	WebhookServiceGetDeliveryStatsProcedure = "/webhook.WebhookService/GetDeliveryStats"
+	// WebhookServiceListEventTypesProcedure is the fully-qualified name of the WebhookService's
+	// ListEventTypes RPC.
+	WebhookServiceListEventTypesProcedure = "/webhook.WebhookService/ListEventTypes"
+	// WebhookServiceSetNamespaceDefaultsProcedure is the fully-qualified name of the WebhookService's
+	// SetNamespaceDefaults RPC.
+	WebhookServiceSetNamespaceDefaultsProcedure = "/webhook.WebhookService/SetNamespaceDefaults"
+	// WebhookServiceGetNamespaceDefaultsProcedure is the fully-qualified name of the WebhookService's
+	// GetNamespaceDefaults RPC.
+	WebhookServiceGetNamespaceDefaultsProcedure = "/webhook.WebhookService/GetNamespaceDefaults"
+	// WebhookServiceCreateNamespaceProcedure is the fully-qualified name of the WebhookService's
+	// CreateNamespace RPC.
+	WebhookServiceCreateNamespaceProcedure = "/webhook.WebhookService/CreateNamespace"
+	// WebhookServiceExtendDeliveryTTLProcedure is the fully-qualified name of the WebhookService's
+	// ExtendDeliveryTTL RPC.
+	WebhookServiceExtendDeliveryTTLProcedure = "/webhook.WebhookService/ExtendDeliveryTTL"
+	// WebhookServiceListAllWebhooksProcedure is the fully-qualified name of the WebhookService's
+	// ListAllWebhooks RPC.
+	WebhookServiceListAllWebhooksProcedure = "/webhook.WebhookService/ListAllWebhooks"
+	// WebhookServiceGetDeliveryProcedure is the fully-qualified name of the WebhookService's
+	// GetDelivery RPC.
+	WebhookServiceGetDeliveryProcedure = "/webhook.WebhookService/GetDelivery"
+	// WebhookServiceGetEventProcedure is the fully-qualified name of the WebhookService's GetEvent RPC.
+	WebhookServiceGetEventProcedure = "/webhook.WebhookService/GetEvent"
 )
 
 // WebhookServiceClient is a client for the webhook.WebhookService service.
@@ -57,12 +128,61 @@ type WebhookServiceClient interface {
 	RegisterWebhook(context.Context, *connect.Request[proto.RegisterWebhookRequest]) (*connect.Response[proto.RegisterWebhookResponse], error)
 	// UnregisterWebhook removes a webhook registration
 	UnregisterWebhook(context.Context, *connect.Request[proto.UnregisterWebhookRequest]) (*connect.Response[proto.UnregisterWebhookResponse], error)
+	// PauseWebhook stops scheduling deliveries to a webhook without removing it
+	PauseWebhook(context.Context, *connect.Request[proto.PauseWebhookRequest]) (*connect.Response[proto.PauseWebhookResponse], error)
+	// ResumeWebhook resumes scheduling deliveries to a paused webhook
+	ResumeWebhook(context.Context, *connect.Request[proto.ResumeWebhookRequest]) (*connect.Response[proto.ResumeWebhookResponse], error)
+	// RotateSecret replaces a signed webhook's signing secret; the old one keeps signing
+	// deliveries during a grace period
+	RotateSecret(context.Context, *connect.Request[proto.RotateSecretRequest]) (*connect.Response[proto.RotateSecretResponse], error)
+	// DeleteWebhooks removes every webhook in a namespace, optionally only those listening for an event
+	DeleteWebhooks(context.Context, *connect.Request[proto.DeleteWebhooksRequest]) (*connect.Response[proto.DeleteWebhooksResponse], error)
 	// PushEvent pushes an event that triggers registered webhooks
 	PushEvent(context.Context, *connect.Request[proto.PushEventRequest]) (*connect.Response[proto.PushEventResponse], error)
+	// RegisterEventSchema registers (or replaces) the JSON Schema that payloads of an event must match
+	RegisterEventSchema(context.Context, *connect.Request[proto.RegisterEventSchemaRequest]) (*connect.Response[proto.RegisterEventSchemaResponse], error)
+	// PushEvents pushes a batch of events in a single call
+	PushEvents(context.Context, *connect.Request[proto.PushEventsRequest]) (*connect.Response[proto.PushEventsResponse], error)
 	// GetWebhookStatus gets the status of webhook deliveries
 	GetWebhookStatus(context.Context, *connect.Request[proto.GetWebhookStatusRequest]) (*connect.Response[proto.GetWebhookStatusResponse], error)
+	// GetWebhookStatusBatch gets the status of the deliveries of several webhooks and events at once
+	GetWebhookStatusBatch(context.Context, *connect.Request[proto.GetWebhookStatusBatchRequest]) (*connect.Response[proto.GetWebhookStatusBatchResponse], error)
+	// WatchWebhookStatus streams deliveries for a webhook or event as their status changes
+	WatchWebhookStatus(context.Context, *connect.Request[proto.WatchWebhookStatusRequest]) (*connect.ServerStreamForClient[proto.WebhookDelivery], error)
 	// ListWebhooks lists all registered webhooks for a namespace
 	ListWebhooks(context.Context, *connect.Request[proto.ListWebhooksRequest]) (*connect.Response[proto.ListWebhooksResponse], error)
+	// ListEvents lists stored events for a namespace, newest first
+	ListEvents(context.Context, *connect.Request[proto.ListEventsRequest]) (*connect.Response[proto.ListEventsResponse], error)
+	// ReplayEvents delivers stored events again to the webhooks currently registered for them
+	ReplayEvents(context.Context, *connect.Request[proto.ReplayEventsRequest]) (*connect.Response[proto.ReplayEventsResponse], error)
+	// ListEventFailures lists events whose processing failed after exhausting its attempts, newest first
+	ListEventFailures(context.Context, *connect.Request[proto.ListEventFailuresRequest]) (*connect.Response[proto.ListEventFailuresResponse], error)
+	// ListDeliveries lists deliveries to a namespace's webhooks, newest first
+	ListDeliveries(context.Context, *connect.Request[proto.ListDeliveriesRequest]) (*connect.Response[proto.ListDeliveriesResponse], error)
+	// GetWebhook gets a single webhook registration by ID
+	GetWebhook(context.Context, *connect.Request[proto.GetWebhookRequest]) (*connect.Response[proto.GetWebhookResponse], error)
+	// CreateAPIKey creates an API key scoped to a set of namespaces (admin only)
+	CreateAPIKey(context.Context, *connect.Request[proto.CreateAPIKeyRequest]) (*connect.Response[proto.CreateAPIKeyResponse], error)
+	// RevokeAPIKey revokes an API key (admin only)
+	RevokeAPIKey(context.Context, *connect.Request[proto.RevokeAPIKeyRequest]) (*connect.Response[proto.RevokeAPIKeyResponse], error)
+	// GetDeliveryStats aggregates a namespace's deliveries over a time range
+	GetDeliveryStats(context.Context, *connect.Request[proto.GetDeliveryStatsRequest]) (*connect.Response[proto.GetDeliveryStatsResponse], error)
+	// ListEventTypes lists the event names a namespace uses, with counts and when each was last pushed
+	ListEventTypes(context.Context, *connect.Request[proto.ListEventTypesRequest]) (*connect.Response[proto.ListEventTypesResponse], error)
+	// SetNamespaceDefaults sets the headers and timeout a namespace's webhooks inherit
+	SetNamespaceDefaults(context.Context, *connect.Request[proto.SetNamespaceDefaultsRequest]) (*connect.Response[proto.SetNamespaceDefaultsResponse], error)
+	// GetNamespaceDefaults returns the headers and timeout a namespace's webhooks inherit
+	GetNamespaceDefaults(context.Context, *connect.Request[proto.GetNamespaceDefaultsRequest]) (*connect.Response[proto.GetNamespaceDefaultsResponse], error)
+	// CreateNamespace registers a namespace; required before use when STRICT_NAMESPACES is set (admin only)
+	CreateNamespace(context.Context, *connect.Request[proto.CreateNamespaceRequest]) (*connect.Response[proto.CreateNamespaceResponse], error)
+	// ExtendDeliveryTTL gives an expired delivery a new expiry and schedules it again (admin only)
+	ExtendDeliveryTTL(context.Context, *connect.Request[proto.ExtendDeliveryTTLRequest]) (*connect.Response[proto.ExtendDeliveryTTLResponse], error)
+	// ListAllWebhooks pages through webhooks across all namespaces (admin only)
+	ListAllWebhooks(context.Context, *connect.Request[proto.ListAllWebhooksRequest]) (*connect.Response[proto.ListAllWebhooksResponse], error)
+	// GetDelivery gets a single delivery by ID
+	GetDelivery(context.Context, *connect.Request[proto.GetDeliveryRequest]) (*connect.Response[proto.GetDeliveryResponse], error)
+	// GetEvent gets a single event by ID, with a summary of its deliveries
+	GetEvent(context.Context, *connect.Request[proto.GetEventRequest]) (*connect.Response[proto.GetEventResponse], error)
 }
 
 // NewWebhookServiceClient constructs a client for the webhook.WebhookService service. By default,
@@ -88,34 +208,202 @@ func NewWebhookServiceClient(httpClient connect.HTTPClient, baseURL string, opts
 			connect.WithSchema(webhookServiceMethods.ByName("UnregisterWebhook")),
 			connect.WithClientOptions(opts...),
 		),
+		pauseWebhook: connect.NewClient[proto.PauseWebhookRequest, proto.PauseWebhookResponse](
+			httpClient,
+			baseURL+WebhookServicePauseWebhookProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("PauseWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		resumeWebhook: connect.NewClient[proto.ResumeWebhookRequest, proto.ResumeWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceResumeWebhookProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ResumeWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		rotateSecret: connect.NewClient[proto.RotateSecretRequest, proto.RotateSecretResponse](
+			httpClient,
+			baseURL+WebhookServiceRotateSecretProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RotateSecret")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteWebhooks: connect.NewClient[proto.DeleteWebhooksRequest, proto.DeleteWebhooksResponse](
+			httpClient,
+			baseURL+WebhookServiceDeleteWebhooksProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
 		pushEvent: connect.NewClient[proto.PushEventRequest, proto.PushEventResponse](
 			httpClient,
 			baseURL+WebhookServicePushEventProcedure,
 			connect.WithSchema(webhookServiceMethods.ByName("PushEvent")),
 			connect.WithClientOptions(opts...),
 		),
+		registerEventSchema: connect.NewClient[proto.RegisterEventSchemaRequest, proto.RegisterEventSchemaResponse](
+			httpClient,
+			baseURL+WebhookServiceRegisterEventSchemaProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RegisterEventSchema")),
+			connect.WithClientOptions(opts...),
+		),
+		pushEvents: connect.NewClient[proto.PushEventsRequest, proto.PushEventsResponse](
+			httpClient,
+			baseURL+WebhookServicePushEventsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("PushEvents")),
+			connect.WithClientOptions(opts...),
+		),
 		getWebhookStatus: connect.NewClient[proto.GetWebhookStatusRequest, proto.GetWebhookStatusResponse](
 			httpClient,
 			baseURL+WebhookServiceGetWebhookStatusProcedure,
 			connect.WithSchema(webhookServiceMethods.ByName("GetWebhookStatus")),
 			connect.WithClientOptions(opts...),
 		),
+		getWebhookStatusBatch: connect.NewClient[proto.GetWebhookStatusBatchRequest, proto.GetWebhookStatusBatchResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookStatusBatchProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetWebhookStatusBatch")),
+			connect.WithClientOptions(opts...),
+		),
+		watchWebhookStatus: connect.NewClient[proto.WatchWebhookStatusRequest, proto.WebhookDelivery](
+			httpClient,
+			baseURL+WebhookServiceWatchWebhookStatusProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("WatchWebhookStatus")),
+			connect.WithClientOptions(opts...),
+		),
 		listWebhooks: connect.NewClient[proto.ListWebhooksRequest, proto.ListWebhooksResponse](
 			httpClient,
 			baseURL+WebhookServiceListWebhooksProcedure,
 			connect.WithSchema(webhookServiceMethods.ByName("ListWebhooks")),
 			connect.WithClientOptions(opts...),
 		),
+		listEvents: connect.NewClient[proto.ListEventsRequest, proto.ListEventsResponse](
+			httpClient,
+			baseURL+WebhookServiceListEventsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		replayEvents: connect.NewClient[proto.ReplayEventsRequest, proto.ReplayEventsResponse](
+			httpClient,
+			baseURL+WebhookServiceReplayEventsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ReplayEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		listEventFailures: connect.NewClient[proto.ListEventFailuresRequest, proto.ListEventFailuresResponse](
+			httpClient,
+			baseURL+WebhookServiceListEventFailuresProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListEventFailures")),
+			connect.WithClientOptions(opts...),
+		),
+		listDeliveries: connect.NewClient[proto.ListDeliveriesRequest, proto.ListDeliveriesResponse](
+			httpClient,
+			baseURL+WebhookServiceListDeliveriesProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListDeliveries")),
+			connect.WithClientOptions(opts...),
+		),
+		getWebhook: connect.NewClient[proto.GetWebhookRequest, proto.GetWebhookResponse](
+			httpClient,
+			baseURL+WebhookServiceGetWebhookProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetWebhook")),
+			connect.WithClientOptions(opts...),
+		),
+		createAPIKey: connect.NewClient[proto.CreateAPIKeyRequest, proto.CreateAPIKeyResponse](
+			httpClient,
+			baseURL+WebhookServiceCreateAPIKeyProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("CreateAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		revokeAPIKey: connect.NewClient[proto.RevokeAPIKeyRequest, proto.RevokeAPIKeyResponse](
+			httpClient,
+			baseURL+WebhookServiceRevokeAPIKeyProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("RevokeAPIKey")),
+			connect.WithClientOptions(opts...),
+		),
+		getDeliveryStats: connect.NewClient[proto.GetDeliveryStatsRequest, proto.GetDeliveryStatsResponse](
+			httpClient,
+			baseURL+WebhookServiceGetDeliveryStatsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetDeliveryStats")),
+			connect.WithClientOptions(opts...),
+		),
+		listEventTypes: connect.NewClient[proto.ListEventTypesRequest, proto.ListEventTypesResponse](
+			httpClient,
+			baseURL+WebhookServiceListEventTypesProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListEventTypes")),
+			connect.WithClientOptions(opts...),
+		),
+		setNamespaceDefaults: connect.NewClient[proto.SetNamespaceDefaultsRequest, proto.SetNamespaceDefaultsResponse](
+			httpClient,
+			baseURL+WebhookServiceSetNamespaceDefaultsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("SetNamespaceDefaults")),
+			connect.WithClientOptions(opts...),
+		),
+		getNamespaceDefaults: connect.NewClient[proto.GetNamespaceDefaultsRequest, proto.GetNamespaceDefaultsResponse](
+			httpClient,
+			baseURL+WebhookServiceGetNamespaceDefaultsProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetNamespaceDefaults")),
+			connect.WithClientOptions(opts...),
+		),
+		createNamespace: connect.NewClient[proto.CreateNamespaceRequest, proto.CreateNamespaceResponse](
+			httpClient,
+			baseURL+WebhookServiceCreateNamespaceProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("CreateNamespace")),
+			connect.WithClientOptions(opts...),
+		),
+		extendDeliveryTTL: connect.NewClient[proto.ExtendDeliveryTTLRequest, proto.ExtendDeliveryTTLResponse](
+			httpClient,
+			baseURL+WebhookServiceExtendDeliveryTTLProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ExtendDeliveryTTL")),
+			connect.WithClientOptions(opts...),
+		),
+		listAllWebhooks: connect.NewClient[proto.ListAllWebhooksRequest, proto.ListAllWebhooksResponse](
+			httpClient,
+			baseURL+WebhookServiceListAllWebhooksProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("ListAllWebhooks")),
+			connect.WithClientOptions(opts...),
+		),
+		getDelivery: connect.NewClient[proto.GetDeliveryRequest, proto.GetDeliveryResponse](
+			httpClient,
+			baseURL+WebhookServiceGetDeliveryProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetDelivery")),
+			connect.WithClientOptions(opts...),
+		),
+		getEvent: connect.NewClient[proto.GetEventRequest, proto.GetEventResponse](
+			httpClient,
+			baseURL+WebhookServiceGetEventProcedure,
+			connect.WithSchema(webhookServiceMethods.ByName("GetEvent")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // webhookServiceClient implements WebhookServiceClient.
 type webhookServiceClient struct {
-	registerWebhook   *connect.Client[proto.RegisterWebhookRequest, proto.RegisterWebhookResponse]
-	unregisterWebhook *connect.Client[proto.UnregisterWebhookRequest, proto.UnregisterWebhookResponse]
-	pushEvent         *connect.Client[proto.PushEventRequest, proto.PushEventResponse]
-	getWebhookStatus  *connect.Client[proto.GetWebhookStatusRequest, proto.GetWebhookStatusResponse]
-	listWebhooks      *connect.Client[proto.ListWebhooksRequest, proto.ListWebhooksResponse]
+	registerWebhook       *connect.Client[proto.RegisterWebhookRequest, proto.RegisterWebhookResponse]
+	unregisterWebhook     *connect.Client[proto.UnregisterWebhookRequest, proto.UnregisterWebhookResponse]
+	pauseWebhook          *connect.Client[proto.PauseWebhookRequest, proto.PauseWebhookResponse]
+	resumeWebhook         *connect.Client[proto.ResumeWebhookRequest, proto.ResumeWebhookResponse]
+	rotateSecret          *connect.Client[proto.RotateSecretRequest, proto.RotateSecretResponse]
+	deleteWebhooks        *connect.Client[proto.DeleteWebhooksRequest, proto.DeleteWebhooksResponse]
+	pushEvent             *connect.Client[proto.PushEventRequest, proto.PushEventResponse]
+	registerEventSchema   *connect.Client[proto.RegisterEventSchemaRequest, proto.RegisterEventSchemaResponse]
+	pushEvents            *connect.Client[proto.PushEventsRequest, proto.PushEventsResponse]
+	getWebhookStatus      *connect.Client[proto.GetWebhookStatusRequest, proto.GetWebhookStatusResponse]
+	getWebhookStatusBatch *connect.Client[proto.GetWebhookStatusBatchRequest, proto.GetWebhookStatusBatchResponse]
+	watchWebhookStatus    *connect.Client[proto.WatchWebhookStatusRequest, proto.WebhookDelivery]
+	listWebhooks          *connect.Client[proto.ListWebhooksRequest, proto.ListWebhooksResponse]
+	listEvents            *connect.Client[proto.ListEventsRequest, proto.ListEventsResponse]
+	replayEvents          *connect.Client[proto.ReplayEventsRequest, proto.ReplayEventsResponse]
+	listEventFailures     *connect.Client[proto.ListEventFailuresRequest, proto.ListEventFailuresResponse]
+	listDeliveries        *connect.Client[proto.ListDeliveriesRequest, proto.ListDeliveriesResponse]
+	getWebhook            *connect.Client[proto.GetWebhookRequest, proto.GetWebhookResponse]
+	createAPIKey          *connect.Client[proto.CreateAPIKeyRequest, proto.CreateAPIKeyResponse]
+	revokeAPIKey          *connect.Client[proto.RevokeAPIKeyRequest, proto.RevokeAPIKeyResponse]
+	getDeliveryStats      *connect.Client[proto.GetDeliveryStatsRequest, proto.GetDeliveryStatsResponse]
+	listEventTypes        *connect.Client[proto.ListEventTypesRequest, proto.ListEventTypesResponse]
+	setNamespaceDefaults  *connect.Client[proto.SetNamespaceDefaultsRequest, proto.SetNamespaceDefaultsResponse]
+	getNamespaceDefaults  *connect.Client[proto.GetNamespaceDefaultsRequest, proto.GetNamespaceDefaultsResponse]
+	createNamespace       *connect.Client[proto.CreateNamespaceRequest, proto.CreateNamespaceResponse]
+	extendDeliveryTTL     *connect.Client[proto.ExtendDeliveryTTLRequest, proto.ExtendDeliveryTTLResponse]
+	listAllWebhooks       *connect.Client[proto.ListAllWebhooksRequest, proto.ListAllWebhooksResponse]
+	getDelivery           *connect.Client[proto.GetDeliveryRequest, proto.GetDeliveryResponse]
+	getEvent              *connect.Client[proto.GetEventRequest, proto.GetEventResponse]
 }
 
 // RegisterWebhook calls webhook.WebhookService.RegisterWebhook.
@@ -128,33 +416,202 @@ func (c *webhookServiceClient) UnregisterWebhook(ctx context.Context, req *conne
 	return c.unregisterWebhook.CallUnary(ctx, req)
 }
 
+// PauseWebhook calls webhook.WebhookService.PauseWebhook.
+func (c *webhookServiceClient) PauseWebhook(ctx context.Context, req *connect.Request[proto.PauseWebhookRequest]) (*connect.Response[proto.PauseWebhookResponse], error) {
+	return c.pauseWebhook.CallUnary(ctx, req)
+}
+
+// ResumeWebhook calls webhook.WebhookService.ResumeWebhook.
+func (c *webhookServiceClient) ResumeWebhook(ctx context.Context, req *connect.Request[proto.ResumeWebhookRequest]) (*connect.Response[proto.ResumeWebhookResponse], error) {
+	return c.resumeWebhook.CallUnary(ctx, req)
+}
+
+// RotateSecret calls webhook.WebhookService.RotateSecret.
+func (c *webhookServiceClient) RotateSecret(ctx context.Context, req *connect.Request[proto.RotateSecretRequest]) (*connect.Response[proto.RotateSecretResponse], error) {
+	return c.rotateSecret.CallUnary(ctx, req)
+}
+
+// DeleteWebhooks calls webhook.WebhookService.DeleteWebhooks.
+func (c *webhookServiceClient) DeleteWebhooks(ctx context.Context, req *connect.Request[proto.DeleteWebhooksRequest]) (*connect.Response[proto.DeleteWebhooksResponse], error) {
+	return c.deleteWebhooks.CallUnary(ctx, req)
+}
+
 // PushEvent calls webhook.WebhookService.PushEvent.
 func (c *webhookServiceClient) PushEvent(ctx context.Context, req *connect.Request[proto.PushEventRequest]) (*connect.Response[proto.PushEventResponse], error) {
 	return c.pushEvent.CallUnary(ctx, req)
 }
 
+// RegisterEventSchema calls webhook.WebhookService.RegisterEventSchema.
+func (c *webhookServiceClient) RegisterEventSchema(ctx context.Context, req *connect.Request[proto.RegisterEventSchemaRequest]) (*connect.Response[proto.RegisterEventSchemaResponse], error) {
+	return c.registerEventSchema.CallUnary(ctx, req)
+}
+
+// PushEvents calls webhook.WebhookService.PushEvents.
+func (c *webhookServiceClient) PushEvents(ctx context.Context, req *connect.Request[proto.PushEventsRequest]) (*connect.Response[proto.PushEventsResponse], error) {
+	return c.pushEvents.CallUnary(ctx, req)
+}
+
 // GetWebhookStatus calls webhook.WebhookService.GetWebhookStatus.
 func (c *webhookServiceClient) GetWebhookStatus(ctx context.Context, req *connect.Request[proto.GetWebhookStatusRequest]) (*connect.Response[proto.GetWebhookStatusResponse], error) {
 	return c.getWebhookStatus.CallUnary(ctx, req)
 }
 
+// GetWebhookStatusBatch calls webhook.WebhookService.GetWebhookStatusBatch.
+func (c *webhookServiceClient) GetWebhookStatusBatch(ctx context.Context, req *connect.Request[proto.GetWebhookStatusBatchRequest]) (*connect.Response[proto.GetWebhookStatusBatchResponse], error) {
+	return c.getWebhookStatusBatch.CallUnary(ctx, req)
+}
+
+// WatchWebhookStatus calls webhook.WebhookService.WatchWebhookStatus.
+func (c *webhookServiceClient) WatchWebhookStatus(ctx context.Context, req *connect.Request[proto.WatchWebhookStatusRequest]) (*connect.ServerStreamForClient[proto.WebhookDelivery], error) {
+	return c.watchWebhookStatus.CallServerStream(ctx, req)
+}
+
 // ListWebhooks calls webhook.WebhookService.ListWebhooks.
 func (c *webhookServiceClient) ListWebhooks(ctx context.Context, req *connect.Request[proto.ListWebhooksRequest]) (*connect.Response[proto.ListWebhooksResponse], error) {
 	return c.listWebhooks.CallUnary(ctx, req)
 }
 
+// ListEvents calls webhook.WebhookService.ListEvents.
+func (c *webhookServiceClient) ListEvents(ctx context.Context, req *connect.Request[proto.ListEventsRequest]) (*connect.Response[proto.ListEventsResponse], error) {
+	return c.listEvents.CallUnary(ctx, req)
+}
+
+// ReplayEvents calls webhook.WebhookService.ReplayEvents.
+func (c *webhookServiceClient) ReplayEvents(ctx context.Context, req *connect.Request[proto.ReplayEventsRequest]) (*connect.Response[proto.ReplayEventsResponse], error) {
+	return c.replayEvents.CallUnary(ctx, req)
+}
+
+// ListEventFailures calls webhook.WebhookService.ListEventFailures.
+func (c *webhookServiceClient) ListEventFailures(ctx context.Context, req *connect.Request[proto.ListEventFailuresRequest]) (*connect.Response[proto.ListEventFailuresResponse], error) {
+	return c.listEventFailures.CallUnary(ctx, req)
+}
+
+// ListDeliveries calls webhook.WebhookService.ListDeliveries.
+func (c *webhookServiceClient) ListDeliveries(ctx context.Context, req *connect.Request[proto.ListDeliveriesRequest]) (*connect.Response[proto.ListDeliveriesResponse], error) {
+	return c.listDeliveries.CallUnary(ctx, req)
+}
+
+// GetWebhook calls webhook.WebhookService.GetWebhook.
+func (c *webhookServiceClient) GetWebhook(ctx context.Context, req *connect.Request[proto.GetWebhookRequest]) (*connect.Response[proto.GetWebhookResponse], error) {
+	return c.getWebhook.CallUnary(ctx, req)
+}
+
+// CreateAPIKey calls webhook.WebhookService.CreateAPIKey.
+func (c *webhookServiceClient) CreateAPIKey(ctx context.Context, req *connect.Request[proto.CreateAPIKeyRequest]) (*connect.Response[proto.CreateAPIKeyResponse], error) {
+	return c.createAPIKey.CallUnary(ctx, req)
+}
+
+// RevokeAPIKey calls webhook.WebhookService.RevokeAPIKey.
+func (c *webhookServiceClient) RevokeAPIKey(ctx context.Context, req *connect.Request[proto.RevokeAPIKeyRequest]) (*connect.Response[proto.RevokeAPIKeyResponse], error) {
+	return c.revokeAPIKey.CallUnary(ctx, req)
+}
+
+// GetDeliveryStats calls webhook.WebhookService.GetDeliveryStats.
+func (c *webhookServiceClient) GetDeliveryStats(ctx context.Context, req *connect.Request[proto.GetDeliveryStatsRequest]) (*connect.Response[proto.GetDeliveryStatsResponse], error) {
+	return c.getDeliveryStats.CallUnary(ctx, req)
+}
+
+// ListEventTypes calls webhook.WebhookService.ListEventTypes.
+func (c *webhookServiceClient) ListEventTypes(ctx context.Context, req *connect.Request[proto.ListEventTypesRequest]) (*connect.Response[proto.ListEventTypesResponse], error) {
+	return c.listEventTypes.CallUnary(ctx, req)
+}
+
+// SetNamespaceDefaults calls webhook.WebhookService.SetNamespaceDefaults.
+func (c *webhookServiceClient) SetNamespaceDefaults(ctx context.Context, req *connect.Request[proto.SetNamespaceDefaultsRequest]) (*connect.Response[proto.SetNamespaceDefaultsResponse], error) {
+	return c.setNamespaceDefaults.CallUnary(ctx, req)
+}
+
+// GetNamespaceDefaults calls webhook.WebhookService.GetNamespaceDefaults.
+func (c *webhookServiceClient) GetNamespaceDefaults(ctx context.Context, req *connect.Request[proto.GetNamespaceDefaultsRequest]) (*connect.Response[proto.GetNamespaceDefaultsResponse], error) {
+	return c.getNamespaceDefaults.CallUnary(ctx, req)
+}
+
+// CreateNamespace calls webhook.WebhookService.CreateNamespace.
+func (c *webhookServiceClient) CreateNamespace(ctx context.Context, req *connect.Request[proto.CreateNamespaceRequest]) (*connect.Response[proto.CreateNamespaceResponse], error) {
+	return c.createNamespace.CallUnary(ctx, req)
+}
+
+// ExtendDeliveryTTL calls webhook.WebhookService.ExtendDeliveryTTL.
+func (c *webhookServiceClient) ExtendDeliveryTTL(ctx context.Context, req *connect.Request[proto.ExtendDeliveryTTLRequest]) (*connect.Response[proto.ExtendDeliveryTTLResponse], error) {
+	return c.extendDeliveryTTL.CallUnary(ctx, req)
+}
+
+// ListAllWebhooks calls webhook.WebhookService.ListAllWebhooks.
+func (c *webhookServiceClient) ListAllWebhooks(ctx context.Context, req *connect.Request[proto.ListAllWebhooksRequest]) (*connect.Response[proto.ListAllWebhooksResponse], error) {
+	return c.listAllWebhooks.CallUnary(ctx, req)
+}
+
+// GetDelivery calls webhook.WebhookService.GetDelivery.
+func (c *webhookServiceClient) GetDelivery(ctx context.Context, req *connect.Request[proto.GetDeliveryRequest]) (*connect.Response[proto.GetDeliveryResponse], error) {
+	return c.getDelivery.CallUnary(ctx, req)
+}
+
+// GetEvent calls webhook.WebhookService.GetEvent.
+func (c *webhookServiceClient) GetEvent(ctx context.Context, req *connect.Request[proto.GetEventRequest]) (*connect.Response[proto.GetEventResponse], error) {
+	return c.getEvent.CallUnary(ctx, req)
+}
+
 // WebhookServiceHandler is an implementation of the webhook.WebhookService service.
 type WebhookServiceHandler interface {
 	// RegisterWebhook registers a URL for specific events in a namespace
 	RegisterWebhook(context.Context, *connect.Request[proto.RegisterWebhookRequest]) (*connect.Response[proto.RegisterWebhookResponse], error)
 	// UnregisterWebhook removes a webhook registration
 	UnregisterWebhook(context.Context, *connect.Request[proto.UnregisterWebhookRequest]) (*connect.Response[proto.UnregisterWebhookResponse], error)
+	// PauseWebhook stops scheduling deliveries to a webhook without removing it
+	PauseWebhook(context.Context, *connect.Request[proto.PauseWebhookRequest]) (*connect.Response[proto.PauseWebhookResponse], error)
+	// ResumeWebhook resumes scheduling deliveries to a paused webhook
+	ResumeWebhook(context.Context, *connect.Request[proto.ResumeWebhookRequest]) (*connect.Response[proto.ResumeWebhookResponse], error)
+	// RotateSecret replaces a signed webhook's signing secret; the old one keeps signing
+	// deliveries during a grace period
+	RotateSecret(context.Context, *connect.Request[proto.RotateSecretRequest]) (*connect.Response[proto.RotateSecretResponse], error)
+	// DeleteWebhooks removes every webhook in a namespace, optionally only those listening for an event
+	DeleteWebhooks(context.Context, *connect.Request[proto.DeleteWebhooksRequest]) (*connect.Response[proto.DeleteWebhooksResponse], error)
 	// PushEvent pushes an event that triggers registered webhooks
 	PushEvent(context.Context, *connect.Request[proto.PushEventRequest]) (*connect.Response[proto.PushEventResponse], error)
+	// RegisterEventSchema registers (or replaces) the JSON Schema that payloads of an event must match
+	RegisterEventSchema(context.Context, *connect.Request[proto.RegisterEventSchemaRequest]) (*connect.Response[proto.RegisterEventSchemaResponse], error)
+	// PushEvents pushes a batch of events in a single call
+	PushEvents(context.Context, *connect.Request[proto.PushEventsRequest]) (*connect.Response[proto.PushEventsResponse], error)
 	// GetWebhookStatus gets the status of webhook deliveries
 	GetWebhookStatus(context.Context, *connect.Request[proto.GetWebhookStatusRequest]) (*connect.Response[proto.GetWebhookStatusResponse], error)
+	// GetWebhookStatusBatch gets the status of the deliveries of several webhooks and events at once
+	GetWebhookStatusBatch(context.Context, *connect.Request[proto.GetWebhookStatusBatchRequest]) (*connect.Response[proto.GetWebhookStatusBatchResponse], error)
+	// WatchWebhookStatus streams deliveries for a webhook or event as their status changes
+	WatchWebhookStatus(context.Context, *connect.Request[proto.WatchWebhookStatusRequest], *connect.ServerStream[proto.WebhookDelivery]) error
 	// ListWebhooks lists all registered webhooks for a namespace
 	ListWebhooks(context.Context, *connect.Request[proto.ListWebhooksRequest]) (*connect.Response[proto.ListWebhooksResponse], error)
+	// ListEvents lists stored events for a namespace, newest first
+	ListEvents(context.Context, *connect.Request[proto.ListEventsRequest]) (*connect.Response[proto.ListEventsResponse], error)
+	// ReplayEvents delivers stored events again to the webhooks currently registered for them
+	ReplayEvents(context.Context, *connect.Request[proto.ReplayEventsRequest]) (*connect.Response[proto.ReplayEventsResponse], error)
+	// ListEventFailures lists events whose processing failed after exhausting its attempts, newest first
+	ListEventFailures(context.Context, *connect.Request[proto.ListEventFailuresRequest]) (*connect.Response[proto.ListEventFailuresResponse], error)
+	// ListDeliveries lists deliveries to a namespace's webhooks, newest first
+	ListDeliveries(context.Context, *connect.Request[proto.ListDeliveriesRequest]) (*connect.Response[proto.ListDeliveriesResponse], error)
+	// GetWebhook gets a single webhook registration by ID
+	GetWebhook(context.Context, *connect.Request[proto.GetWebhookRequest]) (*connect.Response[proto.GetWebhookResponse], error)
+	// CreateAPIKey creates an API key scoped to a set of namespaces (admin only)
+	CreateAPIKey(context.Context, *connect.Request[proto.CreateAPIKeyRequest]) (*connect.Response[proto.CreateAPIKeyResponse], error)
+	// RevokeAPIKey revokes an API key (admin only)
+	RevokeAPIKey(context.Context, *connect.Request[proto.RevokeAPIKeyRequest]) (*connect.Response[proto.RevokeAPIKeyResponse], error)
+	// GetDeliveryStats aggregates a namespace's deliveries over a time range
+	GetDeliveryStats(context.Context, *connect.Request[proto.GetDeliveryStatsRequest]) (*connect.Response[proto.GetDeliveryStatsResponse], error)
+	// ListEventTypes lists the event names a namespace uses, with counts and when each was last pushed
+	ListEventTypes(context.Context, *connect.Request[proto.ListEventTypesRequest]) (*connect.Response[proto.ListEventTypesResponse], error)
+	// SetNamespaceDefaults sets the headers and timeout a namespace's webhooks inherit
+	SetNamespaceDefaults(context.Context, *connect.Request[proto.SetNamespaceDefaultsRequest]) (*connect.Response[proto.SetNamespaceDefaultsResponse], error)
+	// GetNamespaceDefaults returns the headers and timeout a namespace's webhooks inherit
+	GetNamespaceDefaults(context.Context, *connect.Request[proto.GetNamespaceDefaultsRequest]) (*connect.Response[proto.GetNamespaceDefaultsResponse], error)
+	// CreateNamespace registers a namespace; required before use when STRICT_NAMESPACES is set (admin only)
+	CreateNamespace(context.Context, *connect.Request[proto.CreateNamespaceRequest]) (*connect.Response[proto.CreateNamespaceResponse], error)
+	// ExtendDeliveryTTL gives an expired delivery a new expiry and schedules it again (admin only)
+	ExtendDeliveryTTL(context.Context, *connect.Request[proto.ExtendDeliveryTTLRequest]) (*connect.Response[proto.ExtendDeliveryTTLResponse], error)
+	// ListAllWebhooks pages through webhooks across all namespaces (admin only)
+	ListAllWebhooks(context.Context, *connect.Request[proto.ListAllWebhooksRequest]) (*connect.Response[proto.ListAllWebhooksResponse], error)
+	// GetDelivery gets a single delivery by ID
+	GetDelivery(context.Context, *connect.Request[proto.GetDeliveryRequest]) (*connect.Response[proto.GetDeliveryResponse], error)
+	// GetEvent gets a single event by ID, with a summary of its deliveries
+	GetEvent(context.Context, *connect.Request[proto.GetEventRequest]) (*connect.Response[proto.GetEventResponse], error)
 }
 
 // NewWebhookServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -176,36 +633,228 @@ func NewWebhookServiceHandler(svc WebhookServiceHandler, opts ...connect.Handler
 		connect.WithSchema(webhookServiceMethods.ByName("UnregisterWebhook")),
 		connect.WithHandlerOptions(opts...),
 	)
+	webhookServicePauseWebhookHandler := connect.NewUnaryHandler(
+		WebhookServicePauseWebhookProcedure,
+		svc.PauseWebhook,
+		connect.WithSchema(webhookServiceMethods.ByName("PauseWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceResumeWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceResumeWebhookProcedure,
+		svc.ResumeWebhook,
+		connect.WithSchema(webhookServiceMethods.ByName("ResumeWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceRotateSecretHandler := connect.NewUnaryHandler(
+		WebhookServiceRotateSecretProcedure,
+		svc.RotateSecret,
+		connect.WithSchema(webhookServiceMethods.ByName("RotateSecret")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceDeleteWebhooksHandler := connect.NewUnaryHandler(
+		WebhookServiceDeleteWebhooksProcedure,
+		svc.DeleteWebhooks,
+		connect.WithSchema(webhookServiceMethods.ByName("DeleteWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
 	webhookServicePushEventHandler := connect.NewUnaryHandler(
 		WebhookServicePushEventProcedure,
 		svc.PushEvent,
 		connect.WithSchema(webhookServiceMethods.ByName("PushEvent")),
 		connect.WithHandlerOptions(opts...),
 	)
+	webhookServiceRegisterEventSchemaHandler := connect.NewUnaryHandler(
+		WebhookServiceRegisterEventSchemaProcedure,
+		svc.RegisterEventSchema,
+		connect.WithSchema(webhookServiceMethods.ByName("RegisterEventSchema")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServicePushEventsHandler := connect.NewUnaryHandler(
+		WebhookServicePushEventsProcedure,
+		svc.PushEvents,
+		connect.WithSchema(webhookServiceMethods.ByName("PushEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
 	webhookServiceGetWebhookStatusHandler := connect.NewUnaryHandler(
 		WebhookServiceGetWebhookStatusProcedure,
 		svc.GetWebhookStatus,
 		connect.WithSchema(webhookServiceMethods.ByName("GetWebhookStatus")),
 		connect.WithHandlerOptions(opts...),
 	)
+	webhookServiceGetWebhookStatusBatchHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookStatusBatchProcedure,
+		svc.GetWebhookStatusBatch,
+		connect.WithSchema(webhookServiceMethods.ByName("GetWebhookStatusBatch")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceWatchWebhookStatusHandler := connect.NewServerStreamHandler(
+		WebhookServiceWatchWebhookStatusProcedure,
+		svc.WatchWebhookStatus,
+		connect.WithSchema(webhookServiceMethods.ByName("WatchWebhookStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
 	webhookServiceListWebhooksHandler := connect.NewUnaryHandler(
 		WebhookServiceListWebhooksProcedure,
 		svc.ListWebhooks,
 		connect.WithSchema(webhookServiceMethods.ByName("ListWebhooks")),
 		connect.WithHandlerOptions(opts...),
 	)
+	webhookServiceListEventsHandler := connect.NewUnaryHandler(
+		WebhookServiceListEventsProcedure,
+		svc.ListEvents,
+		connect.WithSchema(webhookServiceMethods.ByName("ListEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceReplayEventsHandler := connect.NewUnaryHandler(
+		WebhookServiceReplayEventsProcedure,
+		svc.ReplayEvents,
+		connect.WithSchema(webhookServiceMethods.ByName("ReplayEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListEventFailuresHandler := connect.NewUnaryHandler(
+		WebhookServiceListEventFailuresProcedure,
+		svc.ListEventFailures,
+		connect.WithSchema(webhookServiceMethods.ByName("ListEventFailures")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListDeliveriesHandler := connect.NewUnaryHandler(
+		WebhookServiceListDeliveriesProcedure,
+		svc.ListDeliveries,
+		connect.WithSchema(webhookServiceMethods.ByName("ListDeliveries")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetWebhookHandler := connect.NewUnaryHandler(
+		WebhookServiceGetWebhookProcedure,
+		svc.GetWebhook,
+		connect.WithSchema(webhookServiceMethods.ByName("GetWebhook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceCreateAPIKeyHandler := connect.NewUnaryHandler(
+		WebhookServiceCreateAPIKeyProcedure,
+		svc.CreateAPIKey,
+		connect.WithSchema(webhookServiceMethods.ByName("CreateAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceRevokeAPIKeyHandler := connect.NewUnaryHandler(
+		WebhookServiceRevokeAPIKeyProcedure,
+		svc.RevokeAPIKey,
+		connect.WithSchema(webhookServiceMethods.ByName("RevokeAPIKey")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetDeliveryStatsHandler := connect.NewUnaryHandler(
+		WebhookServiceGetDeliveryStatsProcedure,
+		svc.GetDeliveryStats,
+		connect.WithSchema(webhookServiceMethods.ByName("GetDeliveryStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListEventTypesHandler := connect.NewUnaryHandler(
+		WebhookServiceListEventTypesProcedure,
+		svc.ListEventTypes,
+		connect.WithSchema(webhookServiceMethods.ByName("ListEventTypes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceSetNamespaceDefaultsHandler := connect.NewUnaryHandler(
+		WebhookServiceSetNamespaceDefaultsProcedure,
+		svc.SetNamespaceDefaults,
+		connect.WithSchema(webhookServiceMethods.ByName("SetNamespaceDefaults")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetNamespaceDefaultsHandler := connect.NewUnaryHandler(
+		WebhookServiceGetNamespaceDefaultsProcedure,
+		svc.GetNamespaceDefaults,
+		connect.WithSchema(webhookServiceMethods.ByName("GetNamespaceDefaults")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceCreateNamespaceHandler := connect.NewUnaryHandler(
+		WebhookServiceCreateNamespaceProcedure,
+		svc.CreateNamespace,
+		connect.WithSchema(webhookServiceMethods.ByName("CreateNamespace")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceExtendDeliveryTTLHandler := connect.NewUnaryHandler(
+		WebhookServiceExtendDeliveryTTLProcedure,
+		svc.ExtendDeliveryTTL,
+		connect.WithSchema(webhookServiceMethods.ByName("ExtendDeliveryTTL")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceListAllWebhooksHandler := connect.NewUnaryHandler(
+		WebhookServiceListAllWebhooksProcedure,
+		svc.ListAllWebhooks,
+		connect.WithSchema(webhookServiceMethods.ByName("ListAllWebhooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetDeliveryHandler := connect.NewUnaryHandler(
+		WebhookServiceGetDeliveryProcedure,
+		svc.GetDelivery,
+		connect.WithSchema(webhookServiceMethods.ByName("GetDelivery")),
+		connect.WithHandlerOptions(opts...),
+	)
+	webhookServiceGetEventHandler := connect.NewUnaryHandler(
+		WebhookServiceGetEventProcedure,
+		svc.GetEvent,
+		connect.WithSchema(webhookServiceMethods.ByName("GetEvent")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/webhook.WebhookService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case WebhookServiceRegisterWebhookProcedure:
 			webhookServiceRegisterWebhookHandler.ServeHTTP(w, r)
 		case WebhookServiceUnregisterWebhookProcedure:
 			webhookServiceUnregisterWebhookHandler.ServeHTTP(w, r)
+		case WebhookServicePauseWebhookProcedure:
+			webhookServicePauseWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceResumeWebhookProcedure:
+			webhookServiceResumeWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceRotateSecretProcedure:
+			webhookServiceRotateSecretHandler.ServeHTTP(w, r)
+		case WebhookServiceDeleteWebhooksProcedure:
+			webhookServiceDeleteWebhooksHandler.ServeHTTP(w, r)
 		case WebhookServicePushEventProcedure:
 			webhookServicePushEventHandler.ServeHTTP(w, r)
+		case WebhookServiceRegisterEventSchemaProcedure:
+			webhookServiceRegisterEventSchemaHandler.ServeHTTP(w, r)
+		case WebhookServicePushEventsProcedure:
+			webhookServicePushEventsHandler.ServeHTTP(w, r)
 		case WebhookServiceGetWebhookStatusProcedure:
 			webhookServiceGetWebhookStatusHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookStatusBatchProcedure:
+			webhookServiceGetWebhookStatusBatchHandler.ServeHTTP(w, r)
+		case WebhookServiceWatchWebhookStatusProcedure:
+			webhookServiceWatchWebhookStatusHandler.ServeHTTP(w, r)
 		case WebhookServiceListWebhooksProcedure:
 			webhookServiceListWebhooksHandler.ServeHTTP(w, r)
+		case WebhookServiceListEventsProcedure:
+			webhookServiceListEventsHandler.ServeHTTP(w, r)
+		case WebhookServiceReplayEventsProcedure:
+			webhookServiceReplayEventsHandler.ServeHTTP(w, r)
+		case WebhookServiceListEventFailuresProcedure:
+			webhookServiceListEventFailuresHandler.ServeHTTP(w, r)
+		case WebhookServiceListDeliveriesProcedure:
+			webhookServiceListDeliveriesHandler.ServeHTTP(w, r)
+		case WebhookServiceGetWebhookProcedure:
+			webhookServiceGetWebhookHandler.ServeHTTP(w, r)
+		case WebhookServiceCreateAPIKeyProcedure:
+			webhookServiceCreateAPIKeyHandler.ServeHTTP(w, r)
+		case WebhookServiceRevokeAPIKeyProcedure:
+			webhookServiceRevokeAPIKeyHandler.ServeHTTP(w, r)
+		case WebhookServiceGetDeliveryStatsProcedure:
+			webhookServiceGetDeliveryStatsHandler.ServeHTTP(w, r)
+		case WebhookServiceListEventTypesProcedure:
+			webhookServiceListEventTypesHandler.ServeHTTP(w, r)
+		case WebhookServiceSetNamespaceDefaultsProcedure:
+			webhookServiceSetNamespaceDefaultsHandler.ServeHTTP(w, r)
+		case WebhookServiceGetNamespaceDefaultsProcedure:
+			webhookServiceGetNamespaceDefaultsHandler.ServeHTTP(w, r)
+		case WebhookServiceCreateNamespaceProcedure:
+			webhookServiceCreateNamespaceHandler.ServeHTTP(w, r)
+		case WebhookServiceExtendDeliveryTTLProcedure:
+			webhookServiceExtendDeliveryTTLHandler.ServeHTTP(w, r)
+		case WebhookServiceListAllWebhooksProcedure:
+			webhookServiceListAllWebhooksHandler.ServeHTTP(w, r)
+		case WebhookServiceGetDeliveryProcedure:
+			webhookServiceGetDeliveryHandler.ServeHTTP(w, r)
+		case WebhookServiceGetEventProcedure:
+			webhookServiceGetEventHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -223,14 +872,110 @@ func (UnimplementedWebhookServiceHandler) UnregisterWebhook(context.Context, *co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.UnregisterWebhook is not implemented"))
 }
 
+func (UnimplementedWebhookServiceHandler) PauseWebhook(context.Context, *connect.Request[proto.PauseWebhookRequest]) (*connect.Response[proto.PauseWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.PauseWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ResumeWebhook(context.Context, *connect.Request[proto.ResumeWebhookRequest]) (*connect.Response[proto.ResumeWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ResumeWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) RotateSecret(context.Context, *connect.Request[proto.RotateSecretRequest]) (*connect.Response[proto.RotateSecretResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.RotateSecret is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) DeleteWebhooks(context.Context, *connect.Request[proto.DeleteWebhooksRequest]) (*connect.Response[proto.DeleteWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.DeleteWebhooks is not implemented"))
+}
+
 func (UnimplementedWebhookServiceHandler) PushEvent(context.Context, *connect.Request[proto.PushEventRequest]) (*connect.Response[proto.PushEventResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.PushEvent is not implemented"))
 }
 
+func (UnimplementedWebhookServiceHandler) RegisterEventSchema(context.Context, *connect.Request[proto.RegisterEventSchemaRequest]) (*connect.Response[proto.RegisterEventSchemaResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.RegisterEventSchema is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) PushEvents(context.Context, *connect.Request[proto.PushEventsRequest]) (*connect.Response[proto.PushEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.PushEvents is not implemented"))
+}
+
 func (UnimplementedWebhookServiceHandler) GetWebhookStatus(context.Context, *connect.Request[proto.GetWebhookStatusRequest]) (*connect.Response[proto.GetWebhookStatusResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.GetWebhookStatus is not implemented"))
 }
 
+func (UnimplementedWebhookServiceHandler) GetWebhookStatusBatch(context.Context, *connect.Request[proto.GetWebhookStatusBatchRequest]) (*connect.Response[proto.GetWebhookStatusBatchResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.GetWebhookStatusBatch is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) WatchWebhookStatus(context.Context, *connect.Request[proto.WatchWebhookStatusRequest], *connect.ServerStream[proto.WebhookDelivery]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.WatchWebhookStatus is not implemented"))
+}
+
 func (UnimplementedWebhookServiceHandler) ListWebhooks(context.Context, *connect.Request[proto.ListWebhooksRequest]) (*connect.Response[proto.ListWebhooksResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ListWebhooks is not implemented"))
 }
+
+func (UnimplementedWebhookServiceHandler) ListEvents(context.Context, *connect.Request[proto.ListEventsRequest]) (*connect.Response[proto.ListEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ListEvents is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ReplayEvents(context.Context, *connect.Request[proto.ReplayEventsRequest]) (*connect.Response[proto.ReplayEventsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ReplayEvents is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListEventFailures(context.Context, *connect.Request[proto.ListEventFailuresRequest]) (*connect.Response[proto.ListEventFailuresResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ListEventFailures is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListDeliveries(context.Context, *connect.Request[proto.ListDeliveriesRequest]) (*connect.Response[proto.ListDeliveriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ListDeliveries is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetWebhook(context.Context, *connect.Request[proto.GetWebhookRequest]) (*connect.Response[proto.GetWebhookResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.GetWebhook is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) CreateAPIKey(context.Context, *connect.Request[proto.CreateAPIKeyRequest]) (*connect.Response[proto.CreateAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.CreateAPIKey is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) RevokeAPIKey(context.Context, *connect.Request[proto.RevokeAPIKeyRequest]) (*connect.Response[proto.RevokeAPIKeyResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.RevokeAPIKey is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetDeliveryStats(context.Context, *connect.Request[proto.GetDeliveryStatsRequest]) (*connect.Response[proto.GetDeliveryStatsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.GetDeliveryStats is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListEventTypes(context.Context, *connect.Request[proto.ListEventTypesRequest]) (*connect.Response[proto.ListEventTypesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ListEventTypes is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) SetNamespaceDefaults(context.Context, *connect.Request[proto.SetNamespaceDefaultsRequest]) (*connect.Response[proto.SetNamespaceDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.SetNamespaceDefaults is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetNamespaceDefaults(context.Context, *connect.Request[proto.GetNamespaceDefaultsRequest]) (*connect.Response[proto.GetNamespaceDefaultsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.GetNamespaceDefaults is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) CreateNamespace(context.Context, *connect.Request[proto.CreateNamespaceRequest]) (*connect.Response[proto.CreateNamespaceResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.CreateNamespace is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ExtendDeliveryTTL(context.Context, *connect.Request[proto.ExtendDeliveryTTLRequest]) (*connect.Response[proto.ExtendDeliveryTTLResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ExtendDeliveryTTL is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) ListAllWebhooks(context.Context, *connect.Request[proto.ListAllWebhooksRequest]) (*connect.Response[proto.ListAllWebhooksResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.ListAllWebhooks is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetDelivery(context.Context, *connect.Request[proto.GetDeliveryRequest]) (*connect.Response[proto.GetDeliveryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.GetDelivery is not implemented"))
+}
+
+func (UnimplementedWebhookServiceHandler) GetEvent(context.Context, *connect.Request[proto.GetEventRequest]) (*connect.Response[proto.GetEventResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("webhook.WebhookService.GetEvent is not implemented"))
+}
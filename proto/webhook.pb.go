@@ -21,6 +21,130 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// WebhookAuthType selects how deliveries authenticate to the receiver
+type WebhookAuthType int32
+
+const (
+	WebhookAuthType_AUTH_NONE   WebhookAuthType = 0
+	WebhookAuthType_AUTH_BASIC  WebhookAuthType = 1
+	WebhookAuthType_AUTH_BEARER WebhookAuthType = 2
+)
+
+// Enum value maps for WebhookAuthType.
+var (
+	WebhookAuthType_name = map[int32]string{
+		0: "AUTH_NONE",
+		1: "AUTH_BASIC",
+		2: "AUTH_BEARER",
+	}
+	WebhookAuthType_value = map[string]int32{
+		"AUTH_NONE":   0,
+		"AUTH_BASIC":  1,
+		"AUTH_BEARER": 2,
+	}
+)
+
+func (x WebhookAuthType) Enum() *WebhookAuthType {
+	p := new(WebhookAuthType)
+	*p = x
+	return p
+}
+
+func (x WebhookAuthType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (WebhookAuthType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_webhook_proto_enumTypes[0].Descriptor()
+}
+
+func (WebhookAuthType) Type() protoreflect.EnumType {
+	return &file_proto_webhook_proto_enumTypes[0]
+}
+
+func (x WebhookAuthType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use WebhookAuthType.Descriptor instead.
+func (WebhookAuthType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{0}
+}
+
+// DeliveryFailureReason classifies why a delivery attempt failed
+type DeliveryFailureReason int32
+
+const (
+	DeliveryFailureReason_FAILURE_NONE                  DeliveryFailureReason = 0
+	DeliveryFailureReason_FAILURE_DNS_ERROR             DeliveryFailureReason = 1
+	DeliveryFailureReason_FAILURE_CONNECTION_REFUSED    DeliveryFailureReason = 2
+	DeliveryFailureReason_FAILURE_TLS_ERROR             DeliveryFailureReason = 3
+	DeliveryFailureReason_FAILURE_TIMEOUT               DeliveryFailureReason = 4
+	DeliveryFailureReason_FAILURE_HTTP_4XX              DeliveryFailureReason = 5
+	DeliveryFailureReason_FAILURE_HTTP_5XX              DeliveryFailureReason = 6
+	DeliveryFailureReason_FAILURE_BODY_MATCH_FAILED     DeliveryFailureReason = 7
+	DeliveryFailureReason_FAILURE_OTHER                 DeliveryFailureReason = 8
+	DeliveryFailureReason_FAILURE_HEADER_TEMPLATE_ERROR DeliveryFailureReason = 9  // A templated header or query parameter couldn't be rendered
+	DeliveryFailureReason_FAILURE_PORT_NOT_ALLOWED      DeliveryFailureReason = 10 // The URL's port isn't in the server's ALLOWED_WEBHOOK_PORTS
+)
+
+// Enum value maps for DeliveryFailureReason.
+var (
+	DeliveryFailureReason_name = map[int32]string{
+		0:  "FAILURE_NONE",
+		1:  "FAILURE_DNS_ERROR",
+		2:  "FAILURE_CONNECTION_REFUSED",
+		3:  "FAILURE_TLS_ERROR",
+		4:  "FAILURE_TIMEOUT",
+		5:  "FAILURE_HTTP_4XX",
+		6:  "FAILURE_HTTP_5XX",
+		7:  "FAILURE_BODY_MATCH_FAILED",
+		8:  "FAILURE_OTHER",
+		9:  "FAILURE_HEADER_TEMPLATE_ERROR",
+		10: "FAILURE_PORT_NOT_ALLOWED",
+	}
+	DeliveryFailureReason_value = map[string]int32{
+		"FAILURE_NONE":                  0,
+		"FAILURE_DNS_ERROR":             1,
+		"FAILURE_CONNECTION_REFUSED":    2,
+		"FAILURE_TLS_ERROR":             3,
+		"FAILURE_TIMEOUT":               4,
+		"FAILURE_HTTP_4XX":              5,
+		"FAILURE_HTTP_5XX":              6,
+		"FAILURE_BODY_MATCH_FAILED":     7,
+		"FAILURE_OTHER":                 8,
+		"FAILURE_HEADER_TEMPLATE_ERROR": 9,
+		"FAILURE_PORT_NOT_ALLOWED":      10,
+	}
+)
+
+func (x DeliveryFailureReason) Enum() *DeliveryFailureReason {
+	p := new(DeliveryFailureReason)
+	*p = x
+	return p
+}
+
+func (x DeliveryFailureReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DeliveryFailureReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_webhook_proto_enumTypes[1].Descriptor()
+}
+
+func (DeliveryFailureReason) Type() protoreflect.EnumType {
+	return &file_proto_webhook_proto_enumTypes[1]
+}
+
+func (x DeliveryFailureReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DeliveryFailureReason.Descriptor instead.
+func (DeliveryFailureReason) EnumDescriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{1}
+}
+
 // WebhookDeliveryStatus represents the status of webhook delivery
 type WebhookDeliveryStatus int32
 
@@ -67,11 +191,11 @@ func (x WebhookDeliveryStatus) String() string {
 }
 
 func (WebhookDeliveryStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_proto_webhook_proto_enumTypes[0].Descriptor()
+	return file_proto_webhook_proto_enumTypes[2].Descriptor()
 }
 
 func (WebhookDeliveryStatus) Type() protoreflect.EnumType {
-	return &file_proto_webhook_proto_enumTypes[0]
+	return &file_proto_webhook_proto_enumTypes[2]
 }
 
 func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
@@ -80,19 +204,77 @@ func (x WebhookDeliveryStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use WebhookDeliveryStatus.Descriptor instead.
 func (WebhookDeliveryStatus) EnumDescriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{0}
+	return file_proto_webhook_proto_rawDescGZIP(), []int{2}
 }
 
 // RegisterWebhookRequest represents a request to register a webhook URL
 type RegisterWebhookRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                       // Namespace for grouping webhooks
-	Events        []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`                                                                             // Event names to listen for (multiple events supported)
-	Url           string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                                                                                   // Target URL for the webhook
-	Headers       map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // HTTP headers to include in requests
-	Timeout       int32                  `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                          // Timeout in seconds (default: 30)
-	Active        bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                                                                            // Whether webhook is active (default: true)
-	Description   string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`                                                                   // Optional description
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Namespace               string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                       // Namespace for grouping webhooks
+	Events                  []string               `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`                                                                             // Event names to listen for (multiple events supported)
+	Url                     string                 `protobuf:"bytes,3,opt,name=url,proto3" json:"url,omitempty"`                                                                                   // Target URL for the webhook: http(s)://... or sqs://<queue-name>
+	Headers                 map[string]string      `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // HTTP headers to include in requests
+	Timeout                 int32                  `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                          // Timeout in seconds (default: 30)
+	Active                  bool                   `protobuf:"varint,6,opt,name=active,proto3" json:"active,omitempty"`                                                                            // Whether webhook is active (default: true)
+	Description             string                 `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`                                                                   // Optional description
+	MaxAttempts             int32                  `protobuf:"varint,8,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                               // Maximum delivery attempts, 1-25 (default: 3)
+	ContentType             string                 `protobuf:"bytes,9,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`                                                // Default Content-Type for deliveries (default: application/json)
+	MaxStoredResponseBytes  int32                  `protobuf:"varint,10,opt,name=max_stored_response_bytes,json=maxStoredResponseBytes,proto3" json:"max_stored_response_bytes,omitempty"`         // Response body bytes stored per delivery, up to 65536 (default: server setting)
+	DisableTracePropagation bool                   `protobuf:"varint,11,opt,name=disable_trace_propagation,json=disableTracePropagation,proto3" json:"disable_trace_propagation,omitempty"`        // Don't send traceparent/baggage headers to this webhook
+	// Deliver events strictly in the order they were scheduled. Each delivery waits until
+	// all earlier deliveries to this webhook succeed or give up, so a failing event holds
+	// back later ones and throughput is limited to one in-flight request.
+	Ordered bool `protobuf:"varint,12,opt,name=ordered,proto3" json:"ordered,omitempty"`
+	// JSON paths (e.g. "user.email", "items.0.id") to deliver; when set, only these fields are sent.
+	// Paths missing from an event's payload are omitted. Requires JSON payloads.
+	IncludeFields []string `protobuf:"bytes,13,rep,name=include_fields,json=includeFields,proto3" json:"include_fields,omitempty"`
+	// JSON paths removed from the payload before delivery, applied after include_fields.
+	// Paths missing from an event's payload are ignored.
+	ExcludeFields []string `protobuf:"bytes,14,rep,name=exclude_fields,json=excludeFields,proto3" json:"exclude_fields,omitempty"`
+	// Delivery queue priority, 1 (most urgent) to 4 (least); 0 = default (2). When an event
+	// also sets a priority, the more urgent of the two is used.
+	Priority int32 `protobuf:"varint,15,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Backup URLs (at most 5) tried in order, within the same attempt, when the primary URL
+	// can't be reached or responds with a 5xx.
+	FallbackUrls []string `protobuf:"bytes,16,rep,name=fallback_urls,json=fallbackUrls,proto3" json:"fallback_urls,omitempty"`
+	// Optional rule a 2xx response body must satisfy; otherwise the delivery fails and is retried
+	SuccessBodyMatcher *SuccessBodyMatcher `protobuf:"bytes,17,opt,name=success_body_matcher,json=successBodyMatcher,proto3" json:"success_body_matcher,omitempty"`
+	// Credentials for the Authorization header sent with deliveries. Prefer this to putting
+	// secrets in headers: credentials are stored separately and never returned.
+	Auth *WebhookAuth `protobuf:"bytes,18,opt,name=auth,proto3" json:"auth,omitempty"`
+	// Maximum deliveries to this webhook in flight at once, across all workers; 0 = unlimited.
+	// Deliveries over the limit wait without holding a worker.
+	MaxInFlight int32 `protobuf:"varint,19,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`
+	// Unix time after which the webhook stops receiving events and is marked inactive; 0 = never.
+	// Must be in the future.
+	ExpiresAt int64 `protobuf:"varint,20,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Gzip request bodies larger than the server's threshold and send Content-Encoding: gzip.
+	// Only applies to http(s) targets.
+	CompressBody bool `protobuf:"varint,21,opt,name=compress_body,json=compressBody,proto3" json:"compress_body,omitempty"`
+	// Collect deliveries for this many milliseconds and send them as one application/x-ndjson
+	// request, one line per delivery; 0 = deliver each event on its own. At most 60000.
+	// Only applies to http(s) targets without fallback_urls or ordered delivery.
+	BatchWindowMs int32 `protobuf:"varint,22,opt,name=batch_window_ms,json=batchWindowMs,proto3" json:"batch_window_ms,omitempty"`
+	// Maximum deliveries per batch; 0 = server default (100). At most 1000.
+	BatchMaxSize int32 `protobuf:"varint,23,opt,name=batch_max_size,json=batchMaxSize,proto3" json:"batch_max_size,omitempty"`
+	// Seconds to wait before each retry, in order: the first entry is the delay before the
+	// second attempt. Must not decrease; at most 24 entries of up to 86400. Retries past the
+	// end of the schedule use the default exponential backoff. Empty = default backoff only.
+	RetryScheduleSeconds []int32 `protobuf:"varint,24,rep,packed,name=retry_schedule_seconds,json=retryScheduleSeconds,proto3" json:"retry_schedule_seconds,omitempty"`
+	// Query parameters added to the URL and fallback URLs of every delivery, for receivers that
+	// read event data from the query string. Values may be templates, like header values. The
+	// URLs must not already carry these parameters, and SQS targets can't have any. At most 20.
+	QueryParams map[string]string `protobuf:"bytes,25,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Event metadata the webhook requires: it only receives events whose metadata has every
+	// key with exactly the given value. Empty = all events. At most 20 entries.
+	MetadataFilter map[string]string `protobuf:"bytes,26,rep,name=metadata_filter,json=metadataFilter,proto3" json:"metadata_filter,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Secret deliveries are signed with, at least 16 characters. When set, each delivery carries
+	// X-Sparrow-Timestamp and X-Sparrow-Signature headers that receivers can check with
+	// pkg/verify. Stored separately and never returned; change it with RotateSecret.
+	SigningSecret string `protobuf:"bytes,27,opt,name=signing_secret,json=signingSecret,proto3" json:"signing_secret,omitempty"`
+	// Connect to the receiver directly instead of through the outbound proxy (WEBHOOK_PROXY_URL
+	// or HTTP_PROXY/HTTPS_PROXY), for receivers inside the network. Only applies to http(s) targets.
+	BypassProxy   bool `protobuf:"varint,28,opt,name=bypass_proxy,json=bypassProxy,proto3" json:"bypass_proxy,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -176,144 +358,179 @@ func (x *RegisterWebhookRequest) GetDescription() string {
 	return ""
 }
 
-// RegisterWebhookResponse represents the response for webhook registration
-type RegisterWebhookResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`  // Unique webhook identifier
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`                      // Whether registration was successful
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                       // Success or error message
-	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the webhook was registered
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RegisterWebhookRequest) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
 }
 
-func (x *RegisterWebhookResponse) Reset() {
-	*x = RegisterWebhookResponse{}
-	mi := &file_proto_webhook_proto_msgTypes[1]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RegisterWebhookRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
 }
 
-func (x *RegisterWebhookResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RegisterWebhookRequest) GetMaxStoredResponseBytes() int32 {
+	if x != nil {
+		return x.MaxStoredResponseBytes
+	}
+	return 0
 }
 
-func (*RegisterWebhookResponse) ProtoMessage() {}
+func (x *RegisterWebhookRequest) GetDisableTracePropagation() bool {
+	if x != nil {
+		return x.DisableTracePropagation
+	}
+	return false
+}
 
-func (x *RegisterWebhookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[1]
+func (x *RegisterWebhookRequest) GetOrdered() bool {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Ordered
 	}
-	return mi.MessageOf(x)
+	return false
 }
 
-// Deprecated: Use RegisterWebhookResponse.ProtoReflect.Descriptor instead.
-func (*RegisterWebhookResponse) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{1}
+func (x *RegisterWebhookRequest) GetIncludeFields() []string {
+	if x != nil {
+		return x.IncludeFields
+	}
+	return nil
 }
 
-func (x *RegisterWebhookResponse) GetWebhookId() string {
+func (x *RegisterWebhookRequest) GetExcludeFields() []string {
 	if x != nil {
-		return x.WebhookId
+		return x.ExcludeFields
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterWebhookResponse) GetSuccess() bool {
+func (x *RegisterWebhookRequest) GetPriority() int32 {
 	if x != nil {
-		return x.Success
+		return x.Priority
 	}
-	return false
+	return 0
 }
 
-func (x *RegisterWebhookResponse) GetMessage() string {
+func (x *RegisterWebhookRequest) GetFallbackUrls() []string {
 	if x != nil {
-		return x.Message
+		return x.FallbackUrls
 	}
-	return ""
+	return nil
 }
 
-func (x *RegisterWebhookResponse) GetCreatedAt() int64 {
+func (x *RegisterWebhookRequest) GetSuccessBodyMatcher() *SuccessBodyMatcher {
 	if x != nil {
-		return x.CreatedAt
+		return x.SuccessBodyMatcher
+	}
+	return nil
+}
+
+func (x *RegisterWebhookRequest) GetAuth() *WebhookAuth {
+	if x != nil {
+		return x.Auth
+	}
+	return nil
+}
+
+func (x *RegisterWebhookRequest) GetMaxInFlight() int32 {
+	if x != nil {
+		return x.MaxInFlight
 	}
 	return 0
 }
 
-// UnregisterWebhookRequest represents a request to remove a webhook
-type UnregisterWebhookRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // Webhook ID to remove
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RegisterWebhookRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
 }
 
-func (x *UnregisterWebhookRequest) Reset() {
-	*x = UnregisterWebhookRequest{}
-	mi := &file_proto_webhook_proto_msgTypes[2]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RegisterWebhookRequest) GetCompressBody() bool {
+	if x != nil {
+		return x.CompressBody
+	}
+	return false
 }
 
-func (x *UnregisterWebhookRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RegisterWebhookRequest) GetBatchWindowMs() int32 {
+	if x != nil {
+		return x.BatchWindowMs
+	}
+	return 0
 }
 
-func (*UnregisterWebhookRequest) ProtoMessage() {}
+func (x *RegisterWebhookRequest) GetBatchMaxSize() int32 {
+	if x != nil {
+		return x.BatchMaxSize
+	}
+	return 0
+}
 
-func (x *UnregisterWebhookRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[2]
+func (x *RegisterWebhookRequest) GetRetryScheduleSeconds() []int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RetryScheduleSeconds
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use UnregisterWebhookRequest.ProtoReflect.Descriptor instead.
-func (*UnregisterWebhookRequest) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{2}
+func (x *RegisterWebhookRequest) GetQueryParams() map[string]string {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
 }
 
-func (x *UnregisterWebhookRequest) GetWebhookId() string {
+func (x *RegisterWebhookRequest) GetMetadataFilter() map[string]string {
 	if x != nil {
-		return x.WebhookId
+		return x.MetadataFilter
+	}
+	return nil
+}
+
+func (x *RegisterWebhookRequest) GetSigningSecret() string {
+	if x != nil {
+		return x.SigningSecret
 	}
 	return ""
 }
 
-// UnregisterWebhookResponse represents the response for webhook removal
-type UnregisterWebhookResponse struct {
+func (x *RegisterWebhookRequest) GetBypassProxy() bool {
+	if x != nil {
+		return x.BypassProxy
+	}
+	return false
+}
+
+// WebhookAuth configures the Authorization header sent with deliveries
+type WebhookAuth struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Whether removal was successful
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // Success or error message
+	Type          WebhookAuthType        `protobuf:"varint,1,opt,name=type,proto3,enum=webhook.WebhookAuthType" json:"type,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"` // Basic auth username
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"` // Basic auth password
+	Token         string                 `protobuf:"bytes,4,opt,name=token,proto3" json:"token,omitempty"`       // Bearer token
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnregisterWebhookResponse) Reset() {
-	*x = UnregisterWebhookResponse{}
-	mi := &file_proto_webhook_proto_msgTypes[3]
+func (x *WebhookAuth) Reset() {
+	*x = WebhookAuth{}
+	mi := &file_proto_webhook_proto_msgTypes[1]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnregisterWebhookResponse) String() string {
+func (x *WebhookAuth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnregisterWebhookResponse) ProtoMessage() {}
+func (*WebhookAuth) ProtoMessage() {}
 
-func (x *UnregisterWebhookResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[3]
+func (x *WebhookAuth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[1]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -324,52 +541,65 @@ func (x *UnregisterWebhookResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnregisterWebhookResponse.ProtoReflect.Descriptor instead.
-func (*UnregisterWebhookResponse) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use WebhookAuth.ProtoReflect.Descriptor instead.
+func (*WebhookAuth) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *UnregisterWebhookResponse) GetSuccess() bool {
+func (x *WebhookAuth) GetType() WebhookAuthType {
 	if x != nil {
-		return x.Success
+		return x.Type
 	}
-	return false
+	return WebhookAuthType_AUTH_NONE
 }
 
-func (x *UnregisterWebhookResponse) GetMessage() string {
+func (x *WebhookAuth) GetUsername() string {
 	if x != nil {
-		return x.Message
+		return x.Username
 	}
 	return ""
 }
 
-// PushEventRequest represents a request to push an event
-type PushEventRequest struct {
+func (x *WebhookAuth) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *WebhookAuth) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+// SuccessBodyMatcher checks a 2xx response body, for receivers that report errors in the body.
+// Set either json_path (with expected_value) or regex.
+type SuccessBodyMatcher struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                         // Namespace for the event
-	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`                                                                                 // Event name
-	Payload       string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`                                                                             // Event payload as JSON string
-	TtlSeconds    int64                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`                                                    // TTL for webhook retry attempts
-	Metadata      map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional event metadata
+	JsonPath      string                 `protobuf:"bytes,1,opt,name=json_path,json=jsonPath,proto3" json:"json_path,omitempty"`                // Dot-separated JSON path, e.g. "status"
+	ExpectedValue string                 `protobuf:"bytes,2,opt,name=expected_value,json=expectedValue,proto3" json:"expected_value,omitempty"` // Value json_path must have, e.g. "ok"
+	Regex         string                 `protobuf:"bytes,3,opt,name=regex,proto3" json:"regex,omitempty"`                                      // Regular expression the body must match
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PushEventRequest) Reset() {
-	*x = PushEventRequest{}
-	mi := &file_proto_webhook_proto_msgTypes[4]
+func (x *SuccessBodyMatcher) Reset() {
+	*x = SuccessBodyMatcher{}
+	mi := &file_proto_webhook_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushEventRequest) String() string {
+func (x *SuccessBodyMatcher) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushEventRequest) ProtoMessage() {}
+func (*SuccessBodyMatcher) ProtoMessage() {}
 
-func (x *PushEventRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[4]
+func (x *SuccessBodyMatcher) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -380,73 +610,4205 @@ func (x *PushEventRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushEventRequest.ProtoReflect.Descriptor instead.
-func (*PushEventRequest) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use SuccessBodyMatcher.ProtoReflect.Descriptor instead.
+func (*SuccessBodyMatcher) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *PushEventRequest) GetNamespace() string {
+func (x *SuccessBodyMatcher) GetJsonPath() string {
 	if x != nil {
-		return x.Namespace
+		return x.JsonPath
 	}
 	return ""
 }
 
-func (x *PushEventRequest) GetEvent() string {
+func (x *SuccessBodyMatcher) GetExpectedValue() string {
 	if x != nil {
-		return x.Event
+		return x.ExpectedValue
 	}
 	return ""
 }
 
-func (x *PushEventRequest) GetPayload() string {
+func (x *SuccessBodyMatcher) GetRegex() string {
 	if x != nil {
-		return x.Payload
+		return x.Regex
 	}
 	return ""
 }
 
-func (x *PushEventRequest) GetTtlSeconds() int64 {
-	if x != nil {
-		return x.TtlSeconds
-	}
-	return 0
+// RegisterWebhookResponse represents the response for webhook registration
+type RegisterWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`  // Unique webhook identifier
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`                      // Whether registration was successful
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`                       // Success or error message
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the webhook was registered
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *PushEventRequest) GetMetadata() map[string]string {
-	if x != nil {
-		return x.Metadata
-	}
-	return nil
+func (x *RegisterWebhookResponse) Reset() {
+	*x = RegisterWebhookResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterWebhookResponse) ProtoMessage() {}
+
+func (x *RegisterWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterWebhookResponse.ProtoReflect.Descriptor instead.
+func (*RegisterWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *RegisterWebhookResponse) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *RegisterWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegisterWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RegisterWebhookResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+// UnregisterWebhookRequest represents a request to remove a webhook
+type UnregisterWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // Webhook ID to remove
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterWebhookRequest) Reset() {
+	*x = UnregisterWebhookRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterWebhookRequest) ProtoMessage() {}
+
+func (x *UnregisterWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterWebhookRequest.ProtoReflect.Descriptor instead.
+func (*UnregisterWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *UnregisterWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// UnregisterWebhookResponse represents the response for webhook removal
+type UnregisterWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Whether removal was successful
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // Success or error message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnregisterWebhookResponse) Reset() {
+	*x = UnregisterWebhookResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnregisterWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnregisterWebhookResponse) ProtoMessage() {}
+
+func (x *UnregisterWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnregisterWebhookResponse.ProtoReflect.Descriptor instead.
+func (*UnregisterWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *UnregisterWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnregisterWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// PauseWebhookRequest represents a request to pause a webhook
+type PauseWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // Webhook ID to pause
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseWebhookRequest) Reset() {
+	*x = PauseWebhookRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseWebhookRequest) ProtoMessage() {}
+
+func (x *PauseWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseWebhookRequest.ProtoReflect.Descriptor instead.
+func (*PauseWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *PauseWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// PauseWebhookResponse represents the response for pausing a webhook
+type PauseWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Whether the webhook is now paused
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // Success or error message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseWebhookResponse) Reset() {
+	*x = PauseWebhookResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseWebhookResponse) ProtoMessage() {}
+
+func (x *PauseWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseWebhookResponse.ProtoReflect.Descriptor instead.
+func (*PauseWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *PauseWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PauseWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ResumeWebhookRequest represents a request to resume a paused webhook
+type ResumeWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // Webhook ID to resume
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeWebhookRequest) Reset() {
+	*x = ResumeWebhookRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeWebhookRequest) ProtoMessage() {}
+
+func (x *ResumeWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeWebhookRequest.ProtoReflect.Descriptor instead.
+func (*ResumeWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ResumeWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// ResumeWebhookResponse represents the response for resuming a webhook
+type ResumeWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"` // Whether the webhook is now active
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`  // Success or error message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeWebhookResponse) Reset() {
+	*x = ResumeWebhookResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResumeWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResumeWebhookResponse) ProtoMessage() {}
+
+func (x *ResumeWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResumeWebhookResponse.ProtoReflect.Descriptor instead.
+func (*ResumeWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ResumeWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ResumeWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RotateSecretRequest represents a request to rotate a webhook's signing secret
+type RotateSecretRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // Signed webhook to rotate the secret of
+	NewSecret string                 `protobuf:"bytes,2,opt,name=new_secret,json=newSecret,proto3" json:"new_secret,omitempty"` // Secret to sign deliveries with from now on, at least 16 characters
+	// How long the current secret keeps signing deliveries alongside the new one, so receivers
+	// can switch over (default: 86400, max: 604800). A previous secret still in its grace
+	// period is dropped.
+	GracePeriodSeconds int64 `protobuf:"varint,3,opt,name=grace_period_seconds,json=gracePeriodSeconds,proto3" json:"grace_period_seconds,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *RotateSecretRequest) Reset() {
+	*x = RotateSecretRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSecretRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSecretRequest) ProtoMessage() {}
+
+func (x *RotateSecretRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSecretRequest.ProtoReflect.Descriptor instead.
+func (*RotateSecretRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *RotateSecretRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *RotateSecretRequest) GetNewSecret() string {
+	if x != nil {
+		return x.NewSecret
+	}
+	return ""
+}
+
+func (x *RotateSecretRequest) GetGracePeriodSeconds() int64 {
+	if x != nil {
+		return x.GracePeriodSeconds
+	}
+	return 0
+}
+
+// RotateSecretResponse represents the response for rotating a signing secret
+type RotateSecretResponse struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Success                 bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message                 string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PreviousSecretExpiresAt int64                  `protobuf:"varint,3,opt,name=previous_secret_expires_at,json=previousSecretExpiresAt,proto3" json:"previous_secret_expires_at,omitempty"` // When the old secret stops signing deliveries
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RotateSecretResponse) Reset() {
+	*x = RotateSecretResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateSecretResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateSecretResponse) ProtoMessage() {}
+
+func (x *RotateSecretResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateSecretResponse.ProtoReflect.Descriptor instead.
+func (*RotateSecretResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *RotateSecretResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RotateSecretResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RotateSecretResponse) GetPreviousSecretExpiresAt() int64 {
+	if x != nil {
+		return x.PreviousSecretExpiresAt
+	}
+	return 0
+}
+
+// DeleteWebhooksRequest represents a request to delete webhooks in bulk
+type DeleteWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace to delete webhooks from (required)
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`         // Only delete webhooks listening for this event (optional)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhooksRequest) Reset() {
+	*x = DeleteWebhooksRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhooksRequest) ProtoMessage() {}
+
+func (x *DeleteWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*DeleteWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DeleteWebhooksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DeleteWebhooksRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+// DeleteWebhooksResponse represents the response for bulk webhook deletion
+type DeleteWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeletedCount  int32                  `protobuf:"varint,1,opt,name=deleted_count,json=deletedCount,proto3" json:"deleted_count,omitempty"` // Number of webhooks deleted
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteWebhooksResponse) Reset() {
+	*x = DeleteWebhooksResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteWebhooksResponse) ProtoMessage() {}
+
+func (x *DeleteWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*DeleteWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeleteWebhooksResponse) GetDeletedCount() int32 {
+	if x != nil {
+		return x.DeletedCount
+	}
+	return 0
+}
+
+func (x *DeleteWebhooksResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *DeleteWebhooksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RegisterEventSchemaRequest represents a request to register a JSON Schema for an event
+type RegisterEventSchemaRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace of the event
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`         // Event name
+	Schema        string                 `protobuf:"bytes,3,opt,name=schema,proto3" json:"schema,omitempty"`       // JSON Schema document that pushed payloads must match
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterEventSchemaRequest) Reset() {
+	*x = RegisterEventSchemaRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterEventSchemaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterEventSchemaRequest) ProtoMessage() {}
+
+func (x *RegisterEventSchemaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterEventSchemaRequest.ProtoReflect.Descriptor instead.
+func (*RegisterEventSchemaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RegisterEventSchemaRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RegisterEventSchemaRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *RegisterEventSchemaRequest) GetSchema() string {
+	if x != nil {
+		return x.Schema
+	}
+	return ""
+}
+
+// RegisterEventSchemaResponse represents the response for event schema registration
+type RegisterEventSchemaResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RegisterEventSchemaResponse) Reset() {
+	*x = RegisterEventSchemaResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisterEventSchemaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisterEventSchemaResponse) ProtoMessage() {}
+
+func (x *RegisterEventSchemaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisterEventSchemaResponse.ProtoReflect.Descriptor instead.
+func (*RegisterEventSchemaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RegisterEventSchemaResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RegisterEventSchemaResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// PushEventRequest represents a request to push an event
+type PushEventRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Namespace               string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                         // Namespace for the event
+	Event                   string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`                                                                                 // Event name
+	Payload                 string                 `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`                                                                             // Event payload, such as a JSON document
+	TtlSeconds              int64                  `protobuf:"varint,4,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`                                                    // TTL for webhook retry attempts, default DEFAULT_EVENT_TTL_SECONDS, at most MAX_EVENT_TTL_SECONDS
+	Metadata                map[string]string      `protobuf:"bytes,5,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Additional event metadata
+	ContentType             string                 `protobuf:"bytes,6,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`                                                  // Payload Content-Type; JSON is only validated for JSON types (default: application/json)
+	DeliveryTimeoutOverride int32                  `protobuf:"varint,7,opt,name=delivery_timeout_override,json=deliveryTimeoutOverride,proto3" json:"delivery_timeout_override,omitempty"`           // Delivery timeout in seconds for this event; overrides the webhook timeout when > 0 (capped by the server)
+	// Queue priority, 1 (most urgent) to 4 (least); 0 = default (2). When the webhook also sets
+	// a priority, the more urgent of the two is used for its delivery.
+	Priority int32 `protobuf:"varint,8,opt,name=priority,proto3" json:"priority,omitempty"`
+	// Unix time to deliver the event at; 0 or a past time delivers immediately. Must not be
+	// later than the event's TTL expiry.
+	DeliverAt int64 `protobuf:"varint,9,opt,name=deliver_at,json=deliverAt,proto3" json:"deliver_at,omitempty"`
+	// Make the first delivery attempt inline and return its results in the response. Only
+	// webhooks that aren't ordered, batched or limited in flight are delivered inline, up to a
+	// server-side cap; the rest, and retries of failed attempts, go through the queue. Can't be
+	// combined with a future deliver_at, or used in PushEvents.
+	Synchronous bool `protobuf:"varint,10,opt,name=synchronous,proto3" json:"synchronous,omitempty"`
+	// Event payload as raw bytes, for payloads that aren't UTF-8 text; set instead of payload.
+	// Either way the payload is stored and delivered byte-for-byte.
+	RawPayload    []byte `protobuf:"bytes,11,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushEventRequest) Reset() {
+	*x = PushEventRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEventRequest) ProtoMessage() {}
+
+func (x *PushEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEventRequest.ProtoReflect.Descriptor instead.
+func (*PushEventRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PushEventRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *PushEventRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *PushEventRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *PushEventRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *PushEventRequest) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PushEventRequest) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *PushEventRequest) GetDeliveryTimeoutOverride() int32 {
+	if x != nil {
+		return x.DeliveryTimeoutOverride
+	}
+	return 0
+}
+
+func (x *PushEventRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PushEventRequest) GetDeliverAt() int64 {
+	if x != nil {
+		return x.DeliverAt
+	}
+	return 0
+}
+
+func (x *PushEventRequest) GetSynchronous() bool {
+	if x != nil {
+		return x.Synchronous
+	}
+	return false
+}
+
+func (x *PushEventRequest) GetRawPayload() []byte {
+	if x != nil {
+		return x.RawPayload
+	}
+	return nil
+}
+
+// PushEventResponse represents the response for event pushing
+type PushEventResponse struct {
+	state                 protoimpl.MessageState `protogen:"open.v1"`
+	EventId               string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                                           // Unique event identifier
+	WebhooksTriggered     int32                  `protobuf:"varint,2,opt,name=webhooks_triggered,json=webhooksTriggered,proto3" json:"webhooks_triggered,omitempty"`            // Number of webhooks triggered
+	WebhookIds            []string               `protobuf:"bytes,3,rep,name=webhook_ids,json=webhookIds,proto3" json:"webhook_ids,omitempty"`                                  // IDs of triggered webhooks
+	Success               bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`                                                         // Whether event was processed
+	Message               string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                                          // Success or error message
+	SynchronousDeliveries []*SynchronousDelivery `protobuf:"bytes,6,rep,name=synchronous_deliveries,json=synchronousDeliveries,proto3" json:"synchronous_deliveries,omitempty"` // Inline attempts of a synchronous push
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *PushEventResponse) Reset() {
+	*x = PushEventResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEventResponse) ProtoMessage() {}
+
+func (x *PushEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEventResponse.ProtoReflect.Descriptor instead.
+func (*PushEventResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *PushEventResponse) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PushEventResponse) GetWebhooksTriggered() int32 {
+	if x != nil {
+		return x.WebhooksTriggered
+	}
+	return 0
+}
+
+func (x *PushEventResponse) GetWebhookIds() []string {
+	if x != nil {
+		return x.WebhookIds
+	}
+	return nil
+}
+
+func (x *PushEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PushEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *PushEventResponse) GetSynchronousDeliveries() []*SynchronousDelivery {
+	if x != nil {
+		return x.SynchronousDeliveries
+	}
+	return nil
+}
+
+// SynchronousDelivery is the result of a delivery attempted inline by a synchronous push
+type SynchronousDelivery struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`              // Webhook the event was delivered to
+	DeliveryId    string                 `protobuf:"bytes,2,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`           // Delivery record, for following up on retries
+	Status        WebhookDeliveryStatus  `protobuf:"varint,3,opt,name=status,proto3,enum=webhook.WebhookDeliveryStatus" json:"status,omitempty"` // success, retrying (rescheduled through the queue) or failed
+	ResponseCode  int32                  `protobuf:"varint,4,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`    // Response status; 0 when no response was received
+	ErrorMessage  string                 `protobuf:"bytes,5,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`     // Why the attempt failed; empty on success
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SynchronousDelivery) Reset() {
+	*x = SynchronousDelivery{}
+	mi := &file_proto_webhook_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SynchronousDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SynchronousDelivery) ProtoMessage() {}
+
+func (x *SynchronousDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SynchronousDelivery.ProtoReflect.Descriptor instead.
+func (*SynchronousDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *SynchronousDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *SynchronousDelivery) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *SynchronousDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_DELIVERY_UNKNOWN
+}
+
+func (x *SynchronousDelivery) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *SynchronousDelivery) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+// PushEventsRequest represents a request to push a batch of events
+type PushEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*PushEventRequest    `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"` // Events to push
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushEventsRequest) Reset() {
+	*x = PushEventsRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEventsRequest) ProtoMessage() {}
+
+func (x *PushEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEventsRequest.ProtoReflect.Descriptor instead.
+func (*PushEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *PushEventsRequest) GetEvents() []*PushEventRequest {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// PushEventResult represents the outcome for a single event in a batch
+type PushEventResult struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Index             int32                  `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`                                                  // Position of the event in the request
+	EventId           string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                                // Unique event identifier (empty if rejected)
+	WebhooksTriggered int32                  `protobuf:"varint,3,opt,name=webhooks_triggered,json=webhooksTriggered,proto3" json:"webhooks_triggered,omitempty"` // Number of webhooks triggered
+	WebhookIds        []string               `protobuf:"bytes,4,rep,name=webhook_ids,json=webhookIds,proto3" json:"webhook_ids,omitempty"`                       // IDs of triggered webhooks
+	Success           bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`                                              // Whether the event was accepted
+	Error             string                 `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`                                                   // Validation or scheduling error if rejected
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *PushEventResult) Reset() {
+	*x = PushEventResult{}
+	mi := &file_proto_webhook_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushEventResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEventResult) ProtoMessage() {}
+
+func (x *PushEventResult) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEventResult.ProtoReflect.Descriptor instead.
+func (*PushEventResult) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PushEventResult) GetIndex() int32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *PushEventResult) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PushEventResult) GetWebhooksTriggered() int32 {
+	if x != nil {
+		return x.WebhooksTriggered
+	}
+	return 0
+}
+
+func (x *PushEventResult) GetWebhookIds() []string {
+	if x != nil {
+		return x.WebhookIds
+	}
+	return nil
+}
+
+func (x *PushEventResult) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PushEventResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PushEventsResponse represents the response for batch event pushing
+type PushEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Results       []*PushEventResult     `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`                                   // Per-event results in request order
+	AcceptedCount int32                  `protobuf:"varint,2,opt,name=accepted_count,json=acceptedCount,proto3" json:"accepted_count,omitempty"` // Number of events accepted
+	RejectedCount int32                  `protobuf:"varint,3,opt,name=rejected_count,json=rejectedCount,proto3" json:"rejected_count,omitempty"` // Number of events rejected
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`                                  // Whether all events were accepted
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                   // Summary message
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PushEventsResponse) Reset() {
+	*x = PushEventsResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PushEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PushEventsResponse) ProtoMessage() {}
+
+func (x *PushEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PushEventsResponse.ProtoReflect.Descriptor instead.
+func (*PushEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PushEventsResponse) GetResults() []*PushEventResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *PushEventsResponse) GetAcceptedCount() int32 {
+	if x != nil {
+		return x.AcceptedCount
+	}
+	return 0
+}
+
+func (x *PushEventsResponse) GetRejectedCount() int32 {
+	if x != nil {
+		return x.RejectedCount
+	}
+	return 0
+}
+
+func (x *PushEventsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *PushEventsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetWebhookStatusRequest represents a request to get webhook status
+type GetWebhookStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*GetWebhookStatusRequest_WebhookId
+	//	*GetWebhookStatusRequest_EventId
+	Identifier     isGetWebhookStatusRequest_Identifier `protobuf_oneof:"identifier"`
+	Namespace      string                               `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                  // Optional namespace filter
+	FailureReason  DeliveryFailureReason                `protobuf:"varint,4,opt,name=failure_reason,json=failureReason,proto3,enum=webhook.DeliveryFailureReason" json:"failure_reason,omitempty"` // Only deliveries whose last attempt failed for this reason
+	IncludeHeaders bool                                 `protobuf:"varint,5,opt,name=include_headers,json=includeHeaders,proto3" json:"include_headers,omitempty"`                                 // Include the request and response headers of each delivery's last attempt
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetWebhookStatusRequest) Reset() {
+	*x = GetWebhookStatusRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookStatusRequest) ProtoMessage() {}
+
+func (x *GetWebhookStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetWebhookStatusRequest) GetIdentifier() isGetWebhookStatusRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *GetWebhookStatusRequest) GetWebhookId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetWebhookStatusRequest_WebhookId); ok {
+			return x.WebhookId
+		}
+	}
+	return ""
+}
+
+func (x *GetWebhookStatusRequest) GetEventId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*GetWebhookStatusRequest_EventId); ok {
+			return x.EventId
+		}
+	}
+	return ""
+}
+
+func (x *GetWebhookStatusRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetWebhookStatusRequest) GetFailureReason() DeliveryFailureReason {
+	if x != nil {
+		return x.FailureReason
+	}
+	return DeliveryFailureReason_FAILURE_NONE
+}
+
+func (x *GetWebhookStatusRequest) GetIncludeHeaders() bool {
+	if x != nil {
+		return x.IncludeHeaders
+	}
+	return false
+}
+
+type isGetWebhookStatusRequest_Identifier interface {
+	isGetWebhookStatusRequest_Identifier()
+}
+
+type GetWebhookStatusRequest_WebhookId struct {
+	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3,oneof"` // Get status for specific webhook
+}
+
+type GetWebhookStatusRequest_EventId struct {
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3,oneof"` // Get status for specific event
+}
+
+func (*GetWebhookStatusRequest_WebhookId) isGetWebhookStatusRequest_Identifier() {}
+
+func (*GetWebhookStatusRequest_EventId) isGetWebhookStatusRequest_Identifier() {}
+
+// WebhookDelivery represents a single webhook delivery attempt
+type WebhookDelivery struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId          string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`                                               // Unique delivery identifier
+	WebhookId           string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`                                                  // Associated webhook ID
+	EventId             string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                                                        // Associated event ID
+	Status              WebhookDeliveryStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=webhook.WebhookDeliveryStatus" json:"status,omitempty"`                                     // Current delivery status
+	AttemptCount        int32                  `protobuf:"varint,5,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`                                        // Number of delivery attempts
+	MaxAttempts         int32                  `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                           // Maximum retry attempts
+	CreatedAt           int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                 // When delivery was created
+	LastAttemptedAt     int64                  `protobuf:"varint,8,opt,name=last_attempted_at,json=lastAttemptedAt,proto3" json:"last_attempted_at,omitempty"`                             // Last attempt timestamp
+	NextRetryAt         int64                  `protobuf:"varint,9,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"`                                         // Next retry timestamp
+	ExpiresAt           int64                  `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                                // When delivery expires (TTL)
+	ResponseCode        int32                  `protobuf:"varint,11,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`                                       // HTTP response code from last attempt
+	ResponseBody        string                 `protobuf:"bytes,12,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`                                        // HTTP response body (truncated)
+	ErrorMessage        string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`                                        // Error message if failed
+	ResponseContentType string                 `protobuf:"bytes,14,opt,name=response_content_type,json=responseContentType,proto3" json:"response_content_type,omitempty"`                 // Content-Type of the HTTP response
+	ResponseUrl         string                 `protobuf:"bytes,15,opt,name=response_url,json=responseUrl,proto3" json:"response_url,omitempty"`                                           // URL that produced the recorded response
+	FailureReason       DeliveryFailureReason  `protobuf:"varint,16,opt,name=failure_reason,json=failureReason,proto3,enum=webhook.DeliveryFailureReason" json:"failure_reason,omitempty"` // Why the last attempt failed (FAILURE_NONE if it didn't)
+	// Headers sent and received by the last attempt, with credentials redacted. Only set by
+	// GetWebhookStatus, GetWebhookStatusBatch and GetDelivery with include_headers.
+	RequestHeaders    map[string]string `protobuf:"bytes,17,rep,name=request_headers,json=requestHeaders,proto3" json:"request_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResponseHeaders   map[string]string `protobuf:"bytes,18,rep,name=response_headers,json=responseHeaders,proto3" json:"response_headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	ResponseTruncated bool              `protobuf:"varint,19,opt,name=response_truncated,json=responseTruncated,proto3" json:"response_truncated,omitempty"` // Whether response_body was cut at the capture limit
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *WebhookDelivery) Reset() {
+	*x = WebhookDelivery{}
+	mi := &file_proto_webhook_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookDelivery) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookDelivery) ProtoMessage() {}
+
+func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
+func (*WebhookDelivery) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *WebhookDelivery) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_DELIVERY_UNKNOWN
+}
+
+func (x *WebhookDelivery) GetAttemptCount() int32 {
+	if x != nil {
+		return x.AttemptCount
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetLastAttemptedAt() int64 {
+	if x != nil {
+		return x.LastAttemptedAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetNextRetryAt() int64 {
+	if x != nil {
+		return x.NextRetryAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseCode() int32 {
+	if x != nil {
+		return x.ResponseCode
+	}
+	return 0
+}
+
+func (x *WebhookDelivery) GetResponseBody() string {
+	if x != nil {
+		return x.ResponseBody
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetErrorMessage() string {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetResponseContentType() string {
+	if x != nil {
+		return x.ResponseContentType
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetResponseUrl() string {
+	if x != nil {
+		return x.ResponseUrl
+	}
+	return ""
+}
+
+func (x *WebhookDelivery) GetFailureReason() DeliveryFailureReason {
+	if x != nil {
+		return x.FailureReason
+	}
+	return DeliveryFailureReason_FAILURE_NONE
+}
+
+func (x *WebhookDelivery) GetRequestHeaders() map[string]string {
+	if x != nil {
+		return x.RequestHeaders
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetResponseHeaders() map[string]string {
+	if x != nil {
+		return x.ResponseHeaders
+	}
+	return nil
+}
+
+func (x *WebhookDelivery) GetResponseTruncated() bool {
+	if x != nil {
+		return x.ResponseTruncated
+	}
+	return false
+}
+
+// GetWebhookStatusResponse represents the response for webhook status
+type GetWebhookStatusResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries      []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	TotalDeliveries int32                  `protobuf:"varint,2,opt,name=total_deliveries,json=totalDeliveries,proto3" json:"total_deliveries,omitempty"`
+	Success         bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetWebhookStatusResponse) Reset() {
+	*x = GetWebhookStatusResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookStatusResponse) ProtoMessage() {}
+
+func (x *GetWebhookStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookStatusResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *GetWebhookStatusResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *GetWebhookStatusResponse) GetTotalDeliveries() int32 {
+	if x != nil {
+		return x.TotalDeliveries
+	}
+	return 0
+}
+
+func (x *GetWebhookStatusResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetWebhookStatusResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetWebhookStatusBatchRequest represents a request for the status of several webhooks and events.
+// At most 100 IDs may be given in total.
+type GetWebhookStatusBatchRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	WebhookIds     []string               `protobuf:"bytes,1,rep,name=webhook_ids,json=webhookIds,proto3" json:"webhook_ids,omitempty"`                                              // Get status for these webhooks
+	EventIds       []string               `protobuf:"bytes,2,rep,name=event_ids,json=eventIds,proto3" json:"event_ids,omitempty"`                                                    // Get status for these events
+	FailureReason  DeliveryFailureReason  `protobuf:"varint,3,opt,name=failure_reason,json=failureReason,proto3,enum=webhook.DeliveryFailureReason" json:"failure_reason,omitempty"` // Only deliveries whose last attempt failed for this reason
+	IncludeHeaders bool                   `protobuf:"varint,4,opt,name=include_headers,json=includeHeaders,proto3" json:"include_headers,omitempty"`                                 // Include the request and response headers of each delivery's last attempt
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetWebhookStatusBatchRequest) Reset() {
+	*x = GetWebhookStatusBatchRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookStatusBatchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookStatusBatchRequest) ProtoMessage() {}
+
+func (x *GetWebhookStatusBatchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookStatusBatchRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookStatusBatchRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetWebhookStatusBatchRequest) GetWebhookIds() []string {
+	if x != nil {
+		return x.WebhookIds
+	}
+	return nil
+}
+
+func (x *GetWebhookStatusBatchRequest) GetEventIds() []string {
+	if x != nil {
+		return x.EventIds
+	}
+	return nil
+}
+
+func (x *GetWebhookStatusBatchRequest) GetFailureReason() DeliveryFailureReason {
+	if x != nil {
+		return x.FailureReason
+	}
+	return DeliveryFailureReason_FAILURE_NONE
+}
+
+func (x *GetWebhookStatusBatchRequest) GetIncludeHeaders() bool {
+	if x != nil {
+		return x.IncludeHeaders
+	}
+	return false
+}
+
+// DeliveryStatusGroup holds the deliveries of one webhook or event, newest first
+type DeliveryStatusGroup struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"` // Webhook or event ID
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,2,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryStatusGroup) Reset() {
+	*x = DeliveryStatusGroup{}
+	mi := &file_proto_webhook_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryStatusGroup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryStatusGroup) ProtoMessage() {}
+
+func (x *DeliveryStatusGroup) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryStatusGroup.ProtoReflect.Descriptor instead.
+func (*DeliveryStatusGroup) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *DeliveryStatusGroup) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *DeliveryStatusGroup) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+// GetWebhookStatusBatchResponse represents the response for batch webhook status
+type GetWebhookStatusBatchResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks        []*DeliveryStatusGroup `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`                                       // One per requested webhook ID, in request order
+	Events          []*DeliveryStatusGroup `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`                                           // One per requested event ID, in request order
+	TotalDeliveries int32                  `protobuf:"varint,3,opt,name=total_deliveries,json=totalDeliveries,proto3" json:"total_deliveries,omitempty"` // Distinct deliveries across all groups
+	Success         bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Message         string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetWebhookStatusBatchResponse) Reset() {
+	*x = GetWebhookStatusBatchResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookStatusBatchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookStatusBatchResponse) ProtoMessage() {}
+
+func (x *GetWebhookStatusBatchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookStatusBatchResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookStatusBatchResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetWebhookStatusBatchResponse) GetWebhooks() []*DeliveryStatusGroup {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *GetWebhookStatusBatchResponse) GetEvents() []*DeliveryStatusGroup {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetWebhookStatusBatchResponse) GetTotalDeliveries() int32 {
+	if x != nil {
+		return x.TotalDeliveries
+	}
+	return 0
+}
+
+func (x *GetWebhookStatusBatchResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetWebhookStatusBatchResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// WatchWebhookStatusRequest represents a request to stream delivery status changes.
+// The current state of every matching delivery is sent first, followed by updates.
+type WatchWebhookStatusRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Identifier:
+	//
+	//	*WatchWebhookStatusRequest_WebhookId
+	//	*WatchWebhookStatusRequest_EventId
+	Identifier    isWatchWebhookStatusRequest_Identifier `protobuf_oneof:"identifier"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchWebhookStatusRequest) Reset() {
+	*x = WatchWebhookStatusRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchWebhookStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchWebhookStatusRequest) ProtoMessage() {}
+
+func (x *WatchWebhookStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchWebhookStatusRequest.ProtoReflect.Descriptor instead.
+func (*WatchWebhookStatusRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *WatchWebhookStatusRequest) GetIdentifier() isWatchWebhookStatusRequest_Identifier {
+	if x != nil {
+		return x.Identifier
+	}
+	return nil
+}
+
+func (x *WatchWebhookStatusRequest) GetWebhookId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*WatchWebhookStatusRequest_WebhookId); ok {
+			return x.WebhookId
+		}
+	}
+	return ""
+}
+
+func (x *WatchWebhookStatusRequest) GetEventId() string {
+	if x != nil {
+		if x, ok := x.Identifier.(*WatchWebhookStatusRequest_EventId); ok {
+			return x.EventId
+		}
+	}
+	return ""
+}
+
+type isWatchWebhookStatusRequest_Identifier interface {
+	isWatchWebhookStatusRequest_Identifier()
+}
+
+type WatchWebhookStatusRequest_WebhookId struct {
+	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3,oneof"` // Watch deliveries for a specific webhook
+}
+
+type WatchWebhookStatusRequest_EventId struct {
+	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3,oneof"` // Watch deliveries for a specific event
+}
+
+func (*WatchWebhookStatusRequest_WebhookId) isWatchWebhookStatusRequest_Identifier() {}
+
+func (*WatchWebhookStatusRequest_EventId) isWatchWebhookStatusRequest_Identifier() {}
+
+// ListWebhooksRequest represents a request to list webhooks
+type ListWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                      // Namespace to filter by
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`                              // Event to filter by (optional)
+	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Only return active webhooks
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksRequest) Reset() {
+	*x = ListWebhooksRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksRequest) ProtoMessage() {}
+
+func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListWebhooksRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ListWebhooksRequest) GetActiveOnly() bool {
+	if x != nil {
+		return x.ActiveOnly
+	}
+	return false
+}
+
+// RegisteredWebhook represents a registered webhook
+type RegisteredWebhook struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId               string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`                                                                                           // Unique webhook identifier
+	Namespace               string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                                                            // Webhook namespace
+	Events                  []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`                                                                                                                  // Events the webhook listens for
+	Url                     string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                                                                                                                        // Target URL
+	Headers                 map[string]string      `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`                                      // HTTP headers
+	Timeout                 int32                  `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                                                               // Timeout in seconds
+	Active                  bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`                                                                                                                 // Whether webhook is active
+	Description             string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`                                                                                                        // Webhook description
+	CreatedAt               int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                                                          // When webhook was registered
+	UpdatedAt               int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                                                         // When webhook was last updated
+	MaxAttempts             int32                  `protobuf:"varint,11,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`                                                                                   // Maximum delivery attempts
+	ContentType             string                 `protobuf:"bytes,12,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`                                                                                    // Default Content-Type for deliveries
+	MaxStoredResponseBytes  int32                  `protobuf:"varint,13,opt,name=max_stored_response_bytes,json=maxStoredResponseBytes,proto3" json:"max_stored_response_bytes,omitempty"`                                              // Response body bytes stored per delivery (0 = server default)
+	DisableTracePropagation bool                   `protobuf:"varint,14,opt,name=disable_trace_propagation,json=disableTracePropagation,proto3" json:"disable_trace_propagation,omitempty"`                                             // Whether traceparent/baggage headers are omitted
+	Ordered                 bool                   `protobuf:"varint,15,opt,name=ordered,proto3" json:"ordered,omitempty"`                                                                                                              // Whether events are delivered strictly in order
+	IncludeFields           []string               `protobuf:"bytes,16,rep,name=include_fields,json=includeFields,proto3" json:"include_fields,omitempty"`                                                                              // JSON paths delivered (empty = all)
+	ExcludeFields           []string               `protobuf:"bytes,17,rep,name=exclude_fields,json=excludeFields,proto3" json:"exclude_fields,omitempty"`                                                                              // JSON paths removed before delivery
+	Priority                int32                  `protobuf:"varint,18,opt,name=priority,proto3" json:"priority,omitempty"`                                                                                                            // Delivery queue priority (0 = default)
+	FallbackUrls            []string               `protobuf:"bytes,19,rep,name=fallback_urls,json=fallbackUrls,proto3" json:"fallback_urls,omitempty"`                                                                                 // Backup URLs tried in order after the primary
+	SuccessBodyMatcher      *SuccessBodyMatcher    `protobuf:"bytes,20,opt,name=success_body_matcher,json=successBodyMatcher,proto3" json:"success_body_matcher,omitempty"`                                                             // Rule a 2xx response body must satisfy
+	AuthType                WebhookAuthType        `protobuf:"varint,21,opt,name=auth_type,json=authType,proto3,enum=webhook.WebhookAuthType" json:"auth_type,omitempty"`                                                               // How deliveries authenticate; credentials are never returned
+	MaxInFlight             int32                  `protobuf:"varint,22,opt,name=max_in_flight,json=maxInFlight,proto3" json:"max_in_flight,omitempty"`                                                                                 // Maximum concurrent deliveries (0 = unlimited)
+	ExpiresAt               int64                  `protobuf:"varint,23,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                                                                         // When the webhook stops receiving events (0 = never)
+	CompressBody            bool                   `protobuf:"varint,24,opt,name=compress_body,json=compressBody,proto3" json:"compress_body,omitempty"`                                                                                // Large request bodies are sent gzip-encoded
+	BatchWindowMs           int32                  `protobuf:"varint,25,opt,name=batch_window_ms,json=batchWindowMs,proto3" json:"batch_window_ms,omitempty"`                                                                           // Milliseconds deliveries are collected per batch (0 = no batching)
+	BatchMaxSize            int32                  `protobuf:"varint,26,opt,name=batch_max_size,json=batchMaxSize,proto3" json:"batch_max_size,omitempty"`                                                                              // Maximum deliveries per batch
+	RetryScheduleSeconds    []int32                `protobuf:"varint,27,rep,packed,name=retry_schedule_seconds,json=retryScheduleSeconds,proto3" json:"retry_schedule_seconds,omitempty"`                                               // Seconds before each retry (empty = default backoff)
+	QueryParams             map[string]string      `protobuf:"bytes,28,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`          // Added to each delivery URL (values may be templates)
+	MetadataFilter          map[string]string      `protobuf:"bytes,29,rep,name=metadata_filter,json=metadataFilter,proto3" json:"metadata_filter,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Event metadata required for delivery (empty = all events)
+	Signed                  bool                   `protobuf:"varint,30,opt,name=signed,proto3" json:"signed,omitempty"`                                                                                                                // Deliveries carry X-Sparrow-Signature headers
+	BypassProxy             bool                   `protobuf:"varint,31,opt,name=bypass_proxy,json=bypassProxy,proto3" json:"bypass_proxy,omitempty"`                                                                                   // Deliveries connect directly instead of through the outbound proxy
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *RegisteredWebhook) Reset() {
+	*x = RegisteredWebhook{}
+	mi := &file_proto_webhook_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RegisteredWebhook) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RegisteredWebhook) ProtoMessage() {}
+
+func (x *RegisteredWebhook) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RegisteredWebhook.ProtoReflect.Descriptor instead.
+func (*RegisteredWebhook) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RegisteredWebhook) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *RegisteredWebhook) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *RegisteredWebhook) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RegisteredWebhook) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *RegisteredWebhook) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RegisteredWebhook) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetMaxAttempts() int32 {
+	if x != nil {
+		return x.MaxAttempts
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *RegisteredWebhook) GetMaxStoredResponseBytes() int32 {
+	if x != nil {
+		return x.MaxStoredResponseBytes
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetDisableTracePropagation() bool {
+	if x != nil {
+		return x.DisableTracePropagation
+	}
+	return false
+}
+
+func (x *RegisteredWebhook) GetOrdered() bool {
+	if x != nil {
+		return x.Ordered
+	}
+	return false
+}
+
+func (x *RegisteredWebhook) GetIncludeFields() []string {
+	if x != nil {
+		return x.IncludeFields
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetExcludeFields() []string {
+	if x != nil {
+		return x.ExcludeFields
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetFallbackUrls() []string {
+	if x != nil {
+		return x.FallbackUrls
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetSuccessBodyMatcher() *SuccessBodyMatcher {
+	if x != nil {
+		return x.SuccessBodyMatcher
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetAuthType() WebhookAuthType {
+	if x != nil {
+		return x.AuthType
+	}
+	return WebhookAuthType_AUTH_NONE
+}
+
+func (x *RegisteredWebhook) GetMaxInFlight() int32 {
+	if x != nil {
+		return x.MaxInFlight
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetCompressBody() bool {
+	if x != nil {
+		return x.CompressBody
+	}
+	return false
+}
+
+func (x *RegisteredWebhook) GetBatchWindowMs() int32 {
+	if x != nil {
+		return x.BatchWindowMs
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetBatchMaxSize() int32 {
+	if x != nil {
+		return x.BatchMaxSize
+	}
+	return 0
+}
+
+func (x *RegisteredWebhook) GetRetryScheduleSeconds() []int32 {
+	if x != nil {
+		return x.RetryScheduleSeconds
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetQueryParams() map[string]string {
+	if x != nil {
+		return x.QueryParams
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetMetadataFilter() map[string]string {
+	if x != nil {
+		return x.MetadataFilter
+	}
+	return nil
+}
+
+func (x *RegisteredWebhook) GetSigned() bool {
+	if x != nil {
+		return x.Signed
+	}
+	return false
+}
+
+func (x *RegisteredWebhook) GetBypassProxy() bool {
+	if x != nil {
+		return x.BypassProxy
+	}
+	return false
+}
+
+// ListWebhooksResponse represents the response for listing webhooks
+type ListWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*RegisteredWebhook   `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWebhooksResponse) Reset() {
+	*x = ListWebhooksResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWebhooksResponse) ProtoMessage() {}
+
+func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ListWebhooksResponse) GetWebhooks() []*RegisteredWebhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListWebhooksResponse) GetTotalCount() int32 {
+	if x != nil {
+		return x.TotalCount
+	}
+	return 0
+}
+
+func (x *ListWebhooksResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListWebhooksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ListEventsRequest represents a request to list stored events
+type ListEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                  // Namespace to list events from (required)
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`                          // Event name to filter by (optional)
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`                         // Only events created at or after this Unix time (optional)
+	Until         int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`                         // Only events created before this Unix time (optional)
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                         // Maximum events to return (default: 50, max: 500)
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Token from a previous response to fetch the next page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsRequest) Reset() {
+	*x = ListEventsRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsRequest) ProtoMessage() {}
+
+func (x *ListEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ListEventsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ListEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventsRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// StoredEvent represents an event that was pushed
+type StoredEvent struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EventId         string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                                                              // Unique event identifier
+	Namespace       string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                         // Event namespace
+	Event           string                 `protobuf:"bytes,3,opt,name=event,proto3" json:"event,omitempty"`                                                                                 // Event name
+	Payload         string                 `protobuf:"bytes,4,opt,name=payload,proto3" json:"payload,omitempty"`                                                                             // Event payload
+	ContentType     string                 `protobuf:"bytes,5,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`                                                  // Payload Content-Type
+	Metadata        map[string]string      `protobuf:"bytes,6,rep,name=metadata,proto3" json:"metadata,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Event metadata
+	CreatedAt       int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                       // When the event was pushed
+	ExpiresAt       int64                  `protobuf:"varint,8,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                                                       // When the event's deliveries expire
+	WebhookCount    int32                  `protobuf:"varint,9,opt,name=webhook_count,json=webhookCount,proto3" json:"webhook_count,omitempty"`                                              // Number of webhook deliveries the event triggered
+	RawPayload      []byte                 `protobuf:"bytes,10,opt,name=raw_payload,json=rawPayload,proto3" json:"raw_payload,omitempty"`                                                    // Event payload when it isn't UTF-8 text; payload is empty then
+	DeliverySummary *EventDeliverySummary  `protobuf:"bytes,11,opt,name=delivery_summary,json=deliverySummary,proto3" json:"delivery_summary,omitempty"`                                     // The event's deliveries by outcome
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StoredEvent) Reset() {
+	*x = StoredEvent{}
+	mi := &file_proto_webhook_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StoredEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StoredEvent) ProtoMessage() {}
+
+func (x *StoredEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StoredEvent.ProtoReflect.Descriptor instead.
+func (*StoredEvent) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *StoredEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *StoredEvent) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *StoredEvent) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *StoredEvent) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *StoredEvent) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *StoredEvent) GetMetadata() map[string]string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *StoredEvent) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *StoredEvent) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *StoredEvent) GetWebhookCount() int32 {
+	if x != nil {
+		return x.WebhookCount
+	}
+	return 0
+}
+
+func (x *StoredEvent) GetRawPayload() []byte {
+	if x != nil {
+		return x.RawPayload
+	}
+	return nil
+}
+
+func (x *StoredEvent) GetDeliverySummary() *EventDeliverySummary {
+	if x != nil {
+		return x.DeliverySummary
+	}
+	return nil
+}
+
+// EventDeliverySummary counts an event's deliveries by outcome
+type EventDeliverySummary struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	TotalDeliveries int32                  `protobuf:"varint,1,opt,name=total_deliveries,json=totalDeliveries,proto3" json:"total_deliveries,omitempty"` // Deliveries the event triggered
+	Succeeded       int32                  `protobuf:"varint,2,opt,name=succeeded,proto3" json:"succeeded,omitempty"`                                    // Deliveries that succeeded
+	Failed          int32                  `protobuf:"varint,3,opt,name=failed,proto3" json:"failed,omitempty"`                                          // Deliveries that failed for good or expired
+	Pending         int32                  `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`                                        // Deliveries still pending, sending or retrying
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *EventDeliverySummary) Reset() {
+	*x = EventDeliverySummary{}
+	mi := &file_proto_webhook_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDeliverySummary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDeliverySummary) ProtoMessage() {}
+
+func (x *EventDeliverySummary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventDeliverySummary.ProtoReflect.Descriptor instead.
+func (*EventDeliverySummary) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *EventDeliverySummary) GetTotalDeliveries() int32 {
+	if x != nil {
+		return x.TotalDeliveries
+	}
+	return 0
+}
+
+func (x *EventDeliverySummary) GetSucceeded() int32 {
+	if x != nil {
+		return x.Succeeded
+	}
+	return 0
+}
+
+func (x *EventDeliverySummary) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *EventDeliverySummary) GetPending() int32 {
+	if x != nil {
+		return x.Pending
+	}
+	return 0
+}
+
+// ListEventsResponse represents the response for listing events
+type ListEventsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Events        []*StoredEvent         `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty when there are no more events
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventsResponse) Reset() {
+	*x = ListEventsResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventsResponse) ProtoMessage() {}
+
+func (x *ListEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ListEventsResponse) GetEvents() []*StoredEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *ListEventsResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListEventsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListEventsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ReplayEventsRequest represents a request to replay stored events
+type ReplayEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace of the events (required)
+	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`         // Event name to replay (required)
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`        // Only events created at or after this Unix time (optional)
+	Until         int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`        // Only events created before this Unix time (optional)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReplayEventsRequest) Reset() {
+	*x = ReplayEventsRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEventsRequest) ProtoMessage() {}
+
+func (x *ReplayEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEventsRequest.ProtoReflect.Descriptor instead.
+func (*ReplayEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReplayEventsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ReplayEventsRequest) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *ReplayEventsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ReplayEventsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+// ReplayEventsResponse represents the response for replaying events
+type ReplayEventsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	EventsReplayed      int32                  `protobuf:"varint,1,opt,name=events_replayed,json=eventsReplayed,proto3" json:"events_replayed,omitempty"`                // Stored events that were replayed
+	EventsExpired       int32                  `protobuf:"varint,2,opt,name=events_expired,json=eventsExpired,proto3" json:"events_expired,omitempty"`                   // Matching events skipped because their TTL has passed
+	DeliveriesScheduled int32                  `protobuf:"varint,3,opt,name=deliveries_scheduled,json=deliveriesScheduled,proto3" json:"deliveries_scheduled,omitempty"` // New deliveries; webhooks that already had one for an event are skipped
+	Success             bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Message             string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ReplayEventsResponse) Reset() {
+	*x = ReplayEventsResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplayEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplayEventsResponse) ProtoMessage() {}
+
+func (x *ReplayEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplayEventsResponse.ProtoReflect.Descriptor instead.
+func (*ReplayEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *ReplayEventsResponse) GetEventsReplayed() int32 {
+	if x != nil {
+		return x.EventsReplayed
+	}
+	return 0
+}
+
+func (x *ReplayEventsResponse) GetEventsExpired() int32 {
+	if x != nil {
+		return x.EventsExpired
+	}
+	return 0
+}
+
+func (x *ReplayEventsResponse) GetDeliveriesScheduled() int32 {
+	if x != nil {
+		return x.DeliveriesScheduled
+	}
+	return 0
+}
+
+func (x *ReplayEventsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReplayEventsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ListEventFailuresRequest represents a request to list event processing failures
+type ListEventFailuresRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                  // Namespace to list failures from (required)
+	Limit         int32                  `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`                         // Maximum failures to return (default: 50, max: 500)
+	PageToken     string                 `protobuf:"bytes,3,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"` // Token from a previous response to fetch the next page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventFailuresRequest) Reset() {
+	*x = ListEventFailuresRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventFailuresRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventFailuresRequest) ProtoMessage() {}
+
+func (x *ListEventFailuresRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventFailuresRequest.ProtoReflect.Descriptor instead.
+func (*ListEventFailuresRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ListEventFailuresRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListEventFailuresRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListEventFailuresRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// EventProcessingFailure represents an event whose deliveries may not all have been scheduled
+type EventProcessingFailure struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	Namespace     string                 `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Event         string                 `protobuf:"bytes,4,opt,name=event,proto3" json:"event,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"` // Error from the last attempt
+	Attempts      int32                  `protobuf:"varint,6,opt,name=attempts,proto3" json:"attempts,omitempty"`
+	FailedAt      int64                  `protobuf:"varint,7,opt,name=failed_at,json=failedAt,proto3" json:"failed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventProcessingFailure) Reset() {
+	*x = EventProcessingFailure{}
+	mi := &file_proto_webhook_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventProcessingFailure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventProcessingFailure) ProtoMessage() {}
+
+func (x *EventProcessingFailure) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventProcessingFailure.ProtoReflect.Descriptor instead.
+func (*EventProcessingFailure) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *EventProcessingFailure) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *EventProcessingFailure) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *EventProcessingFailure) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *EventProcessingFailure) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *EventProcessingFailure) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EventProcessingFailure) GetAttempts() int32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *EventProcessingFailure) GetFailedAt() int64 {
+	if x != nil {
+		return x.FailedAt
+	}
+	return 0
+}
+
+// ListEventFailuresResponse represents the response for listing event processing failures
+type ListEventFailuresResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Failures      []*EventProcessingFailure `protobuf:"bytes,1,rep,name=failures,proto3" json:"failures,omitempty"`
+	NextPageToken string                    `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty when there are no more failures
+	Success       bool                      `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                    `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEventFailuresResponse) Reset() {
+	*x = ListEventFailuresResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEventFailuresResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEventFailuresResponse) ProtoMessage() {}
+
+func (x *ListEventFailuresResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEventFailuresResponse.ProtoReflect.Descriptor instead.
+func (*ListEventFailuresResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ListEventFailuresResponse) GetFailures() []*EventProcessingFailure {
+	if x != nil {
+		return x.Failures
+	}
+	return nil
+}
+
+func (x *ListEventFailuresResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListEventFailuresResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListEventFailuresResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ListDeliveriesRequest represents a request to list a namespace's deliveries
+type ListDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                               // Namespace of the webhooks (required)
+	Status        WebhookDeliveryStatus  `protobuf:"varint,2,opt,name=status,proto3,enum=webhook.WebhookDeliveryStatus" json:"status,omitempty"` // Status to filter by (optional)
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`                                      // Only deliveries created at or after this Unix time (optional)
+	Until         int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`                                      // Only deliveries created before this Unix time (optional)
+	Limit         int32                  `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`                                      // Maximum deliveries to return (default: 50, max: 500)
+	PageToken     string                 `protobuf:"bytes,6,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`              // Token from a previous response to fetch the next page
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesRequest) Reset() {
+	*x = ListDeliveriesRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesRequest) ProtoMessage() {}
+
+func (x *ListDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListDeliveriesRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *ListDeliveriesRequest) GetStatus() WebhookDeliveryStatus {
+	if x != nil {
+		return x.Status
+	}
+	return WebhookDeliveryStatus_DELIVERY_UNKNOWN
+}
+
+func (x *ListDeliveriesRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListDeliveriesRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListDeliveriesRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListDeliveriesRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListDeliveriesResponse represents the response for listing deliveries
+type ListDeliveriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty when there are no more deliveries
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveriesResponse) Reset() {
+	*x = ListDeliveriesResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveriesResponse) ProtoMessage() {}
+
+func (x *ListDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*ListDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListDeliveriesResponse) GetDeliveries() []*WebhookDelivery {
+	if x != nil {
+		return x.Deliveries
+	}
+	return nil
+}
+
+func (x *ListDeliveriesResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+func (x *ListDeliveriesResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListDeliveriesResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetDeliveryRequest represents a request to get a single delivery
+type GetDeliveryRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId     string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`              // Delivery ID to fetch
+	IncludeHeaders bool                   `protobuf:"varint,2,opt,name=include_headers,json=includeHeaders,proto3" json:"include_headers,omitempty"` // Include the request and response headers of the last attempt
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *GetDeliveryRequest) Reset() {
+	*x = GetDeliveryRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryRequest) ProtoMessage() {}
+
+func (x *GetDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *GetDeliveryRequest) GetDeliveryId() string {
+	if x != nil {
+		return x.DeliveryId
+	}
+	return ""
+}
+
+func (x *GetDeliveryRequest) GetIncludeHeaders() bool {
+	if x != nil {
+		return x.IncludeHeaders
+	}
+	return false
+}
+
+// GetDeliveryResponse represents the response for getting a delivery
+type GetDeliveryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryResponse) Reset() {
+	*x = GetDeliveryResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryResponse) ProtoMessage() {}
+
+func (x *GetDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*GetDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *GetDeliveryResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
+	}
+	return nil
+}
+
+func (x *GetDeliveryResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetDeliveryResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetEventRequest represents a request to get a single event
+type GetEventRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"` // Event ID to fetch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventRequest) Reset() {
+	*x = GetEventRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventRequest) ProtoMessage() {}
+
+func (x *GetEventRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventRequest.ProtoReflect.Descriptor instead.
+func (*GetEventRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetEventRequest) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+// GetEventResponse represents the response for getting an event
+type GetEventResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         *StoredEvent           `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetEventResponse) Reset() {
+	*x = GetEventResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetEventResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventResponse) ProtoMessage() {}
+
+func (x *GetEventResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventResponse.ProtoReflect.Descriptor instead.
+func (*GetEventResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetEventResponse) GetEvent() *StoredEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *GetEventResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetEventResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetWebhookRequest represents a request to get a single webhook
+type GetWebhookRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"` // Webhook ID to fetch
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookRequest) Reset() {
+	*x = GetWebhookRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookRequest) ProtoMessage() {}
+
+func (x *GetWebhookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *GetWebhookRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+// GetWebhookResponse represents the response for getting a webhook
+type GetWebhookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhook       *RegisteredWebhook     `protobuf:"bytes,1,opt,name=webhook,proto3" json:"webhook,omitempty"`
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Health        *WebhookHealth         `protobuf:"bytes,4,opt,name=health,proto3" json:"health,omitempty"` // Recent delivery success of the webhook
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookResponse) Reset() {
+	*x = GetWebhookResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookResponse) ProtoMessage() {}
+
+func (x *GetWebhookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *GetWebhookResponse) GetWebhook() *RegisteredWebhook {
+	if x != nil {
+		return x.Webhook
+	}
+	return nil
+}
+
+func (x *GetWebhookResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *GetWebhookResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *GetWebhookResponse) GetHealth() *WebhookHealth {
+	if x != nil {
+		return x.Health
+	}
+	return nil
+}
+
+// WebhookHealth is a webhook's delivery success over the server's rolling health window
+type WebhookHealth struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SuccessRate   float64                `protobuf:"fixed64,1,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"` // Fraction of recent attempts that succeeded (1 without attempts)
+	Attempts      float64                `protobuf:"fixed64,2,opt,name=attempts,proto3" json:"attempts,omitempty"`                          // Recent attempts, older ones weighted down
+	DisabledAt    int64                  `protobuf:"varint,3,opt,name=disabled_at,json=disabledAt,proto3" json:"disabled_at,omitempty"`     // When the webhook was disabled for failing (0 = never)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WebhookHealth) Reset() {
+	*x = WebhookHealth{}
+	mi := &file_proto_webhook_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WebhookHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookHealth) ProtoMessage() {}
+
+func (x *WebhookHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookHealth.ProtoReflect.Descriptor instead.
+func (*WebhookHealth) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *WebhookHealth) GetSuccessRate() float64 {
+	if x != nil {
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *WebhookHealth) GetAttempts() float64 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+func (x *WebhookHealth) GetDisabledAt() int64 {
+	if x != nil {
+		return x.DisabledAt
+	}
+	return 0
+}
+
+// CreateAPIKeyRequest represents a request to create an API key
+type CreateAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespaces    []string               `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`   // Namespaces the key is authorized for
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // Optional description
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyRequest) Reset() {
+	*x = CreateAPIKeyRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyRequest) ProtoMessage() {}
+
+func (x *CreateAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateAPIKeyRequest) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CreateAPIKeyResponse represents the response for API key creation
+type CreateAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`              // Unique key identifier (used to revoke the key)
+	ApiKey        string                 `protobuf:"bytes,2,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`           // The API key; only returned once and never stored in plaintext
+	Namespaces    []string               `protobuf:"bytes,3,rep,name=namespaces,proto3" json:"namespaces,omitempty"`                 // Namespaces the key is authorized for
+	CreatedAt     int64                  `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the key was created
+	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAPIKeyResponse) Reset() {
+	*x = CreateAPIKeyResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAPIKeyResponse) ProtoMessage() {}
+
+func (x *CreateAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*CreateAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CreateAPIKeyResponse) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyResponse) GetApiKey() string {
+	if x != nil {
+		return x.ApiKey
+	}
+	return ""
+}
+
+func (x *CreateAPIKeyResponse) GetNamespaces() []string {
+	if x != nil {
+		return x.Namespaces
+	}
+	return nil
+}
+
+func (x *CreateAPIKeyResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CreateAPIKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateAPIKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// RevokeAPIKeyRequest represents a request to revoke an API key
+type RevokeAPIKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	KeyId         string                 `protobuf:"bytes,1,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"` // Key ID to revoke
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyRequest) Reset() {
+	*x = RevokeAPIKeyRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyRequest) ProtoMessage() {}
+
+func (x *RevokeAPIKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyRequest.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *RevokeAPIKeyRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+// RevokeAPIKeyResponse represents the response for API key revocation
+type RevokeAPIKeyResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeAPIKeyResponse) Reset() {
+	*x = RevokeAPIKeyResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeAPIKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeAPIKeyResponse) ProtoMessage() {}
+
+func (x *RevokeAPIKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeAPIKeyResponse.ProtoReflect.Descriptor instead.
+func (*RevokeAPIKeyResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *RevokeAPIKeyResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *RevokeAPIKeyResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// CreateNamespaceRequest represents a request to register a namespace
+type CreateNamespaceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // Namespace name
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // Optional description
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNamespaceRequest) Reset() {
+	*x = CreateNamespaceRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNamespaceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNamespaceRequest) ProtoMessage() {}
+
+func (x *CreateNamespaceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNamespaceRequest.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CreateNamespaceRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNamespaceRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+// CreateNamespaceResponse represents the response for namespace creation
+type CreateNamespaceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // When the namespace was created
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateNamespaceResponse) Reset() {
+	*x = CreateNamespaceResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateNamespaceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateNamespaceResponse) ProtoMessage() {}
+
+func (x *CreateNamespaceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateNamespaceResponse.ProtoReflect.Descriptor instead.
+func (*CreateNamespaceResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateNamespaceResponse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateNamespaceResponse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CreateNamespaceResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CreateNamespaceResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// SetNamespaceDefaultsRequest represents a request to set a namespace's webhook defaults.
+// It replaces any defaults set before.
+type SetNamespaceDefaultsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Namespace string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace to set defaults for (required)
+	// Headers sent with every delivery to the namespace's webhooks, including ones registered
+	// earlier; a webhook's own header of the same name wins. Supports the same templates.
+	Headers       map[string]string `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	Timeout       int32             `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"` // Timeout in seconds for webhooks registered without one; 0 = server default
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNamespaceDefaultsRequest) Reset() {
+	*x = SetNamespaceDefaultsRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNamespaceDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceDefaultsRequest) ProtoMessage() {}
+
+func (x *SetNamespaceDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*SetNamespaceDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *SetNamespaceDefaultsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SetNamespaceDefaultsRequest) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *SetNamespaceDefaultsRequest) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
+}
+
+// SetNamespaceDefaultsResponse represents the response for setting namespace defaults
+type SetNamespaceDefaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetNamespaceDefaultsResponse) Reset() {
+	*x = SetNamespaceDefaultsResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetNamespaceDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetNamespaceDefaultsResponse) ProtoMessage() {}
+
+func (x *SetNamespaceDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetNamespaceDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*SetNamespaceDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *SetNamespaceDefaultsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *SetNamespaceDefaultsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// GetNamespaceDefaultsRequest represents a request for a namespace's webhook defaults
+type GetNamespaceDefaultsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace to get defaults for (required)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceDefaultsRequest) Reset() {
+	*x = GetNamespaceDefaultsRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceDefaultsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceDefaultsRequest) ProtoMessage() {}
+
+func (x *GetNamespaceDefaultsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceDefaultsRequest.ProtoReflect.Descriptor instead.
+func (*GetNamespaceDefaultsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetNamespaceDefaultsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+// GetNamespaceDefaultsResponse represents a namespace's webhook defaults
+type GetNamespaceDefaultsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	Headers       map[string]string      `protobuf:"bytes,2,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // Headers sent with every delivery
+	Timeout       int32                  `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                          // Timeout for webhooks registered without one; 0 = server default
+	Success       bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNamespaceDefaultsResponse) Reset() {
+	*x = GetNamespaceDefaultsResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNamespaceDefaultsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNamespaceDefaultsResponse) ProtoMessage() {}
+
+func (x *GetNamespaceDefaultsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNamespaceDefaultsResponse.ProtoReflect.Descriptor instead.
+func (*GetNamespaceDefaultsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetNamespaceDefaultsResponse) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetNamespaceDefaultsResponse) GetHeaders() map[string]string {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *GetNamespaceDefaultsResponse) GetTimeout() int32 {
+	if x != nil {
+		return x.Timeout
+	}
+	return 0
 }
 
-// PushEventResponse represents the response for event pushing
-type PushEventResponse struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	EventId           string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                                // Unique event identifier
-	WebhooksTriggered int32                  `protobuf:"varint,2,opt,name=webhooks_triggered,json=webhooksTriggered,proto3" json:"webhooks_triggered,omitempty"` // Number of webhooks triggered
-	WebhookIds        []string               `protobuf:"bytes,3,rep,name=webhook_ids,json=webhookIds,proto3" json:"webhook_ids,omitempty"`                       // IDs of triggered webhooks
-	Success           bool                   `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`                                              // Whether event was processed
-	Message           string                 `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`                                               // Success or error message
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *GetNamespaceDefaultsResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
 }
 
-func (x *PushEventResponse) Reset() {
-	*x = PushEventResponse{}
-	mi := &file_proto_webhook_proto_msgTypes[5]
+func (x *GetNamespaceDefaultsResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ExtendDeliveryTTLRequest represents a request to retry an expired delivery
+type ExtendDeliveryTTLRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryId    string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`  // Expired delivery to schedule again
+	TtlSeconds    int64                  `protobuf:"varint,2,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"` // New time to live from now, at most 24 hours
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendDeliveryTTLRequest) Reset() {
+	*x = ExtendDeliveryTTLRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *PushEventResponse) String() string {
+func (x *ExtendDeliveryTTLRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PushEventResponse) ProtoMessage() {}
+func (*ExtendDeliveryTTLRequest) ProtoMessage() {}
 
-func (x *PushEventResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[5]
+func (x *ExtendDeliveryTTLRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -457,74 +4819,112 @@ func (x *PushEventResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PushEventResponse.ProtoReflect.Descriptor instead.
-func (*PushEventResponse) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ExtendDeliveryTTLRequest.ProtoReflect.Descriptor instead.
+func (*ExtendDeliveryTTLRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *PushEventResponse) GetEventId() string {
+func (x *ExtendDeliveryTTLRequest) GetDeliveryId() string {
 	if x != nil {
-		return x.EventId
+		return x.DeliveryId
 	}
 	return ""
 }
 
-func (x *PushEventResponse) GetWebhooksTriggered() int32 {
+func (x *ExtendDeliveryTTLRequest) GetTtlSeconds() int64 {
 	if x != nil {
-		return x.WebhooksTriggered
+		return x.TtlSeconds
 	}
 	return 0
 }
 
-func (x *PushEventResponse) GetWebhookIds() []string {
+// ExtendDeliveryTTLResponse represents the response for extending a delivery's TTL
+type ExtendDeliveryTTLResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Delivery      *WebhookDelivery       `protobuf:"bytes,1,opt,name=delivery,proto3" json:"delivery,omitempty"` // The rescheduled delivery, with its new expires_at
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExtendDeliveryTTLResponse) Reset() {
+	*x = ExtendDeliveryTTLResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExtendDeliveryTTLResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExtendDeliveryTTLResponse) ProtoMessage() {}
+
+func (x *ExtendDeliveryTTLResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[61]
 	if x != nil {
-		return x.WebhookIds
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExtendDeliveryTTLResponse.ProtoReflect.Descriptor instead.
+func (*ExtendDeliveryTTLResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ExtendDeliveryTTLResponse) GetDelivery() *WebhookDelivery {
+	if x != nil {
+		return x.Delivery
 	}
 	return nil
 }
 
-func (x *PushEventResponse) GetSuccess() bool {
+func (x *ExtendDeliveryTTLResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *PushEventResponse) GetMessage() string {
+func (x *ExtendDeliveryTTLResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// GetWebhookStatusRequest represents a request to get webhook status
-type GetWebhookStatusRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Types that are valid to be assigned to Identifier:
-	//
-	//	*GetWebhookStatusRequest_WebhookId
-	//	*GetWebhookStatusRequest_EventId
-	Identifier    isGetWebhookStatusRequest_Identifier `protobuf_oneof:"identifier"`
-	Namespace     string                               `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"` // Optional namespace filter
+// ListAllWebhooksRequest represents a request to list webhooks across all namespaces
+type ListAllWebhooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ActiveOnly    bool                   `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`   // Only return active webhooks
+	UrlContains   string                 `protobuf:"bytes,2,opt,name=url_contains,json=urlContains,proto3" json:"url_contains,omitempty"` // Only return webhooks whose URL contains this substring (optional)
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                               // Maximum webhooks to return (default: 50, max: 500)
+	PageToken     string                 `protobuf:"bytes,4,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`       // Token from a previous response to fetch the next page
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWebhookStatusRequest) Reset() {
-	*x = GetWebhookStatusRequest{}
-	mi := &file_proto_webhook_proto_msgTypes[6]
+func (x *ListAllWebhooksRequest) Reset() {
+	*x = ListAllWebhooksRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWebhookStatusRequest) String() string {
+func (x *ListAllWebhooksRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWebhookStatusRequest) ProtoMessage() {}
+func (*ListAllWebhooksRequest) ProtoMessage() {}
 
-func (x *GetWebhookStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[6]
+func (x *ListAllWebhooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -535,94 +4935,201 @@ func (x *GetWebhookStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWebhookStatusRequest.ProtoReflect.Descriptor instead.
-func (*GetWebhookStatusRequest) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ListAllWebhooksRequest.ProtoReflect.Descriptor instead.
+func (*ListAllWebhooksRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *GetWebhookStatusRequest) GetIdentifier() isGetWebhookStatusRequest_Identifier {
+func (x *ListAllWebhooksRequest) GetActiveOnly() bool {
 	if x != nil {
-		return x.Identifier
+		return x.ActiveOnly
 	}
-	return nil
+	return false
 }
 
-func (x *GetWebhookStatusRequest) GetWebhookId() string {
+func (x *ListAllWebhooksRequest) GetUrlContains() string {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetWebhookStatusRequest_WebhookId); ok {
-			return x.WebhookId
-		}
+		return x.UrlContains
 	}
 	return ""
 }
 
-func (x *GetWebhookStatusRequest) GetEventId() string {
+func (x *ListAllWebhooksRequest) GetLimit() int32 {
 	if x != nil {
-		if x, ok := x.Identifier.(*GetWebhookStatusRequest_EventId); ok {
-			return x.EventId
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *ListAllWebhooksRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+// ListAllWebhooksResponse represents the response for listing webhooks across all namespaces
+type ListAllWebhooksResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Webhooks      []*RegisteredWebhook   `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
+	NextPageToken string                 `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"` // Empty when there are no more webhooks
+	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListAllWebhooksResponse) Reset() {
+	*x = ListAllWebhooksResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListAllWebhooksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAllWebhooksResponse) ProtoMessage() {}
+
+func (x *ListAllWebhooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
 		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAllWebhooksResponse.ProtoReflect.Descriptor instead.
+func (*ListAllWebhooksResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ListAllWebhooksResponse) GetWebhooks() []*RegisteredWebhook {
+	if x != nil {
+		return x.Webhooks
+	}
+	return nil
+}
+
+func (x *ListAllWebhooksResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
 	}
 	return ""
 }
 
-func (x *GetWebhookStatusRequest) GetNamespace() string {
+func (x *ListAllWebhooksResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Namespace
+		return x.Success
+	}
+	return false
+}
+
+func (x *ListAllWebhooksResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
 	}
 	return ""
 }
 
-type isGetWebhookStatusRequest_Identifier interface {
-	isGetWebhookStatusRequest_Identifier()
+// GetDeliveryStatsRequest represents a request for aggregate delivery statistics
+type GetDeliveryStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace to aggregate (required)
+	Since         int64                  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`        // Only deliveries created at or after this Unix time (default: 24 hours before until)
+	Until         int64                  `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`        // Only deliveries created before this Unix time (default: now)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-type GetWebhookStatusRequest_WebhookId struct {
-	WebhookId string `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3,oneof"` // Get status for specific webhook
+func (x *GetDeliveryStatsRequest) Reset() {
+	*x = GetDeliveryStatsRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-type GetWebhookStatusRequest_EventId struct {
-	EventId string `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3,oneof"` // Get status for specific event
+func (x *GetDeliveryStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWebhookStatusRequest_WebhookId) isGetWebhookStatusRequest_Identifier() {}
+func (*GetDeliveryStatsRequest) ProtoMessage() {}
 
-func (*GetWebhookStatusRequest_EventId) isGetWebhookStatusRequest_Identifier() {}
+func (x *GetDeliveryStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-// WebhookDelivery represents a single webhook delivery attempt
-type WebhookDelivery struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	DeliveryId      string                 `protobuf:"bytes,1,opt,name=delivery_id,json=deliveryId,proto3" json:"delivery_id,omitempty"`                   // Unique delivery identifier
-	WebhookId       string                 `protobuf:"bytes,2,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`                      // Associated webhook ID
-	EventId         string                 `protobuf:"bytes,3,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`                            // Associated event ID
-	Status          WebhookDeliveryStatus  `protobuf:"varint,4,opt,name=status,proto3,enum=webhook.WebhookDeliveryStatus" json:"status,omitempty"`         // Current delivery status
-	AttemptCount    int32                  `protobuf:"varint,5,opt,name=attempt_count,json=attemptCount,proto3" json:"attempt_count,omitempty"`            // Number of delivery attempts
-	MaxAttempts     int32                  `protobuf:"varint,6,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`               // Maximum retry attempts
-	CreatedAt       int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                     // When delivery was created
-	LastAttemptedAt int64                  `protobuf:"varint,8,opt,name=last_attempted_at,json=lastAttemptedAt,proto3" json:"last_attempted_at,omitempty"` // Last attempt timestamp
-	NextRetryAt     int64                  `protobuf:"varint,9,opt,name=next_retry_at,json=nextRetryAt,proto3" json:"next_retry_at,omitempty"`             // Next retry timestamp
-	ExpiresAt       int64                  `protobuf:"varint,10,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`                    // When delivery expires (TTL)
-	ResponseCode    int32                  `protobuf:"varint,11,opt,name=response_code,json=responseCode,proto3" json:"response_code,omitempty"`           // HTTP response code from last attempt
-	ResponseBody    string                 `protobuf:"bytes,12,opt,name=response_body,json=responseBody,proto3" json:"response_body,omitempty"`            // HTTP response body (truncated)
-	ErrorMessage    string                 `protobuf:"bytes,13,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`            // Error message if failed
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// Deprecated: Use GetDeliveryStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryStatsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{64}
 }
 
-func (x *WebhookDelivery) Reset() {
-	*x = WebhookDelivery{}
-	mi := &file_proto_webhook_proto_msgTypes[7]
+func (x *GetDeliveryStatsRequest) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *GetDeliveryStatsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *GetDeliveryStatsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+// DeliveryStats are delivery counts by status and last-attempt latency percentiles
+type DeliveryStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int64                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Pending       int64                  `protobuf:"varint,2,opt,name=pending,proto3" json:"pending,omitempty"`
+	Sending       int64                  `protobuf:"varint,3,opt,name=sending,proto3" json:"sending,omitempty"`
+	Success       int64                  `protobuf:"varint,4,opt,name=success,proto3" json:"success,omitempty"`
+	Failed        int64                  `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	Retrying      int64                  `protobuf:"varint,6,opt,name=retrying,proto3" json:"retrying,omitempty"`
+	Expired       int64                  `protobuf:"varint,7,opt,name=expired,proto3" json:"expired,omitempty"`
+	SuccessRate   float64                `protobuf:"fixed64,8,opt,name=success_rate,json=successRate,proto3" json:"success_rate,omitempty"`       // Fraction of finished (succeeded, failed or expired) deliveries that succeeded
+	LatencyP50Ms  float64                `protobuf:"fixed64,9,opt,name=latency_p50_ms,json=latencyP50Ms,proto3" json:"latency_p50_ms,omitempty"`  // Median request duration of each delivery's last attempt
+	LatencyP95Ms  float64                `protobuf:"fixed64,10,opt,name=latency_p95_ms,json=latencyP95Ms,proto3" json:"latency_p95_ms,omitempty"` // 95th percentile request duration of each delivery's last attempt
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeliveryStats) Reset() {
+	*x = DeliveryStats{}
+	mi := &file_proto_webhook_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WebhookDelivery) String() string {
+func (x *DeliveryStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WebhookDelivery) ProtoMessage() {}
+func (*DeliveryStats) ProtoMessage() {}
 
-func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[7]
+func (x *DeliveryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -633,128 +5140,162 @@ func (x *WebhookDelivery) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WebhookDelivery.ProtoReflect.Descriptor instead.
-func (*WebhookDelivery) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use DeliveryStats.ProtoReflect.Descriptor instead.
+func (*DeliveryStats) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{65}
 }
 
-func (x *WebhookDelivery) GetDeliveryId() string {
+func (x *DeliveryStats) GetTotal() int64 {
 	if x != nil {
-		return x.DeliveryId
+		return x.Total
 	}
-	return ""
+	return 0
 }
 
-func (x *WebhookDelivery) GetWebhookId() string {
+func (x *DeliveryStats) GetPending() int64 {
 	if x != nil {
-		return x.WebhookId
+		return x.Pending
 	}
-	return ""
+	return 0
 }
 
-func (x *WebhookDelivery) GetEventId() string {
+func (x *DeliveryStats) GetSending() int64 {
 	if x != nil {
-		return x.EventId
+		return x.Sending
 	}
-	return ""
+	return 0
 }
 
-func (x *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+func (x *DeliveryStats) GetSuccess() int64 {
 	if x != nil {
-		return x.Status
+		return x.Success
 	}
-	return WebhookDeliveryStatus_DELIVERY_UNKNOWN
+	return 0
 }
 
-func (x *WebhookDelivery) GetAttemptCount() int32 {
+func (x *DeliveryStats) GetFailed() int64 {
 	if x != nil {
-		return x.AttemptCount
+		return x.Failed
 	}
 	return 0
 }
 
-func (x *WebhookDelivery) GetMaxAttempts() int32 {
+func (x *DeliveryStats) GetRetrying() int64 {
 	if x != nil {
-		return x.MaxAttempts
+		return x.Retrying
 	}
 	return 0
 }
 
-func (x *WebhookDelivery) GetCreatedAt() int64 {
+func (x *DeliveryStats) GetExpired() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.Expired
 	}
 	return 0
 }
 
-func (x *WebhookDelivery) GetLastAttemptedAt() int64 {
+func (x *DeliveryStats) GetSuccessRate() float64 {
 	if x != nil {
-		return x.LastAttemptedAt
+		return x.SuccessRate
+	}
+	return 0
+}
+
+func (x *DeliveryStats) GetLatencyP50Ms() float64 {
+	if x != nil {
+		return x.LatencyP50Ms
+	}
+	return 0
+}
+
+func (x *DeliveryStats) GetLatencyP95Ms() float64 {
+	if x != nil {
+		return x.LatencyP95Ms
 	}
 	return 0
 }
 
-func (x *WebhookDelivery) GetNextRetryAt() int64 {
-	if x != nil {
-		return x.NextRetryAt
-	}
-	return 0
-}
+// EventDeliveryStats are the delivery statistics of one event name
+type EventDeliveryStats struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Stats         *DeliveryStats         `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventDeliveryStats) Reset() {
+	*x = EventDeliveryStats{}
+	mi := &file_proto_webhook_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventDeliveryStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventDeliveryStats) ProtoMessage() {}
 
-func (x *WebhookDelivery) GetExpiresAt() int64 {
+func (x *EventDeliveryStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[66]
 	if x != nil {
-		return x.ExpiresAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *WebhookDelivery) GetResponseCode() int32 {
-	if x != nil {
-		return x.ResponseCode
-	}
-	return 0
+// Deprecated: Use EventDeliveryStats.ProtoReflect.Descriptor instead.
+func (*EventDeliveryStats) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *WebhookDelivery) GetResponseBody() string {
+func (x *EventDeliveryStats) GetEvent() string {
 	if x != nil {
-		return x.ResponseBody
+		return x.Event
 	}
 	return ""
 }
 
-func (x *WebhookDelivery) GetErrorMessage() string {
+func (x *EventDeliveryStats) GetStats() *DeliveryStats {
 	if x != nil {
-		return x.ErrorMessage
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-// GetWebhookStatusResponse represents the response for webhook status
-type GetWebhookStatusResponse struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Deliveries      []*WebhookDelivery     `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
-	TotalDeliveries int32                  `protobuf:"varint,2,opt,name=total_deliveries,json=totalDeliveries,proto3" json:"total_deliveries,omitempty"`
-	Success         bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Message         string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+// GetDeliveryStatsResponse represents the response for delivery statistics
+type GetDeliveryStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Totals        *DeliveryStats         `protobuf:"bytes,1,opt,name=totals,proto3" json:"totals,omitempty"`
+	Events        []*EventDeliveryStats  `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"` // Busiest events first
+	Since         int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`  // Start of the aggregated range
+	Until         int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`  // End of the aggregated range
+	Success       bool                   `protobuf:"varint,5,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWebhookStatusResponse) Reset() {
-	*x = GetWebhookStatusResponse{}
-	mi := &file_proto_webhook_proto_msgTypes[8]
+func (x *GetDeliveryStatsResponse) Reset() {
+	*x = GetDeliveryStatsResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWebhookStatusResponse) String() string {
+func (x *GetDeliveryStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWebhookStatusResponse) ProtoMessage() {}
+func (*GetDeliveryStatsResponse) ProtoMessage() {}
 
-func (x *GetWebhookStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[8]
+func (x *GetDeliveryStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -765,64 +5306,78 @@ func (x *GetWebhookStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWebhookStatusResponse.ProtoReflect.Descriptor instead.
-func (*GetWebhookStatusResponse) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use GetDeliveryStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetDeliveryStatsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *GetWebhookStatusResponse) GetDeliveries() []*WebhookDelivery {
+func (x *GetDeliveryStatsResponse) GetTotals() *DeliveryStats {
 	if x != nil {
-		return x.Deliveries
+		return x.Totals
 	}
 	return nil
 }
 
-func (x *GetWebhookStatusResponse) GetTotalDeliveries() int32 {
+func (x *GetDeliveryStatsResponse) GetEvents() []*EventDeliveryStats {
 	if x != nil {
-		return x.TotalDeliveries
+		return x.Events
+	}
+	return nil
+}
+
+func (x *GetDeliveryStatsResponse) GetSince() int64 {
+	if x != nil {
+		return x.Since
 	}
 	return 0
 }
 
-func (x *GetWebhookStatusResponse) GetSuccess() bool {
+func (x *GetDeliveryStatsResponse) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *GetDeliveryStatsResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *GetWebhookStatusResponse) GetMessage() string {
+func (x *GetDeliveryStatsResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
 	return ""
 }
 
-// ListWebhooksRequest represents a request to list webhooks
-type ListWebhooksRequest struct {
+// ListEventTypesRequest represents a request to list a namespace's event names
+type ListEventTypesRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`                      // Namespace to filter by
-	Event         string                 `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`                              // Event to filter by (optional)
-	ActiveOnly    bool                   `protobuf:"varint,3,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"` // Only return active webhooks
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"` // Namespace to list (required)
+	Since         int64                  `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`        // Only count events pushed at or after this Unix time (optional)
+	Until         int64                  `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`        // Only count events pushed before this Unix time (optional)
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWebhooksRequest) Reset() {
-	*x = ListWebhooksRequest{}
-	mi := &file_proto_webhook_proto_msgTypes[9]
+func (x *ListEventTypesRequest) Reset() {
+	*x = ListEventTypesRequest{}
+	mi := &file_proto_webhook_proto_msgTypes[68]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWebhooksRequest) String() string {
+func (x *ListEventTypesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWebhooksRequest) ProtoMessage() {}
+func (*ListEventTypesRequest) ProtoMessage() {}
 
-func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[9]
+func (x *ListEventTypesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[68]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -833,64 +5388,58 @@ func (x *ListWebhooksRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWebhooksRequest.ProtoReflect.Descriptor instead.
-func (*ListWebhooksRequest) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use ListEventTypesRequest.ProtoReflect.Descriptor instead.
+func (*ListEventTypesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *ListWebhooksRequest) GetNamespace() string {
+func (x *ListEventTypesRequest) GetNamespace() string {
 	if x != nil {
 		return x.Namespace
 	}
 	return ""
 }
 
-func (x *ListWebhooksRequest) GetEvent() string {
+func (x *ListEventTypesRequest) GetSince() int64 {
 	if x != nil {
-		return x.Event
+		return x.Since
 	}
-	return ""
+	return 0
 }
 
-func (x *ListWebhooksRequest) GetActiveOnly() bool {
+func (x *ListEventTypesRequest) GetUntil() int64 {
 	if x != nil {
-		return x.ActiveOnly
+		return x.Until
 	}
-	return false
+	return 0
 }
 
-// RegisteredWebhook represents a registered webhook
-type RegisteredWebhook struct {
+// EventType is an event name used in a namespace
+type EventType struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`                                                      // Unique webhook identifier
-	Namespace     string                 `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`                                                                       // Webhook namespace
-	Events        []string               `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`                                                                             // Events the webhook listens for
-	Url           string                 `protobuf:"bytes,4,opt,name=url,proto3" json:"url,omitempty"`                                                                                   // Target URL
-	Headers       map[string]string      `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"` // HTTP headers
-	Timeout       int32                  `protobuf:"varint,6,opt,name=timeout,proto3" json:"timeout,omitempty"`                                                                          // Timeout in seconds
-	Active        bool                   `protobuf:"varint,7,opt,name=active,proto3" json:"active,omitempty"`                                                                            // Whether webhook is active
-	Description   string                 `protobuf:"bytes,8,opt,name=description,proto3" json:"description,omitempty"`                                                                   // Webhook description
-	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                                                     // When webhook was registered
-	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`                                                    // When webhook was last updated
+	Event         string                 `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	Count         int64                  `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`                               // Stored events with this name in the requested range
+	LastSeenAt    int64                  `protobuf:"varint,3,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"` // When the latest of them was pushed (0 = none)
+	Registered    bool                   `protobuf:"varint,4,opt,name=registered,proto3" json:"registered,omitempty"`                     // A webhook in the namespace subscribes to it
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RegisteredWebhook) Reset() {
-	*x = RegisteredWebhook{}
-	mi := &file_proto_webhook_proto_msgTypes[10]
+func (x *EventType) Reset() {
+	*x = EventType{}
+	mi := &file_proto_webhook_proto_msgTypes[69]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RegisteredWebhook) String() string {
+func (x *EventType) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RegisteredWebhook) ProtoMessage() {}
+func (*EventType) ProtoMessage() {}
 
-func (x *RegisteredWebhook) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[10]
+func (x *EventType) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[69]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -901,107 +5450,64 @@ func (x *RegisteredWebhook) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RegisteredWebhook.ProtoReflect.Descriptor instead.
-func (*RegisteredWebhook) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *RegisteredWebhook) GetWebhookId() string {
-	if x != nil {
-		return x.WebhookId
-	}
-	return ""
-}
-
-func (x *RegisteredWebhook) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *RegisteredWebhook) GetEvents() []string {
-	if x != nil {
-		return x.Events
-	}
-	return nil
+// Deprecated: Use EventType.ProtoReflect.Descriptor instead.
+func (*EventType) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{69}
 }
 
-func (x *RegisteredWebhook) GetUrl() string {
+func (x *EventType) GetEvent() string {
 	if x != nil {
-		return x.Url
+		return x.Event
 	}
 	return ""
 }
 
-func (x *RegisteredWebhook) GetHeaders() map[string]string {
-	if x != nil {
-		return x.Headers
-	}
-	return nil
-}
-
-func (x *RegisteredWebhook) GetTimeout() int32 {
+func (x *EventType) GetCount() int64 {
 	if x != nil {
-		return x.Timeout
+		return x.Count
 	}
 	return 0
 }
 
-func (x *RegisteredWebhook) GetActive() bool {
-	if x != nil {
-		return x.Active
-	}
-	return false
-}
-
-func (x *RegisteredWebhook) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-func (x *RegisteredWebhook) GetCreatedAt() int64 {
+func (x *EventType) GetLastSeenAt() int64 {
 	if x != nil {
-		return x.CreatedAt
+		return x.LastSeenAt
 	}
 	return 0
 }
 
-func (x *RegisteredWebhook) GetUpdatedAt() int64 {
+func (x *EventType) GetRegistered() bool {
 	if x != nil {
-		return x.UpdatedAt
+		return x.Registered
 	}
-	return 0
+	return false
 }
 
-// ListWebhooksResponse represents the response for listing webhooks
-type ListWebhooksResponse struct {
+// ListEventTypesResponse represents the response for listing event names
+type ListEventTypesResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Webhooks      []*RegisteredWebhook   `protobuf:"bytes,1,rep,name=webhooks,proto3" json:"webhooks,omitempty"`
-	TotalCount    int32                  `protobuf:"varint,2,opt,name=total_count,json=totalCount,proto3" json:"total_count,omitempty"`
-	Success       bool                   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	EventTypes    []*EventType           `protobuf:"bytes,1,rep,name=event_types,json=eventTypes,proto3" json:"event_types,omitempty"` // Sorted by name
+	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListWebhooksResponse) Reset() {
-	*x = ListWebhooksResponse{}
-	mi := &file_proto_webhook_proto_msgTypes[11]
+func (x *ListEventTypesResponse) Reset() {
+	*x = ListEventTypesResponse{}
+	mi := &file_proto_webhook_proto_msgTypes[70]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListWebhooksResponse) String() string {
+func (x *ListEventTypesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListWebhooksResponse) ProtoMessage() {}
+func (*ListEventTypesResponse) ProtoMessage() {}
 
-func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_webhook_proto_msgTypes[11]
+func (x *ListEventTypesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_webhook_proto_msgTypes[70]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1012,33 +5518,26 @@ func (x *ListWebhooksResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListWebhooksResponse.ProtoReflect.Descriptor instead.
-func (*ListWebhooksResponse) Descriptor() ([]byte, []int) {
-	return file_proto_webhook_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use ListEventTypesResponse.ProtoReflect.Descriptor instead.
+func (*ListEventTypesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_webhook_proto_rawDescGZIP(), []int{70}
 }
 
-func (x *ListWebhooksResponse) GetWebhooks() []*RegisteredWebhook {
+func (x *ListEventTypesResponse) GetEventTypes() []*EventType {
 	if x != nil {
-		return x.Webhooks
+		return x.EventTypes
 	}
 	return nil
 }
 
-func (x *ListWebhooksResponse) GetTotalCount() int32 {
-	if x != nil {
-		return x.TotalCount
-	}
-	return 0
-}
-
-func (x *ListWebhooksResponse) GetSuccess() bool {
+func (x *ListEventTypesResponse) GetSuccess() bool {
 	if x != nil {
 		return x.Success
 	}
 	return false
 }
 
-func (x *ListWebhooksResponse) GetMessage() string {
+func (x *ListEventTypesResponse) GetMessage() string {
 	if x != nil {
 		return x.Message
 	}
@@ -1049,7 +5548,7 @@ var File_proto_webhook_proto protoreflect.FileDescriptor
 
 const file_proto_webhook_proto_rawDesc = "" +
 	"\n" +
-	"\x13proto/webhook.proto\x12\awebhook\"\xb8\x02\n" +
+	"\x13proto/webhook.proto\x12\awebhook\"\x83\v\n" +
 	"\x16RegisterWebhookRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x16\n" +
 	"\x06events\x18\x02 \x03(\tR\x06events\x12\x10\n" +
@@ -1057,10 +5556,48 @@ const file_proto_webhook_proto_rawDesc = "" +
 	"\aheaders\x18\x04 \x03(\v2,.webhook.RegisterWebhookRequest.HeadersEntryR\aheaders\x12\x18\n" +
 	"\atimeout\x18\x05 \x01(\x05R\atimeout\x12\x16\n" +
 	"\x06active\x18\x06 \x01(\bR\x06active\x12 \n" +
-	"\vdescription\x18\a \x01(\tR\vdescription\x1a:\n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12!\n" +
+	"\fmax_attempts\x18\b \x01(\x05R\vmaxAttempts\x12!\n" +
+	"\fcontent_type\x18\t \x01(\tR\vcontentType\x129\n" +
+	"\x19max_stored_response_bytes\x18\n" +
+	" \x01(\x05R\x16maxStoredResponseBytes\x12:\n" +
+	"\x19disable_trace_propagation\x18\v \x01(\bR\x17disableTracePropagation\x12\x18\n" +
+	"\aordered\x18\f \x01(\bR\aordered\x12%\n" +
+	"\x0einclude_fields\x18\r \x03(\tR\rincludeFields\x12%\n" +
+	"\x0eexclude_fields\x18\x0e \x03(\tR\rexcludeFields\x12\x1a\n" +
+	"\bpriority\x18\x0f \x01(\x05R\bpriority\x12#\n" +
+	"\rfallback_urls\x18\x10 \x03(\tR\ffallbackUrls\x12M\n" +
+	"\x14success_body_matcher\x18\x11 \x01(\v2\x1b.webhook.SuccessBodyMatcherR\x12successBodyMatcher\x12(\n" +
+	"\x04auth\x18\x12 \x01(\v2\x14.webhook.WebhookAuthR\x04auth\x12\"\n" +
+	"\rmax_in_flight\x18\x13 \x01(\x05R\vmaxInFlight\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x14 \x01(\x03R\texpiresAt\x12#\n" +
+	"\rcompress_body\x18\x15 \x01(\bR\fcompressBody\x12&\n" +
+	"\x0fbatch_window_ms\x18\x16 \x01(\x05R\rbatchWindowMs\x12$\n" +
+	"\x0ebatch_max_size\x18\x17 \x01(\x05R\fbatchMaxSize\x124\n" +
+	"\x16retry_schedule_seconds\x18\x18 \x03(\x05R\x14retryScheduleSeconds\x12S\n" +
+	"\fquery_params\x18\x19 \x03(\v20.webhook.RegisterWebhookRequest.QueryParamsEntryR\vqueryParams\x12\\\n" +
+	"\x0fmetadata_filter\x18\x1a \x03(\v23.webhook.RegisterWebhookRequest.MetadataFilterEntryR\x0emetadataFilter\x12%\n" +
+	"\x0esigning_secret\x18\x1b \x01(\tR\rsigningSecret\x12!\n" +
+	"\fbypass_proxy\x18\x1c \x01(\bR\vbypassProxy\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x8b\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10QueryParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\x13MetadataFilterEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x89\x01\n" +
+	"\vWebhookAuth\x12,\n" +
+	"\x04type\x18\x01 \x01(\x0e2\x18.webhook.WebhookAuthTypeR\x04type\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x14\n" +
+	"\x05token\x18\x04 \x01(\tR\x05token\"n\n" +
+	"\x12SuccessBodyMatcher\x12\x1b\n" +
+	"\tjson_path\x18\x01 \x01(\tR\bjsonPath\x12%\n" +
+	"\x0eexpected_value\x18\x02 \x01(\tR\rexpectedValue\x12\x14\n" +
+	"\x05regex\x18\x03 \x01(\tR\x05regex\"\x8b\x01\n" +
 	"\x17RegisterWebhookResponse\x12\x1d\n" +
 	"\n" +
 	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x18\n" +
@@ -1073,31 +5610,103 @@ const file_proto_webhook_proto_rawDesc = "" +
 	"webhook_id\x18\x01 \x01(\tR\twebhookId\"O\n" +
 	"\x19UnregisterWebhookResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x83\x02\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"4\n" +
+	"\x13PauseWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\"J\n" +
+	"\x14PauseWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"5\n" +
+	"\x14ResumeWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\"K\n" +
+	"\x15ResumeWebhookResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\x85\x01\n" +
+	"\x13RotateSecretRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x1d\n" +
+	"\n" +
+	"new_secret\x18\x02 \x01(\tR\tnewSecret\x120\n" +
+	"\x14grace_period_seconds\x18\x03 \x01(\x03R\x12gracePeriodSeconds\"\x87\x01\n" +
+	"\x14RotateSecretResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12;\n" +
+	"\x1aprevious_secret_expires_at\x18\x03 \x01(\x03R\x17previousSecretExpiresAt\"K\n" +
+	"\x15DeleteWebhooksRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\"q\n" +
+	"\x16DeleteWebhooksResponse\x12#\n" +
+	"\rdeleted_count\x18\x01 \x01(\x05R\fdeletedCount\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"h\n" +
+	"\x1aRegisterEventSchemaRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x16\n" +
+	"\x06schema\x18\x03 \x01(\tR\x06schema\"Q\n" +
+	"\x1bRegisterEventSchemaResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xe0\x03\n" +
 	"\x10PushEventRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05event\x18\x02 \x01(\tR\x05event\x12\x18\n" +
 	"\apayload\x18\x03 \x01(\tR\apayload\x12\x1f\n" +
 	"\vttl_seconds\x18\x04 \x01(\x03R\n" +
 	"ttlSeconds\x12C\n" +
-	"\bmetadata\x18\x05 \x03(\v2'.webhook.PushEventRequest.MetadataEntryR\bmetadata\x1a;\n" +
+	"\bmetadata\x18\x05 \x03(\v2'.webhook.PushEventRequest.MetadataEntryR\bmetadata\x12!\n" +
+	"\fcontent_type\x18\x06 \x01(\tR\vcontentType\x12:\n" +
+	"\x19delivery_timeout_override\x18\a \x01(\x05R\x17deliveryTimeoutOverride\x12\x1a\n" +
+	"\bpriority\x18\b \x01(\x05R\bpriority\x12\x1d\n" +
+	"\n" +
+	"deliver_at\x18\t \x01(\x03R\tdeliverAt\x12 \n" +
+	"\vsynchronous\x18\n" +
+	" \x01(\bR\vsynchronous\x12\x1f\n" +
+	"\vraw_payload\x18\v \x01(\fR\n" +
+	"rawPayload\x1a;\n" +
 	"\rMetadataEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
-	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb2\x01\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x87\x02\n" +
 	"\x11PushEventResponse\x12\x19\n" +
 	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12-\n" +
 	"\x12webhooks_triggered\x18\x02 \x01(\x05R\x11webhooksTriggered\x12\x1f\n" +
 	"\vwebhook_ids\x18\x03 \x03(\tR\n" +
 	"webhookIds\x12\x18\n" +
 	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x05 \x01(\tR\amessage\"\x83\x01\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x12S\n" +
+	"\x16synchronous_deliveries\x18\x06 \x03(\v2\x1c.webhook.SynchronousDeliveryR\x15synchronousDeliveries\"\xd7\x01\n" +
+	"\x13SynchronousDelivery\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x1f\n" +
+	"\vdelivery_id\x18\x02 \x01(\tR\n" +
+	"deliveryId\x126\n" +
+	"\x06status\x18\x03 \x01(\x0e2\x1e.webhook.WebhookDeliveryStatusR\x06status\x12#\n" +
+	"\rresponse_code\x18\x04 \x01(\x05R\fresponseCode\x12#\n" +
+	"\rerror_message\x18\x05 \x01(\tR\ferrorMessage\"F\n" +
+	"\x11PushEventsRequest\x121\n" +
+	"\x06events\x18\x01 \x03(\v2\x19.webhook.PushEventRequestR\x06events\"\xc2\x01\n" +
+	"\x0fPushEventResult\x12\x14\n" +
+	"\x05index\x18\x01 \x01(\x05R\x05index\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12-\n" +
+	"\x12webhooks_triggered\x18\x03 \x01(\x05R\x11webhooksTriggered\x12\x1f\n" +
+	"\vwebhook_ids\x18\x04 \x03(\tR\n" +
+	"webhookIds\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\x12\x14\n" +
+	"\x05error\x18\x06 \x01(\tR\x05error\"\xca\x01\n" +
+	"\x12PushEventsResponse\x122\n" +
+	"\aresults\x18\x01 \x03(\v2\x18.webhook.PushEventResultR\aresults\x12%\n" +
+	"\x0eaccepted_count\x18\x02 \x01(\x05R\racceptedCount\x12%\n" +
+	"\x0erejected_count\x18\x03 \x01(\x05R\rrejectedCount\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"\xf3\x01\n" +
 	"\x17GetWebhookStatusRequest\x12\x1f\n" +
 	"\n" +
 	"webhook_id\x18\x01 \x01(\tH\x00R\twebhookId\x12\x1b\n" +
 	"\bevent_id\x18\x02 \x01(\tH\x00R\aeventId\x12\x1c\n" +
-	"\tnamespace\x18\x03 \x01(\tR\tnamespaceB\f\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12E\n" +
+	"\x0efailure_reason\x18\x04 \x01(\x0e2\x1e.webhook.DeliveryFailureReasonR\rfailureReason\x12'\n" +
+	"\x0finclude_headers\x18\x05 \x01(\bR\x0eincludeHeadersB\f\n" +
 	"\n" +
-	"identifier\"\xe9\x03\n" +
+	"identifier\"\xee\a\n" +
 	"\x0fWebhookDelivery\x12\x1f\n" +
 	"\vdelivery_id\x18\x01 \x01(\tR\n" +
 	"deliveryId\x12\x1d\n" +
@@ -1116,19 +5725,54 @@ const file_proto_webhook_proto_rawDesc = "" +
 	" \x01(\x03R\texpiresAt\x12#\n" +
 	"\rresponse_code\x18\v \x01(\x05R\fresponseCode\x12#\n" +
 	"\rresponse_body\x18\f \x01(\tR\fresponseBody\x12#\n" +
-	"\rerror_message\x18\r \x01(\tR\ferrorMessage\"\xb3\x01\n" +
+	"\rerror_message\x18\r \x01(\tR\ferrorMessage\x122\n" +
+	"\x15response_content_type\x18\x0e \x01(\tR\x13responseContentType\x12!\n" +
+	"\fresponse_url\x18\x0f \x01(\tR\vresponseUrl\x12E\n" +
+	"\x0efailure_reason\x18\x10 \x01(\x0e2\x1e.webhook.DeliveryFailureReasonR\rfailureReason\x12U\n" +
+	"\x0frequest_headers\x18\x11 \x03(\v2,.webhook.WebhookDelivery.RequestHeadersEntryR\x0erequestHeaders\x12X\n" +
+	"\x10response_headers\x18\x12 \x03(\v2-.webhook.WebhookDelivery.ResponseHeadersEntryR\x0fresponseHeaders\x12-\n" +
+	"\x12response_truncated\x18\x13 \x01(\bR\x11responseTruncated\x1aA\n" +
+	"\x13RequestHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aB\n" +
+	"\x14ResponseHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xb3\x01\n" +
 	"\x18GetWebhookStatusResponse\x128\n" +
 	"\n" +
 	"deliveries\x18\x01 \x03(\v2\x18.webhook.WebhookDeliveryR\n" +
 	"deliveries\x12)\n" +
 	"\x10total_deliveries\x18\x02 \x01(\x05R\x0ftotalDeliveries\x12\x18\n" +
 	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage\"j\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xcc\x01\n" +
+	"\x1cGetWebhookStatusBatchRequest\x12\x1f\n" +
+	"\vwebhook_ids\x18\x01 \x03(\tR\n" +
+	"webhookIds\x12\x1b\n" +
+	"\tevent_ids\x18\x02 \x03(\tR\beventIds\x12E\n" +
+	"\x0efailure_reason\x18\x03 \x01(\x0e2\x1e.webhook.DeliveryFailureReasonR\rfailureReason\x12'\n" +
+	"\x0finclude_headers\x18\x04 \x01(\bR\x0eincludeHeaders\"_\n" +
+	"\x13DeliveryStatusGroup\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x128\n" +
+	"\n" +
+	"deliveries\x18\x02 \x03(\v2\x18.webhook.WebhookDeliveryR\n" +
+	"deliveries\"\xee\x01\n" +
+	"\x1dGetWebhookStatusBatchResponse\x128\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x1c.webhook.DeliveryStatusGroupR\bwebhooks\x124\n" +
+	"\x06events\x18\x02 \x03(\v2\x1c.webhook.DeliveryStatusGroupR\x06events\x12)\n" +
+	"\x10total_deliveries\x18\x03 \x01(\x05R\x0ftotalDeliveries\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"g\n" +
+	"\x19WatchWebhookStatusRequest\x12\x1f\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tH\x00R\twebhookId\x12\x1b\n" +
+	"\bevent_id\x18\x02 \x01(\tH\x00R\aeventIdB\f\n" +
+	"\n" +
+	"identifier\"j\n" +
 	"\x13ListWebhooksRequest\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
 	"\x05event\x18\x02 \x01(\tR\x05event\x12\x1f\n" +
 	"\vactive_only\x18\x03 \x01(\bR\n" +
-	"activeOnly\"\x8b\x03\n" +
+	"activeOnly\"\xca\v\n" +
 	"\x11RegisteredWebhook\x12\x1d\n" +
 	"\n" +
 	"webhook_id\x18\x01 \x01(\tR\twebhookId\x12\x1c\n" +
@@ -1143,16 +5787,284 @@ const file_proto_webhook_proto_rawDesc = "" +
 	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
 	"\n" +
 	"updated_at\x18\n" +
-	" \x01(\x03R\tupdatedAt\x1a:\n" +
+	" \x01(\x03R\tupdatedAt\x12!\n" +
+	"\fmax_attempts\x18\v \x01(\x05R\vmaxAttempts\x12!\n" +
+	"\fcontent_type\x18\f \x01(\tR\vcontentType\x129\n" +
+	"\x19max_stored_response_bytes\x18\r \x01(\x05R\x16maxStoredResponseBytes\x12:\n" +
+	"\x19disable_trace_propagation\x18\x0e \x01(\bR\x17disableTracePropagation\x12\x18\n" +
+	"\aordered\x18\x0f \x01(\bR\aordered\x12%\n" +
+	"\x0einclude_fields\x18\x10 \x03(\tR\rincludeFields\x12%\n" +
+	"\x0eexclude_fields\x18\x11 \x03(\tR\rexcludeFields\x12\x1a\n" +
+	"\bpriority\x18\x12 \x01(\x05R\bpriority\x12#\n" +
+	"\rfallback_urls\x18\x13 \x03(\tR\ffallbackUrls\x12M\n" +
+	"\x14success_body_matcher\x18\x14 \x01(\v2\x1b.webhook.SuccessBodyMatcherR\x12successBodyMatcher\x125\n" +
+	"\tauth_type\x18\x15 \x01(\x0e2\x18.webhook.WebhookAuthTypeR\bauthType\x12\"\n" +
+	"\rmax_in_flight\x18\x16 \x01(\x05R\vmaxInFlight\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x17 \x01(\x03R\texpiresAt\x12#\n" +
+	"\rcompress_body\x18\x18 \x01(\bR\fcompressBody\x12&\n" +
+	"\x0fbatch_window_ms\x18\x19 \x01(\x05R\rbatchWindowMs\x12$\n" +
+	"\x0ebatch_max_size\x18\x1a \x01(\x05R\fbatchMaxSize\x124\n" +
+	"\x16retry_schedule_seconds\x18\x1b \x03(\x05R\x14retryScheduleSeconds\x12N\n" +
+	"\fquery_params\x18\x1c \x03(\v2+.webhook.RegisteredWebhook.QueryParamsEntryR\vqueryParams\x12W\n" +
+	"\x0fmetadata_filter\x18\x1d \x03(\v2..webhook.RegisteredWebhook.MetadataFilterEntryR\x0emetadataFilter\x12\x16\n" +
+	"\x06signed\x18\x1e \x01(\bR\x06signed\x12!\n" +
+	"\fbypass_proxy\x18\x1f \x01(\bR\vbypassProxy\x1a:\n" +
 	"\fHeadersEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1a>\n" +
+	"\x10QueryParamsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\x1aA\n" +
+	"\x13MetadataFilterEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
 	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa3\x01\n" +
 	"\x14ListWebhooksResponse\x126\n" +
 	"\bwebhooks\x18\x01 \x03(\v2\x1a.webhook.RegisteredWebhookR\bwebhooks\x12\x1f\n" +
 	"\vtotal_count\x18\x02 \x01(\x05R\n" +
 	"totalCount\x12\x18\n" +
 	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x04 \x01(\tR\amessage*\xb1\x01\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xa8\x01\n" +
+	"\x11ListEventsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\xe4\x03\n" +
+	"\vStoredEvent\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\x12\x1c\n" +
+	"\tnamespace\x18\x02 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05event\x18\x03 \x01(\tR\x05event\x12\x18\n" +
+	"\apayload\x18\x04 \x01(\tR\apayload\x12!\n" +
+	"\fcontent_type\x18\x05 \x01(\tR\vcontentType\x12>\n" +
+	"\bmetadata\x18\x06 \x03(\v2\".webhook.StoredEvent.MetadataEntryR\bmetadata\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\b \x01(\x03R\texpiresAt\x12#\n" +
+	"\rwebhook_count\x18\t \x01(\x05R\fwebhookCount\x12\x1f\n" +
+	"\vraw_payload\x18\n" +
+	" \x01(\fR\n" +
+	"rawPayload\x12H\n" +
+	"\x10delivery_summary\x18\v \x01(\v2\x1d.webhook.EventDeliverySummaryR\x0fdeliverySummary\x1a;\n" +
+	"\rMetadataEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x91\x01\n" +
+	"\x14EventDeliverySummary\x12)\n" +
+	"\x10total_deliveries\x18\x01 \x01(\x05R\x0ftotalDeliveries\x12\x1c\n" +
+	"\tsucceeded\x18\x02 \x01(\x05R\tsucceeded\x12\x16\n" +
+	"\x06failed\x18\x03 \x01(\x05R\x06failed\x12\x18\n" +
+	"\apending\x18\x04 \x01(\x05R\apending\"\x9e\x01\n" +
+	"\x12ListEventsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.webhook.StoredEventR\x06events\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"u\n" +
+	"\x13ReplayEventsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05event\x18\x02 \x01(\tR\x05event\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\"\xcd\x01\n" +
+	"\x14ReplayEventsResponse\x12'\n" +
+	"\x0fevents_replayed\x18\x01 \x01(\x05R\x0eeventsReplayed\x12%\n" +
+	"\x0eevents_expired\x18\x02 \x01(\x05R\reventsExpired\x121\n" +
+	"\x14deliveries_scheduled\x18\x03 \x01(\x05R\x13deliveriesScheduled\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"m\n" +
+	"\x18ListEventFailuresRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x03 \x01(\tR\tpageToken\"\xc6\x01\n" +
+	"\x16EventProcessingFailure\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1c\n" +
+	"\tnamespace\x18\x03 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05event\x18\x04 \x01(\tR\x05event\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1a\n" +
+	"\battempts\x18\x06 \x01(\x05R\battempts\x12\x1b\n" +
+	"\tfailed_at\x18\a \x01(\x03R\bfailedAt\"\xb4\x01\n" +
+	"\x19ListEventFailuresResponse\x12;\n" +
+	"\bfailures\x18\x01 \x03(\v2\x1f.webhook.EventProcessingFailureR\bfailures\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xce\x01\n" +
+	"\x15ListDeliveriesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x126\n" +
+	"\x06status\x18\x02 \x01(\x0e2\x1e.webhook.WebhookDeliveryStatusR\x06status\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\x12\x14\n" +
+	"\x05limit\x18\x05 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x06 \x01(\tR\tpageToken\"\xae\x01\n" +
+	"\x16ListDeliveriesResponse\x128\n" +
+	"\n" +
+	"deliveries\x18\x01 \x03(\v2\x18.webhook.WebhookDeliveryR\n" +
+	"deliveries\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"^\n" +
+	"\x12GetDeliveryRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12'\n" +
+	"\x0finclude_headers\x18\x02 \x01(\bR\x0eincludeHeaders\"\x7f\n" +
+	"\x13GetDeliveryResponse\x124\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x18.webhook.WebhookDeliveryR\bdelivery\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\",\n" +
+	"\x0fGetEventRequest\x12\x19\n" +
+	"\bevent_id\x18\x01 \x01(\tR\aeventId\"r\n" +
+	"\x10GetEventResponse\x12*\n" +
+	"\x05event\x18\x01 \x01(\v2\x14.webhook.StoredEventR\x05event\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"2\n" +
+	"\x11GetWebhookRequest\x12\x1d\n" +
+	"\n" +
+	"webhook_id\x18\x01 \x01(\tR\twebhookId\"\xae\x01\n" +
+	"\x12GetWebhookResponse\x124\n" +
+	"\awebhook\x18\x01 \x01(\v2\x1a.webhook.RegisteredWebhookR\awebhook\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12.\n" +
+	"\x06health\x18\x04 \x01(\v2\x16.webhook.WebhookHealthR\x06health\"o\n" +
+	"\rWebhookHealth\x12!\n" +
+	"\fsuccess_rate\x18\x01 \x01(\x01R\vsuccessRate\x12\x1a\n" +
+	"\battempts\x18\x02 \x01(\x01R\battempts\x12\x1f\n" +
+	"\vdisabled_at\x18\x03 \x01(\x03R\n" +
+	"disabledAt\"W\n" +
+	"\x13CreateAPIKeyRequest\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x01 \x03(\tR\n" +
+	"namespaces\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\xb9\x01\n" +
+	"\x14CreateAPIKeyResponse\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\x12\x17\n" +
+	"\aapi_key\x18\x02 \x01(\tR\x06apiKey\x12\x1e\n" +
+	"\n" +
+	"namespaces\x18\x03 \x03(\tR\n" +
+	"namespaces\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x04 \x01(\x03R\tcreatedAt\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\",\n" +
+	"\x13RevokeAPIKeyRequest\x12\x15\n" +
+	"\x06key_id\x18\x01 \x01(\tR\x05keyId\"J\n" +
+	"\x14RevokeAPIKeyResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"N\n" +
+	"\x16CreateNamespaceRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\"\x80\x01\n" +
+	"\x17CreateNamespaceResponse\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03R\tcreatedAt\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\xde\x01\n" +
+	"\x1bSetNamespaceDefaultsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12K\n" +
+	"\aheaders\x18\x02 \x03(\v21.webhook.SetNamespaceDefaultsRequest.HeadersEntryR\aheaders\x12\x18\n" +
+	"\atimeout\x18\x03 \x01(\x05R\atimeout\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"R\n" +
+	"\x1cSetNamespaceDefaultsResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\";\n" +
+	"\x1bGetNamespaceDefaultsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\"\x94\x02\n" +
+	"\x1cGetNamespaceDefaultsResponse\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12L\n" +
+	"\aheaders\x18\x02 \x03(\v22.webhook.GetNamespaceDefaultsResponse.HeadersEntryR\aheaders\x12\x18\n" +
+	"\atimeout\x18\x03 \x01(\x05R\atimeout\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\x1a:\n" +
+	"\fHeadersEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\\\n" +
+	"\x18ExtendDeliveryTTLRequest\x12\x1f\n" +
+	"\vdelivery_id\x18\x01 \x01(\tR\n" +
+	"deliveryId\x12\x1f\n" +
+	"\vttl_seconds\x18\x02 \x01(\x03R\n" +
+	"ttlSeconds\"\x85\x01\n" +
+	"\x19ExtendDeliveryTTLResponse\x124\n" +
+	"\bdelivery\x18\x01 \x01(\v2\x18.webhook.WebhookDeliveryR\bdelivery\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"\x91\x01\n" +
+	"\x16ListAllWebhooksRequest\x12\x1f\n" +
+	"\vactive_only\x18\x01 \x01(\bR\n" +
+	"activeOnly\x12!\n" +
+	"\furl_contains\x18\x02 \x01(\tR\vurlContains\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\x12\x1d\n" +
+	"\n" +
+	"page_token\x18\x04 \x01(\tR\tpageToken\"\xad\x01\n" +
+	"\x17ListAllWebhooksResponse\x126\n" +
+	"\bwebhooks\x18\x01 \x03(\v2\x1a.webhook.RegisteredWebhookR\bwebhooks\x12&\n" +
+	"\x0fnext_page_token\x18\x02 \x01(\tR\rnextPageToken\x12\x18\n" +
+	"\asuccess\x18\x03 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"c\n" +
+	"\x17GetDeliveryStatsRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x03 \x01(\x03R\x05until\"\xb0\x02\n" +
+	"\rDeliveryStats\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x03R\x05total\x12\x18\n" +
+	"\apending\x18\x02 \x01(\x03R\apending\x12\x18\n" +
+	"\asending\x18\x03 \x01(\x03R\asending\x12\x18\n" +
+	"\asuccess\x18\x04 \x01(\x03R\asuccess\x12\x16\n" +
+	"\x06failed\x18\x05 \x01(\x03R\x06failed\x12\x1a\n" +
+	"\bretrying\x18\x06 \x01(\x03R\bretrying\x12\x18\n" +
+	"\aexpired\x18\a \x01(\x03R\aexpired\x12!\n" +
+	"\fsuccess_rate\x18\b \x01(\x01R\vsuccessRate\x12$\n" +
+	"\x0elatency_p50_ms\x18\t \x01(\x01R\flatencyP50Ms\x12$\n" +
+	"\x0elatency_p95_ms\x18\n" +
+	" \x01(\x01R\flatencyP95Ms\"X\n" +
+	"\x12EventDeliveryStats\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12,\n" +
+	"\x05stats\x18\x02 \x01(\v2\x16.webhook.DeliveryStatsR\x05stats\"\xdf\x01\n" +
+	"\x18GetDeliveryStatsResponse\x12.\n" +
+	"\x06totals\x18\x01 \x01(\v2\x16.webhook.DeliveryStatsR\x06totals\x123\n" +
+	"\x06events\x18\x02 \x03(\v2\x1b.webhook.EventDeliveryStatsR\x06events\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\x12\x18\n" +
+	"\asuccess\x18\x05 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"a\n" +
+	"\x15ListEventTypesRequest\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12\x14\n" +
+	"\x05since\x18\x02 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x03 \x01(\x03R\x05until\"y\n" +
+	"\tEventType\x12\x14\n" +
+	"\x05event\x18\x01 \x01(\tR\x05event\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\x03R\x05count\x12 \n" +
+	"\flast_seen_at\x18\x03 \x01(\x03R\n" +
+	"lastSeenAt\x12\x1e\n" +
+	"\n" +
+	"registered\x18\x04 \x01(\bR\n" +
+	"registered\"\x81\x01\n" +
+	"\x16ListEventTypesResponse\x123\n" +
+	"\vevent_types\x18\x01 \x03(\v2\x12.webhook.EventTypeR\n" +
+	"eventTypes\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage*A\n" +
+	"\x0fWebhookAuthType\x12\r\n" +
+	"\tAUTH_NONE\x10\x00\x12\x0e\n" +
+	"\n" +
+	"AUTH_BASIC\x10\x01\x12\x0f\n" +
+	"\vAUTH_BEARER\x10\x02*\xab\x02\n" +
+	"\x15DeliveryFailureReason\x12\x10\n" +
+	"\fFAILURE_NONE\x10\x00\x12\x15\n" +
+	"\x11FAILURE_DNS_ERROR\x10\x01\x12\x1e\n" +
+	"\x1aFAILURE_CONNECTION_REFUSED\x10\x02\x12\x15\n" +
+	"\x11FAILURE_TLS_ERROR\x10\x03\x12\x13\n" +
+	"\x0fFAILURE_TIMEOUT\x10\x04\x12\x14\n" +
+	"\x10FAILURE_HTTP_4XX\x10\x05\x12\x14\n" +
+	"\x10FAILURE_HTTP_5XX\x10\x06\x12\x1d\n" +
+	"\x19FAILURE_BODY_MATCH_FAILED\x10\a\x12\x11\n" +
+	"\rFAILURE_OTHER\x10\b\x12!\n" +
+	"\x1dFAILURE_HEADER_TEMPLATE_ERROR\x10\t\x12\x1c\n" +
+	"\x18FAILURE_PORT_NOT_ALLOWED\x10\n" +
+	"*\xb1\x01\n" +
 	"\x15WebhookDeliveryStatus\x12\x14\n" +
 	"\x10DELIVERY_UNKNOWN\x10\x00\x12\x14\n" +
 	"\x10DELIVERY_PENDING\x10\x01\x12\x14\n" +
@@ -1160,13 +6072,40 @@ const file_proto_webhook_proto_rawDesc = "" +
 	"\x10DELIVERY_SUCCESS\x10\x03\x12\x13\n" +
 	"\x0fDELIVERY_FAILED\x10\x04\x12\x15\n" +
 	"\x11DELIVERY_RETRYING\x10\x05\x12\x14\n" +
-	"\x10DELIVERY_EXPIRED\x10\x062\xac\x03\n" +
+	"\x10DELIVERY_EXPIRED\x10\x062\xfd\x12\n" +
 	"\x0eWebhookService\x12T\n" +
 	"\x0fRegisterWebhook\x12\x1f.webhook.RegisterWebhookRequest\x1a .webhook.RegisterWebhookResponse\x12Z\n" +
-	"\x11UnregisterWebhook\x12!.webhook.UnregisterWebhookRequest\x1a\".webhook.UnregisterWebhookResponse\x12B\n" +
-	"\tPushEvent\x12\x19.webhook.PushEventRequest\x1a\x1a.webhook.PushEventResponse\x12W\n" +
-	"\x10GetWebhookStatus\x12 .webhook.GetWebhookStatusRequest\x1a!.webhook.GetWebhookStatusResponse\x12K\n" +
-	"\fListWebhooks\x12\x1c.webhook.ListWebhooksRequest\x1a\x1d.webhook.ListWebhooksResponseB%Z#github.com/sarathsp06/sparrow/protob\x06proto3"
+	"\x11UnregisterWebhook\x12!.webhook.UnregisterWebhookRequest\x1a\".webhook.UnregisterWebhookResponse\x12K\n" +
+	"\fPauseWebhook\x12\x1c.webhook.PauseWebhookRequest\x1a\x1d.webhook.PauseWebhookResponse\x12N\n" +
+	"\rResumeWebhook\x12\x1d.webhook.ResumeWebhookRequest\x1a\x1e.webhook.ResumeWebhookResponse\x12K\n" +
+	"\fRotateSecret\x12\x1c.webhook.RotateSecretRequest\x1a\x1d.webhook.RotateSecretResponse\x12Q\n" +
+	"\x0eDeleteWebhooks\x12\x1e.webhook.DeleteWebhooksRequest\x1a\x1f.webhook.DeleteWebhooksResponse\x12B\n" +
+	"\tPushEvent\x12\x19.webhook.PushEventRequest\x1a\x1a.webhook.PushEventResponse\x12`\n" +
+	"\x13RegisterEventSchema\x12#.webhook.RegisterEventSchemaRequest\x1a$.webhook.RegisterEventSchemaResponse\x12E\n" +
+	"\n" +
+	"PushEvents\x12\x1a.webhook.PushEventsRequest\x1a\x1b.webhook.PushEventsResponse\x12W\n" +
+	"\x10GetWebhookStatus\x12 .webhook.GetWebhookStatusRequest\x1a!.webhook.GetWebhookStatusResponse\x12f\n" +
+	"\x15GetWebhookStatusBatch\x12%.webhook.GetWebhookStatusBatchRequest\x1a&.webhook.GetWebhookStatusBatchResponse\x12T\n" +
+	"\x12WatchWebhookStatus\x12\".webhook.WatchWebhookStatusRequest\x1a\x18.webhook.WebhookDelivery0\x01\x12K\n" +
+	"\fListWebhooks\x12\x1c.webhook.ListWebhooksRequest\x1a\x1d.webhook.ListWebhooksResponse\x12E\n" +
+	"\n" +
+	"ListEvents\x12\x1a.webhook.ListEventsRequest\x1a\x1b.webhook.ListEventsResponse\x12K\n" +
+	"\fReplayEvents\x12\x1c.webhook.ReplayEventsRequest\x1a\x1d.webhook.ReplayEventsResponse\x12Z\n" +
+	"\x11ListEventFailures\x12!.webhook.ListEventFailuresRequest\x1a\".webhook.ListEventFailuresResponse\x12Q\n" +
+	"\x0eListDeliveries\x12\x1e.webhook.ListDeliveriesRequest\x1a\x1f.webhook.ListDeliveriesResponse\x12E\n" +
+	"\n" +
+	"GetWebhook\x12\x1a.webhook.GetWebhookRequest\x1a\x1b.webhook.GetWebhookResponse\x12K\n" +
+	"\fCreateAPIKey\x12\x1c.webhook.CreateAPIKeyRequest\x1a\x1d.webhook.CreateAPIKeyResponse\x12K\n" +
+	"\fRevokeAPIKey\x12\x1c.webhook.RevokeAPIKeyRequest\x1a\x1d.webhook.RevokeAPIKeyResponse\x12W\n" +
+	"\x10GetDeliveryStats\x12 .webhook.GetDeliveryStatsRequest\x1a!.webhook.GetDeliveryStatsResponse\x12Q\n" +
+	"\x0eListEventTypes\x12\x1e.webhook.ListEventTypesRequest\x1a\x1f.webhook.ListEventTypesResponse\x12c\n" +
+	"\x14SetNamespaceDefaults\x12$.webhook.SetNamespaceDefaultsRequest\x1a%.webhook.SetNamespaceDefaultsResponse\x12c\n" +
+	"\x14GetNamespaceDefaults\x12$.webhook.GetNamespaceDefaultsRequest\x1a%.webhook.GetNamespaceDefaultsResponse\x12T\n" +
+	"\x0fCreateNamespace\x12\x1f.webhook.CreateNamespaceRequest\x1a .webhook.CreateNamespaceResponse\x12Z\n" +
+	"\x11ExtendDeliveryTTL\x12!.webhook.ExtendDeliveryTTLRequest\x1a\".webhook.ExtendDeliveryTTLResponse\x12T\n" +
+	"\x0fListAllWebhooks\x12\x1f.webhook.ListAllWebhooksRequest\x1a .webhook.ListAllWebhooksResponse\x12H\n" +
+	"\vGetDelivery\x12\x1b.webhook.GetDeliveryRequest\x1a\x1c.webhook.GetDeliveryResponse\x12?\n" +
+	"\bGetEvent\x12\x18.webhook.GetEventRequest\x1a\x19.webhook.GetEventResponseB%Z#github.com/sarathsp06/sparrow/protob\x06proto3"
 
 var (
 	file_proto_webhook_proto_rawDescOnce sync.Once
@@ -1180,48 +6119,205 @@ func file_proto_webhook_proto_rawDescGZIP() []byte {
 	return file_proto_webhook_proto_rawDescData
 }
 
-var file_proto_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_proto_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 15)
+var file_proto_webhook_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_webhook_proto_msgTypes = make([]protoimpl.MessageInfo, 83)
 var file_proto_webhook_proto_goTypes = []any{
-	(WebhookDeliveryStatus)(0),        // 0: webhook.WebhookDeliveryStatus
-	(*RegisterWebhookRequest)(nil),    // 1: webhook.RegisterWebhookRequest
-	(*RegisterWebhookResponse)(nil),   // 2: webhook.RegisterWebhookResponse
-	(*UnregisterWebhookRequest)(nil),  // 3: webhook.UnregisterWebhookRequest
-	(*UnregisterWebhookResponse)(nil), // 4: webhook.UnregisterWebhookResponse
-	(*PushEventRequest)(nil),          // 5: webhook.PushEventRequest
-	(*PushEventResponse)(nil),         // 6: webhook.PushEventResponse
-	(*GetWebhookStatusRequest)(nil),   // 7: webhook.GetWebhookStatusRequest
-	(*WebhookDelivery)(nil),           // 8: webhook.WebhookDelivery
-	(*GetWebhookStatusResponse)(nil),  // 9: webhook.GetWebhookStatusResponse
-	(*ListWebhooksRequest)(nil),       // 10: webhook.ListWebhooksRequest
-	(*RegisteredWebhook)(nil),         // 11: webhook.RegisteredWebhook
-	(*ListWebhooksResponse)(nil),      // 12: webhook.ListWebhooksResponse
-	nil,                               // 13: webhook.RegisterWebhookRequest.HeadersEntry
-	nil,                               // 14: webhook.PushEventRequest.MetadataEntry
-	nil,                               // 15: webhook.RegisteredWebhook.HeadersEntry
+	(WebhookAuthType)(0),                  // 0: webhook.WebhookAuthType
+	(DeliveryFailureReason)(0),            // 1: webhook.DeliveryFailureReason
+	(WebhookDeliveryStatus)(0),            // 2: webhook.WebhookDeliveryStatus
+	(*RegisterWebhookRequest)(nil),        // 3: webhook.RegisterWebhookRequest
+	(*WebhookAuth)(nil),                   // 4: webhook.WebhookAuth
+	(*SuccessBodyMatcher)(nil),            // 5: webhook.SuccessBodyMatcher
+	(*RegisterWebhookResponse)(nil),       // 6: webhook.RegisterWebhookResponse
+	(*UnregisterWebhookRequest)(nil),      // 7: webhook.UnregisterWebhookRequest
+	(*UnregisterWebhookResponse)(nil),     // 8: webhook.UnregisterWebhookResponse
+	(*PauseWebhookRequest)(nil),           // 9: webhook.PauseWebhookRequest
+	(*PauseWebhookResponse)(nil),          // 10: webhook.PauseWebhookResponse
+	(*ResumeWebhookRequest)(nil),          // 11: webhook.ResumeWebhookRequest
+	(*ResumeWebhookResponse)(nil),         // 12: webhook.ResumeWebhookResponse
+	(*RotateSecretRequest)(nil),           // 13: webhook.RotateSecretRequest
+	(*RotateSecretResponse)(nil),          // 14: webhook.RotateSecretResponse
+	(*DeleteWebhooksRequest)(nil),         // 15: webhook.DeleteWebhooksRequest
+	(*DeleteWebhooksResponse)(nil),        // 16: webhook.DeleteWebhooksResponse
+	(*RegisterEventSchemaRequest)(nil),    // 17: webhook.RegisterEventSchemaRequest
+	(*RegisterEventSchemaResponse)(nil),   // 18: webhook.RegisterEventSchemaResponse
+	(*PushEventRequest)(nil),              // 19: webhook.PushEventRequest
+	(*PushEventResponse)(nil),             // 20: webhook.PushEventResponse
+	(*SynchronousDelivery)(nil),           // 21: webhook.SynchronousDelivery
+	(*PushEventsRequest)(nil),             // 22: webhook.PushEventsRequest
+	(*PushEventResult)(nil),               // 23: webhook.PushEventResult
+	(*PushEventsResponse)(nil),            // 24: webhook.PushEventsResponse
+	(*GetWebhookStatusRequest)(nil),       // 25: webhook.GetWebhookStatusRequest
+	(*WebhookDelivery)(nil),               // 26: webhook.WebhookDelivery
+	(*GetWebhookStatusResponse)(nil),      // 27: webhook.GetWebhookStatusResponse
+	(*GetWebhookStatusBatchRequest)(nil),  // 28: webhook.GetWebhookStatusBatchRequest
+	(*DeliveryStatusGroup)(nil),           // 29: webhook.DeliveryStatusGroup
+	(*GetWebhookStatusBatchResponse)(nil), // 30: webhook.GetWebhookStatusBatchResponse
+	(*WatchWebhookStatusRequest)(nil),     // 31: webhook.WatchWebhookStatusRequest
+	(*ListWebhooksRequest)(nil),           // 32: webhook.ListWebhooksRequest
+	(*RegisteredWebhook)(nil),             // 33: webhook.RegisteredWebhook
+	(*ListWebhooksResponse)(nil),          // 34: webhook.ListWebhooksResponse
+	(*ListEventsRequest)(nil),             // 35: webhook.ListEventsRequest
+	(*StoredEvent)(nil),                   // 36: webhook.StoredEvent
+	(*EventDeliverySummary)(nil),          // 37: webhook.EventDeliverySummary
+	(*ListEventsResponse)(nil),            // 38: webhook.ListEventsResponse
+	(*ReplayEventsRequest)(nil),           // 39: webhook.ReplayEventsRequest
+	(*ReplayEventsResponse)(nil),          // 40: webhook.ReplayEventsResponse
+	(*ListEventFailuresRequest)(nil),      // 41: webhook.ListEventFailuresRequest
+	(*EventProcessingFailure)(nil),        // 42: webhook.EventProcessingFailure
+	(*ListEventFailuresResponse)(nil),     // 43: webhook.ListEventFailuresResponse
+	(*ListDeliveriesRequest)(nil),         // 44: webhook.ListDeliveriesRequest
+	(*ListDeliveriesResponse)(nil),        // 45: webhook.ListDeliveriesResponse
+	(*GetDeliveryRequest)(nil),            // 46: webhook.GetDeliveryRequest
+	(*GetDeliveryResponse)(nil),           // 47: webhook.GetDeliveryResponse
+	(*GetEventRequest)(nil),               // 48: webhook.GetEventRequest
+	(*GetEventResponse)(nil),              // 49: webhook.GetEventResponse
+	(*GetWebhookRequest)(nil),             // 50: webhook.GetWebhookRequest
+	(*GetWebhookResponse)(nil),            // 51: webhook.GetWebhookResponse
+	(*WebhookHealth)(nil),                 // 52: webhook.WebhookHealth
+	(*CreateAPIKeyRequest)(nil),           // 53: webhook.CreateAPIKeyRequest
+	(*CreateAPIKeyResponse)(nil),          // 54: webhook.CreateAPIKeyResponse
+	(*RevokeAPIKeyRequest)(nil),           // 55: webhook.RevokeAPIKeyRequest
+	(*RevokeAPIKeyResponse)(nil),          // 56: webhook.RevokeAPIKeyResponse
+	(*CreateNamespaceRequest)(nil),        // 57: webhook.CreateNamespaceRequest
+	(*CreateNamespaceResponse)(nil),       // 58: webhook.CreateNamespaceResponse
+	(*SetNamespaceDefaultsRequest)(nil),   // 59: webhook.SetNamespaceDefaultsRequest
+	(*SetNamespaceDefaultsResponse)(nil),  // 60: webhook.SetNamespaceDefaultsResponse
+	(*GetNamespaceDefaultsRequest)(nil),   // 61: webhook.GetNamespaceDefaultsRequest
+	(*GetNamespaceDefaultsResponse)(nil),  // 62: webhook.GetNamespaceDefaultsResponse
+	(*ExtendDeliveryTTLRequest)(nil),      // 63: webhook.ExtendDeliveryTTLRequest
+	(*ExtendDeliveryTTLResponse)(nil),     // 64: webhook.ExtendDeliveryTTLResponse
+	(*ListAllWebhooksRequest)(nil),        // 65: webhook.ListAllWebhooksRequest
+	(*ListAllWebhooksResponse)(nil),       // 66: webhook.ListAllWebhooksResponse
+	(*GetDeliveryStatsRequest)(nil),       // 67: webhook.GetDeliveryStatsRequest
+	(*DeliveryStats)(nil),                 // 68: webhook.DeliveryStats
+	(*EventDeliveryStats)(nil),            // 69: webhook.EventDeliveryStats
+	(*GetDeliveryStatsResponse)(nil),      // 70: webhook.GetDeliveryStatsResponse
+	(*ListEventTypesRequest)(nil),         // 71: webhook.ListEventTypesRequest
+	(*EventType)(nil),                     // 72: webhook.EventType
+	(*ListEventTypesResponse)(nil),        // 73: webhook.ListEventTypesResponse
+	nil,                                   // 74: webhook.RegisterWebhookRequest.HeadersEntry
+	nil,                                   // 75: webhook.RegisterWebhookRequest.QueryParamsEntry
+	nil,                                   // 76: webhook.RegisterWebhookRequest.MetadataFilterEntry
+	nil,                                   // 77: webhook.PushEventRequest.MetadataEntry
+	nil,                                   // 78: webhook.WebhookDelivery.RequestHeadersEntry
+	nil,                                   // 79: webhook.WebhookDelivery.ResponseHeadersEntry
+	nil,                                   // 80: webhook.RegisteredWebhook.HeadersEntry
+	nil,                                   // 81: webhook.RegisteredWebhook.QueryParamsEntry
+	nil,                                   // 82: webhook.RegisteredWebhook.MetadataFilterEntry
+	nil,                                   // 83: webhook.StoredEvent.MetadataEntry
+	nil,                                   // 84: webhook.SetNamespaceDefaultsRequest.HeadersEntry
+	nil,                                   // 85: webhook.GetNamespaceDefaultsResponse.HeadersEntry
 }
 var file_proto_webhook_proto_depIdxs = []int32{
-	13, // 0: webhook.RegisterWebhookRequest.headers:type_name -> webhook.RegisterWebhookRequest.HeadersEntry
-	14, // 1: webhook.PushEventRequest.metadata:type_name -> webhook.PushEventRequest.MetadataEntry
-	0,  // 2: webhook.WebhookDelivery.status:type_name -> webhook.WebhookDeliveryStatus
-	8,  // 3: webhook.GetWebhookStatusResponse.deliveries:type_name -> webhook.WebhookDelivery
-	15, // 4: webhook.RegisteredWebhook.headers:type_name -> webhook.RegisteredWebhook.HeadersEntry
-	11, // 5: webhook.ListWebhooksResponse.webhooks:type_name -> webhook.RegisteredWebhook
-	1,  // 6: webhook.WebhookService.RegisterWebhook:input_type -> webhook.RegisterWebhookRequest
-	3,  // 7: webhook.WebhookService.UnregisterWebhook:input_type -> webhook.UnregisterWebhookRequest
-	5,  // 8: webhook.WebhookService.PushEvent:input_type -> webhook.PushEventRequest
-	7,  // 9: webhook.WebhookService.GetWebhookStatus:input_type -> webhook.GetWebhookStatusRequest
-	10, // 10: webhook.WebhookService.ListWebhooks:input_type -> webhook.ListWebhooksRequest
-	2,  // 11: webhook.WebhookService.RegisterWebhook:output_type -> webhook.RegisterWebhookResponse
-	4,  // 12: webhook.WebhookService.UnregisterWebhook:output_type -> webhook.UnregisterWebhookResponse
-	6,  // 13: webhook.WebhookService.PushEvent:output_type -> webhook.PushEventResponse
-	9,  // 14: webhook.WebhookService.GetWebhookStatus:output_type -> webhook.GetWebhookStatusResponse
-	12, // 15: webhook.WebhookService.ListWebhooks:output_type -> webhook.ListWebhooksResponse
-	11, // [11:16] is the sub-list for method output_type
-	6,  // [6:11] is the sub-list for method input_type
-	6,  // [6:6] is the sub-list for extension type_name
-	6,  // [6:6] is the sub-list for extension extendee
-	0,  // [0:6] is the sub-list for field type_name
+	74, // 0: webhook.RegisterWebhookRequest.headers:type_name -> webhook.RegisterWebhookRequest.HeadersEntry
+	5,  // 1: webhook.RegisterWebhookRequest.success_body_matcher:type_name -> webhook.SuccessBodyMatcher
+	4,  // 2: webhook.RegisterWebhookRequest.auth:type_name -> webhook.WebhookAuth
+	75, // 3: webhook.RegisterWebhookRequest.query_params:type_name -> webhook.RegisterWebhookRequest.QueryParamsEntry
+	76, // 4: webhook.RegisterWebhookRequest.metadata_filter:type_name -> webhook.RegisterWebhookRequest.MetadataFilterEntry
+	0,  // 5: webhook.WebhookAuth.type:type_name -> webhook.WebhookAuthType
+	77, // 6: webhook.PushEventRequest.metadata:type_name -> webhook.PushEventRequest.MetadataEntry
+	21, // 7: webhook.PushEventResponse.synchronous_deliveries:type_name -> webhook.SynchronousDelivery
+	2,  // 8: webhook.SynchronousDelivery.status:type_name -> webhook.WebhookDeliveryStatus
+	19, // 9: webhook.PushEventsRequest.events:type_name -> webhook.PushEventRequest
+	23, // 10: webhook.PushEventsResponse.results:type_name -> webhook.PushEventResult
+	1,  // 11: webhook.GetWebhookStatusRequest.failure_reason:type_name -> webhook.DeliveryFailureReason
+	2,  // 12: webhook.WebhookDelivery.status:type_name -> webhook.WebhookDeliveryStatus
+	1,  // 13: webhook.WebhookDelivery.failure_reason:type_name -> webhook.DeliveryFailureReason
+	78, // 14: webhook.WebhookDelivery.request_headers:type_name -> webhook.WebhookDelivery.RequestHeadersEntry
+	79, // 15: webhook.WebhookDelivery.response_headers:type_name -> webhook.WebhookDelivery.ResponseHeadersEntry
+	26, // 16: webhook.GetWebhookStatusResponse.deliveries:type_name -> webhook.WebhookDelivery
+	1,  // 17: webhook.GetWebhookStatusBatchRequest.failure_reason:type_name -> webhook.DeliveryFailureReason
+	26, // 18: webhook.DeliveryStatusGroup.deliveries:type_name -> webhook.WebhookDelivery
+	29, // 19: webhook.GetWebhookStatusBatchResponse.webhooks:type_name -> webhook.DeliveryStatusGroup
+	29, // 20: webhook.GetWebhookStatusBatchResponse.events:type_name -> webhook.DeliveryStatusGroup
+	80, // 21: webhook.RegisteredWebhook.headers:type_name -> webhook.RegisteredWebhook.HeadersEntry
+	5,  // 22: webhook.RegisteredWebhook.success_body_matcher:type_name -> webhook.SuccessBodyMatcher
+	0,  // 23: webhook.RegisteredWebhook.auth_type:type_name -> webhook.WebhookAuthType
+	81, // 24: webhook.RegisteredWebhook.query_params:type_name -> webhook.RegisteredWebhook.QueryParamsEntry
+	82, // 25: webhook.RegisteredWebhook.metadata_filter:type_name -> webhook.RegisteredWebhook.MetadataFilterEntry
+	33, // 26: webhook.ListWebhooksResponse.webhooks:type_name -> webhook.RegisteredWebhook
+	83, // 27: webhook.StoredEvent.metadata:type_name -> webhook.StoredEvent.MetadataEntry
+	37, // 28: webhook.StoredEvent.delivery_summary:type_name -> webhook.EventDeliverySummary
+	36, // 29: webhook.ListEventsResponse.events:type_name -> webhook.StoredEvent
+	42, // 30: webhook.ListEventFailuresResponse.failures:type_name -> webhook.EventProcessingFailure
+	2,  // 31: webhook.ListDeliveriesRequest.status:type_name -> webhook.WebhookDeliveryStatus
+	26, // 32: webhook.ListDeliveriesResponse.deliveries:type_name -> webhook.WebhookDelivery
+	26, // 33: webhook.GetDeliveryResponse.delivery:type_name -> webhook.WebhookDelivery
+	36, // 34: webhook.GetEventResponse.event:type_name -> webhook.StoredEvent
+	33, // 35: webhook.GetWebhookResponse.webhook:type_name -> webhook.RegisteredWebhook
+	52, // 36: webhook.GetWebhookResponse.health:type_name -> webhook.WebhookHealth
+	84, // 37: webhook.SetNamespaceDefaultsRequest.headers:type_name -> webhook.SetNamespaceDefaultsRequest.HeadersEntry
+	85, // 38: webhook.GetNamespaceDefaultsResponse.headers:type_name -> webhook.GetNamespaceDefaultsResponse.HeadersEntry
+	26, // 39: webhook.ExtendDeliveryTTLResponse.delivery:type_name -> webhook.WebhookDelivery
+	33, // 40: webhook.ListAllWebhooksResponse.webhooks:type_name -> webhook.RegisteredWebhook
+	68, // 41: webhook.EventDeliveryStats.stats:type_name -> webhook.DeliveryStats
+	68, // 42: webhook.GetDeliveryStatsResponse.totals:type_name -> webhook.DeliveryStats
+	69, // 43: webhook.GetDeliveryStatsResponse.events:type_name -> webhook.EventDeliveryStats
+	72, // 44: webhook.ListEventTypesResponse.event_types:type_name -> webhook.EventType
+	3,  // 45: webhook.WebhookService.RegisterWebhook:input_type -> webhook.RegisterWebhookRequest
+	7,  // 46: webhook.WebhookService.UnregisterWebhook:input_type -> webhook.UnregisterWebhookRequest
+	9,  // 47: webhook.WebhookService.PauseWebhook:input_type -> webhook.PauseWebhookRequest
+	11, // 48: webhook.WebhookService.ResumeWebhook:input_type -> webhook.ResumeWebhookRequest
+	13, // 49: webhook.WebhookService.RotateSecret:input_type -> webhook.RotateSecretRequest
+	15, // 50: webhook.WebhookService.DeleteWebhooks:input_type -> webhook.DeleteWebhooksRequest
+	19, // 51: webhook.WebhookService.PushEvent:input_type -> webhook.PushEventRequest
+	17, // 52: webhook.WebhookService.RegisterEventSchema:input_type -> webhook.RegisterEventSchemaRequest
+	22, // 53: webhook.WebhookService.PushEvents:input_type -> webhook.PushEventsRequest
+	25, // 54: webhook.WebhookService.GetWebhookStatus:input_type -> webhook.GetWebhookStatusRequest
+	28, // 55: webhook.WebhookService.GetWebhookStatusBatch:input_type -> webhook.GetWebhookStatusBatchRequest
+	31, // 56: webhook.WebhookService.WatchWebhookStatus:input_type -> webhook.WatchWebhookStatusRequest
+	32, // 57: webhook.WebhookService.ListWebhooks:input_type -> webhook.ListWebhooksRequest
+	35, // 58: webhook.WebhookService.ListEvents:input_type -> webhook.ListEventsRequest
+	39, // 59: webhook.WebhookService.ReplayEvents:input_type -> webhook.ReplayEventsRequest
+	41, // 60: webhook.WebhookService.ListEventFailures:input_type -> webhook.ListEventFailuresRequest
+	44, // 61: webhook.WebhookService.ListDeliveries:input_type -> webhook.ListDeliveriesRequest
+	50, // 62: webhook.WebhookService.GetWebhook:input_type -> webhook.GetWebhookRequest
+	53, // 63: webhook.WebhookService.CreateAPIKey:input_type -> webhook.CreateAPIKeyRequest
+	55, // 64: webhook.WebhookService.RevokeAPIKey:input_type -> webhook.RevokeAPIKeyRequest
+	67, // 65: webhook.WebhookService.GetDeliveryStats:input_type -> webhook.GetDeliveryStatsRequest
+	71, // 66: webhook.WebhookService.ListEventTypes:input_type -> webhook.ListEventTypesRequest
+	59, // 67: webhook.WebhookService.SetNamespaceDefaults:input_type -> webhook.SetNamespaceDefaultsRequest
+	61, // 68: webhook.WebhookService.GetNamespaceDefaults:input_type -> webhook.GetNamespaceDefaultsRequest
+	57, // 69: webhook.WebhookService.CreateNamespace:input_type -> webhook.CreateNamespaceRequest
+	63, // 70: webhook.WebhookService.ExtendDeliveryTTL:input_type -> webhook.ExtendDeliveryTTLRequest
+	65, // 71: webhook.WebhookService.ListAllWebhooks:input_type -> webhook.ListAllWebhooksRequest
+	46, // 72: webhook.WebhookService.GetDelivery:input_type -> webhook.GetDeliveryRequest
+	48, // 73: webhook.WebhookService.GetEvent:input_type -> webhook.GetEventRequest
+	6,  // 74: webhook.WebhookService.RegisterWebhook:output_type -> webhook.RegisterWebhookResponse
+	8,  // 75: webhook.WebhookService.UnregisterWebhook:output_type -> webhook.UnregisterWebhookResponse
+	10, // 76: webhook.WebhookService.PauseWebhook:output_type -> webhook.PauseWebhookResponse
+	12, // 77: webhook.WebhookService.ResumeWebhook:output_type -> webhook.ResumeWebhookResponse
+	14, // 78: webhook.WebhookService.RotateSecret:output_type -> webhook.RotateSecretResponse
+	16, // 79: webhook.WebhookService.DeleteWebhooks:output_type -> webhook.DeleteWebhooksResponse
+	20, // 80: webhook.WebhookService.PushEvent:output_type -> webhook.PushEventResponse
+	18, // 81: webhook.WebhookService.RegisterEventSchema:output_type -> webhook.RegisterEventSchemaResponse
+	24, // 82: webhook.WebhookService.PushEvents:output_type -> webhook.PushEventsResponse
+	27, // 83: webhook.WebhookService.GetWebhookStatus:output_type -> webhook.GetWebhookStatusResponse
+	30, // 84: webhook.WebhookService.GetWebhookStatusBatch:output_type -> webhook.GetWebhookStatusBatchResponse
+	26, // 85: webhook.WebhookService.WatchWebhookStatus:output_type -> webhook.WebhookDelivery
+	34, // 86: webhook.WebhookService.ListWebhooks:output_type -> webhook.ListWebhooksResponse
+	38, // 87: webhook.WebhookService.ListEvents:output_type -> webhook.ListEventsResponse
+	40, // 88: webhook.WebhookService.ReplayEvents:output_type -> webhook.ReplayEventsResponse
+	43, // 89: webhook.WebhookService.ListEventFailures:output_type -> webhook.ListEventFailuresResponse
+	45, // 90: webhook.WebhookService.ListDeliveries:output_type -> webhook.ListDeliveriesResponse
+	51, // 91: webhook.WebhookService.GetWebhook:output_type -> webhook.GetWebhookResponse
+	54, // 92: webhook.WebhookService.CreateAPIKey:output_type -> webhook.CreateAPIKeyResponse
+	56, // 93: webhook.WebhookService.RevokeAPIKey:output_type -> webhook.RevokeAPIKeyResponse
+	70, // 94: webhook.WebhookService.GetDeliveryStats:output_type -> webhook.GetDeliveryStatsResponse
+	73, // 95: webhook.WebhookService.ListEventTypes:output_type -> webhook.ListEventTypesResponse
+	60, // 96: webhook.WebhookService.SetNamespaceDefaults:output_type -> webhook.SetNamespaceDefaultsResponse
+	62, // 97: webhook.WebhookService.GetNamespaceDefaults:output_type -> webhook.GetNamespaceDefaultsResponse
+	58, // 98: webhook.WebhookService.CreateNamespace:output_type -> webhook.CreateNamespaceResponse
+	64, // 99: webhook.WebhookService.ExtendDeliveryTTL:output_type -> webhook.ExtendDeliveryTTLResponse
+	66, // 100: webhook.WebhookService.ListAllWebhooks:output_type -> webhook.ListAllWebhooksResponse
+	47, // 101: webhook.WebhookService.GetDelivery:output_type -> webhook.GetDeliveryResponse
+	49, // 102: webhook.WebhookService.GetEvent:output_type -> webhook.GetEventResponse
+	74, // [74:103] is the sub-list for method output_type
+	45, // [45:74] is the sub-list for method input_type
+	45, // [45:45] is the sub-list for extension type_name
+	45, // [45:45] is the sub-list for extension extendee
+	0,  // [0:45] is the sub-list for field type_name
 }
 
 func init() { file_proto_webhook_proto_init() }
@@ -1229,17 +6325,21 @@ func file_proto_webhook_proto_init() {
 	if File_proto_webhook_proto != nil {
 		return
 	}
-	file_proto_webhook_proto_msgTypes[6].OneofWrappers = []any{
+	file_proto_webhook_proto_msgTypes[22].OneofWrappers = []any{
 		(*GetWebhookStatusRequest_WebhookId)(nil),
 		(*GetWebhookStatusRequest_EventId)(nil),
 	}
+	file_proto_webhook_proto_msgTypes[28].OneofWrappers = []any{
+		(*WatchWebhookStatusRequest_WebhookId)(nil),
+		(*WatchWebhookStatusRequest_EventId)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_proto_webhook_proto_rawDesc), len(file_proto_webhook_proto_rawDesc)),
-			NumEnums:      1,
-			NumMessages:   15,
+			NumEnums:      3,
+			NumMessages:   83,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
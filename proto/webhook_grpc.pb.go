@@ -19,11 +19,35 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	WebhookService_RegisterWebhook_FullMethodName   = "/webhook.WebhookService/RegisterWebhook"
-	WebhookService_UnregisterWebhook_FullMethodName = "/webhook.WebhookService/UnregisterWebhook"
-	WebhookService_PushEvent_FullMethodName         = "/webhook.WebhookService/PushEvent"
-	WebhookService_GetWebhookStatus_FullMethodName  = "/webhook.WebhookService/GetWebhookStatus"
-	WebhookService_ListWebhooks_FullMethodName      = "/webhook.WebhookService/ListWebhooks"
+	WebhookService_RegisterWebhook_FullMethodName       = "/webhook.WebhookService/RegisterWebhook"
+	WebhookService_UnregisterWebhook_FullMethodName     = "/webhook.WebhookService/UnregisterWebhook"
+	WebhookService_PauseWebhook_FullMethodName          = "/webhook.WebhookService/PauseWebhook"
+	WebhookService_ResumeWebhook_FullMethodName         = "/webhook.WebhookService/ResumeWebhook"
+	WebhookService_RotateSecret_FullMethodName          = "/webhook.WebhookService/RotateSecret"
+	WebhookService_DeleteWebhooks_FullMethodName        = "/webhook.WebhookService/DeleteWebhooks"
+	WebhookService_PushEvent_FullMethodName             = "/webhook.WebhookService/PushEvent"
+	WebhookService_RegisterEventSchema_FullMethodName   = "/webhook.WebhookService/RegisterEventSchema"
+	WebhookService_PushEvents_FullMethodName            = "/webhook.WebhookService/PushEvents"
+	WebhookService_GetWebhookStatus_FullMethodName      = "/webhook.WebhookService/GetWebhookStatus"
+	WebhookService_GetWebhookStatusBatch_FullMethodName = "/webhook.WebhookService/GetWebhookStatusBatch"
+	WebhookService_WatchWebhookStatus_FullMethodName    = "/webhook.WebhookService/WatchWebhookStatus"
+	WebhookService_ListWebhooks_FullMethodName          = "/webhook.WebhookService/ListWebhooks"
+	WebhookService_ListEvents_FullMethodName            = "/webhook.WebhookService/ListEvents"
+	WebhookService_ReplayEvents_FullMethodName          = "/webhook.WebhookService/ReplayEvents"
+	WebhookService_ListEventFailures_FullMethodName     = "/webhook.WebhookService/ListEventFailures"
+	WebhookService_ListDeliveries_FullMethodName        = "/webhook.WebhookService/ListDeliveries"
+	WebhookService_GetWebhook_FullMethodName            = "/webhook.WebhookService/GetWebhook"
+	WebhookService_CreateAPIKey_FullMethodName          = "/webhook.WebhookService/CreateAPIKey"
+	WebhookService_RevokeAPIKey_FullMethodName          = "/webhook.WebhookService/RevokeAPIKey"
+	WebhookService_GetDeliveryStats_FullMethodName      = "/webhook.WebhookService/GetDeliveryStats"
+	WebhookService_ListEventTypes_FullMethodName        = "/webhook.WebhookService/ListEventTypes"
+	WebhookService_SetNamespaceDefaults_FullMethodName  = "/webhook.WebhookService/SetNamespaceDefaults"
+	WebhookService_GetNamespaceDefaults_FullMethodName  = "/webhook.WebhookService/GetNamespaceDefaults"
+	WebhookService_CreateNamespace_FullMethodName       = "/webhook.WebhookService/CreateNamespace"
+	WebhookService_ExtendDeliveryTTL_FullMethodName     = "/webhook.WebhookService/ExtendDeliveryTTL"
+	WebhookService_ListAllWebhooks_FullMethodName       = "/webhook.WebhookService/ListAllWebhooks"
+	WebhookService_GetDelivery_FullMethodName           = "/webhook.WebhookService/GetDelivery"
+	WebhookService_GetEvent_FullMethodName              = "/webhook.WebhookService/GetEvent"
 )
 
 // WebhookServiceClient is the client API for WebhookService service.
@@ -36,12 +60,61 @@ type WebhookServiceClient interface {
 	RegisterWebhook(ctx context.Context, in *RegisterWebhookRequest, opts ...grpc.CallOption) (*RegisterWebhookResponse, error)
 	// UnregisterWebhook removes a webhook registration
 	UnregisterWebhook(ctx context.Context, in *UnregisterWebhookRequest, opts ...grpc.CallOption) (*UnregisterWebhookResponse, error)
+	// PauseWebhook stops scheduling deliveries to a webhook without removing it
+	PauseWebhook(ctx context.Context, in *PauseWebhookRequest, opts ...grpc.CallOption) (*PauseWebhookResponse, error)
+	// ResumeWebhook resumes scheduling deliveries to a paused webhook
+	ResumeWebhook(ctx context.Context, in *ResumeWebhookRequest, opts ...grpc.CallOption) (*ResumeWebhookResponse, error)
+	// RotateSecret replaces a signed webhook's signing secret; the old one keeps signing
+	// deliveries during a grace period
+	RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error)
+	// DeleteWebhooks removes every webhook in a namespace, optionally only those listening for an event
+	DeleteWebhooks(ctx context.Context, in *DeleteWebhooksRequest, opts ...grpc.CallOption) (*DeleteWebhooksResponse, error)
 	// PushEvent pushes an event that triggers registered webhooks
 	PushEvent(ctx context.Context, in *PushEventRequest, opts ...grpc.CallOption) (*PushEventResponse, error)
+	// RegisterEventSchema registers (or replaces) the JSON Schema that payloads of an event must match
+	RegisterEventSchema(ctx context.Context, in *RegisterEventSchemaRequest, opts ...grpc.CallOption) (*RegisterEventSchemaResponse, error)
+	// PushEvents pushes a batch of events in a single call
+	PushEvents(ctx context.Context, in *PushEventsRequest, opts ...grpc.CallOption) (*PushEventsResponse, error)
 	// GetWebhookStatus gets the status of webhook deliveries
 	GetWebhookStatus(ctx context.Context, in *GetWebhookStatusRequest, opts ...grpc.CallOption) (*GetWebhookStatusResponse, error)
+	// GetWebhookStatusBatch gets the status of the deliveries of several webhooks and events at once
+	GetWebhookStatusBatch(ctx context.Context, in *GetWebhookStatusBatchRequest, opts ...grpc.CallOption) (*GetWebhookStatusBatchResponse, error)
+	// WatchWebhookStatus streams deliveries for a webhook or event as their status changes
+	WatchWebhookStatus(ctx context.Context, in *WatchWebhookStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookDelivery], error)
 	// ListWebhooks lists all registered webhooks for a namespace
 	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	// ListEvents lists stored events for a namespace, newest first
+	ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error)
+	// ReplayEvents delivers stored events again to the webhooks currently registered for them
+	ReplayEvents(ctx context.Context, in *ReplayEventsRequest, opts ...grpc.CallOption) (*ReplayEventsResponse, error)
+	// ListEventFailures lists events whose processing failed after exhausting its attempts, newest first
+	ListEventFailures(ctx context.Context, in *ListEventFailuresRequest, opts ...grpc.CallOption) (*ListEventFailuresResponse, error)
+	// ListDeliveries lists deliveries to a namespace's webhooks, newest first
+	ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error)
+	// GetWebhook gets a single webhook registration by ID
+	GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error)
+	// CreateAPIKey creates an API key scoped to a set of namespaces (admin only)
+	CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error)
+	// RevokeAPIKey revokes an API key (admin only)
+	RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error)
+	// GetDeliveryStats aggregates a namespace's deliveries over a time range
+	GetDeliveryStats(ctx context.Context, in *GetDeliveryStatsRequest, opts ...grpc.CallOption) (*GetDeliveryStatsResponse, error)
+	// ListEventTypes lists the event names a namespace uses, with counts and when each was last pushed
+	ListEventTypes(ctx context.Context, in *ListEventTypesRequest, opts ...grpc.CallOption) (*ListEventTypesResponse, error)
+	// SetNamespaceDefaults sets the headers and timeout a namespace's webhooks inherit
+	SetNamespaceDefaults(ctx context.Context, in *SetNamespaceDefaultsRequest, opts ...grpc.CallOption) (*SetNamespaceDefaultsResponse, error)
+	// GetNamespaceDefaults returns the headers and timeout a namespace's webhooks inherit
+	GetNamespaceDefaults(ctx context.Context, in *GetNamespaceDefaultsRequest, opts ...grpc.CallOption) (*GetNamespaceDefaultsResponse, error)
+	// CreateNamespace registers a namespace; required before use when STRICT_NAMESPACES is set (admin only)
+	CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error)
+	// ExtendDeliveryTTL gives an expired delivery a new expiry and schedules it again (admin only)
+	ExtendDeliveryTTL(ctx context.Context, in *ExtendDeliveryTTLRequest, opts ...grpc.CallOption) (*ExtendDeliveryTTLResponse, error)
+	// ListAllWebhooks pages through webhooks across all namespaces (admin only)
+	ListAllWebhooks(ctx context.Context, in *ListAllWebhooksRequest, opts ...grpc.CallOption) (*ListAllWebhooksResponse, error)
+	// GetDelivery gets a single delivery by ID
+	GetDelivery(ctx context.Context, in *GetDeliveryRequest, opts ...grpc.CallOption) (*GetDeliveryResponse, error)
+	// GetEvent gets a single event by ID, with a summary of its deliveries
+	GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error)
 }
 
 type webhookServiceClient struct {
@@ -72,6 +145,46 @@ func (c *webhookServiceClient) UnregisterWebhook(ctx context.Context, in *Unregi
 	return out, nil
 }
 
+func (c *webhookServiceClient) PauseWebhook(ctx context.Context, in *PauseWebhookRequest, opts ...grpc.CallOption) (*PauseWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PauseWebhookResponse)
+	err := c.cc.Invoke(ctx, WebhookService_PauseWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ResumeWebhook(ctx context.Context, in *ResumeWebhookRequest, opts ...grpc.CallOption) (*ResumeWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResumeWebhookResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ResumeWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) RotateSecret(ctx context.Context, in *RotateSecretRequest, opts ...grpc.CallOption) (*RotateSecretResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateSecretResponse)
+	err := c.cc.Invoke(ctx, WebhookService_RotateSecret_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) DeleteWebhooks(ctx context.Context, in *DeleteWebhooksRequest, opts ...grpc.CallOption) (*DeleteWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteWebhooksResponse)
+	err := c.cc.Invoke(ctx, WebhookService_DeleteWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webhookServiceClient) PushEvent(ctx context.Context, in *PushEventRequest, opts ...grpc.CallOption) (*PushEventResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(PushEventResponse)
@@ -82,6 +195,26 @@ func (c *webhookServiceClient) PushEvent(ctx context.Context, in *PushEventReque
 	return out, nil
 }
 
+func (c *webhookServiceClient) RegisterEventSchema(ctx context.Context, in *RegisterEventSchemaRequest, opts ...grpc.CallOption) (*RegisterEventSchemaResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RegisterEventSchemaResponse)
+	err := c.cc.Invoke(ctx, WebhookService_RegisterEventSchema_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) PushEvents(ctx context.Context, in *PushEventsRequest, opts ...grpc.CallOption) (*PushEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PushEventsResponse)
+	err := c.cc.Invoke(ctx, WebhookService_PushEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *webhookServiceClient) GetWebhookStatus(ctx context.Context, in *GetWebhookStatusRequest, opts ...grpc.CallOption) (*GetWebhookStatusResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetWebhookStatusResponse)
@@ -92,6 +225,35 @@ func (c *webhookServiceClient) GetWebhookStatus(ctx context.Context, in *GetWebh
 	return out, nil
 }
 
+func (c *webhookServiceClient) GetWebhookStatusBatch(ctx context.Context, in *GetWebhookStatusBatchRequest, opts ...grpc.CallOption) (*GetWebhookStatusBatchResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookStatusBatchResponse)
+	err := c.cc.Invoke(ctx, WebhookService_GetWebhookStatusBatch_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) WatchWebhookStatus(ctx context.Context, in *WatchWebhookStatusRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WebhookDelivery], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &WebhookService_ServiceDesc.Streams[0], WebhookService_WatchWebhookStatus_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchWebhookStatusRequest, WebhookDelivery]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WebhookService_WatchWebhookStatusClient = grpc.ServerStreamingClient[WebhookDelivery]
+
 func (c *webhookServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ListWebhooksResponse)
@@ -102,6 +264,166 @@ func (c *webhookServiceClient) ListWebhooks(ctx context.Context, in *ListWebhook
 	return out, nil
 }
 
+func (c *webhookServiceClient) ListEvents(ctx context.Context, in *ListEventsRequest, opts ...grpc.CallOption) (*ListEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventsResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ListEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ReplayEvents(ctx context.Context, in *ReplayEventsRequest, opts ...grpc.CallOption) (*ReplayEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayEventsResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ReplayEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListEventFailures(ctx context.Context, in *ListEventFailuresRequest, opts ...grpc.CallOption) (*ListEventFailuresResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventFailuresResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ListEventFailures_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListDeliveries(ctx context.Context, in *ListDeliveriesRequest, opts ...grpc.CallOption) (*ListDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeliveriesResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ListDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) GetWebhook(ctx context.Context, in *GetWebhookRequest, opts ...grpc.CallOption) (*GetWebhookResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookResponse)
+	err := c.cc.Invoke(ctx, WebhookService_GetWebhook_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...grpc.CallOption) (*CreateAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAPIKeyResponse)
+	err := c.cc.Invoke(ctx, WebhookService_CreateAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...grpc.CallOption) (*RevokeAPIKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeAPIKeyResponse)
+	err := c.cc.Invoke(ctx, WebhookService_RevokeAPIKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) GetDeliveryStats(ctx context.Context, in *GetDeliveryStatsRequest, opts ...grpc.CallOption) (*GetDeliveryStatsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeliveryStatsResponse)
+	err := c.cc.Invoke(ctx, WebhookService_GetDeliveryStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListEventTypes(ctx context.Context, in *ListEventTypesRequest, opts ...grpc.CallOption) (*ListEventTypesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEventTypesResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ListEventTypes_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) SetNamespaceDefaults(ctx context.Context, in *SetNamespaceDefaultsRequest, opts ...grpc.CallOption) (*SetNamespaceDefaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetNamespaceDefaultsResponse)
+	err := c.cc.Invoke(ctx, WebhookService_SetNamespaceDefaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) GetNamespaceDefaults(ctx context.Context, in *GetNamespaceDefaultsRequest, opts ...grpc.CallOption) (*GetNamespaceDefaultsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNamespaceDefaultsResponse)
+	err := c.cc.Invoke(ctx, WebhookService_GetNamespaceDefaults_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) CreateNamespace(ctx context.Context, in *CreateNamespaceRequest, opts ...grpc.CallOption) (*CreateNamespaceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateNamespaceResponse)
+	err := c.cc.Invoke(ctx, WebhookService_CreateNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ExtendDeliveryTTL(ctx context.Context, in *ExtendDeliveryTTLRequest, opts ...grpc.CallOption) (*ExtendDeliveryTTLResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExtendDeliveryTTLResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ExtendDeliveryTTL_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) ListAllWebhooks(ctx context.Context, in *ListAllWebhooksRequest, opts ...grpc.CallOption) (*ListAllWebhooksResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListAllWebhooksResponse)
+	err := c.cc.Invoke(ctx, WebhookService_ListAllWebhooks_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) GetDelivery(ctx context.Context, in *GetDeliveryRequest, opts ...grpc.CallOption) (*GetDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDeliveryResponse)
+	err := c.cc.Invoke(ctx, WebhookService_GetDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhookServiceClient) GetEvent(ctx context.Context, in *GetEventRequest, opts ...grpc.CallOption) (*GetEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEventResponse)
+	err := c.cc.Invoke(ctx, WebhookService_GetEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WebhookServiceServer is the server API for WebhookService service.
 // All implementations must embed UnimplementedWebhookServiceServer
 // for forward compatibility.
@@ -112,12 +434,61 @@ type WebhookServiceServer interface {
 	RegisterWebhook(context.Context, *RegisterWebhookRequest) (*RegisterWebhookResponse, error)
 	// UnregisterWebhook removes a webhook registration
 	UnregisterWebhook(context.Context, *UnregisterWebhookRequest) (*UnregisterWebhookResponse, error)
+	// PauseWebhook stops scheduling deliveries to a webhook without removing it
+	PauseWebhook(context.Context, *PauseWebhookRequest) (*PauseWebhookResponse, error)
+	// ResumeWebhook resumes scheduling deliveries to a paused webhook
+	ResumeWebhook(context.Context, *ResumeWebhookRequest) (*ResumeWebhookResponse, error)
+	// RotateSecret replaces a signed webhook's signing secret; the old one keeps signing
+	// deliveries during a grace period
+	RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error)
+	// DeleteWebhooks removes every webhook in a namespace, optionally only those listening for an event
+	DeleteWebhooks(context.Context, *DeleteWebhooksRequest) (*DeleteWebhooksResponse, error)
 	// PushEvent pushes an event that triggers registered webhooks
 	PushEvent(context.Context, *PushEventRequest) (*PushEventResponse, error)
+	// RegisterEventSchema registers (or replaces) the JSON Schema that payloads of an event must match
+	RegisterEventSchema(context.Context, *RegisterEventSchemaRequest) (*RegisterEventSchemaResponse, error)
+	// PushEvents pushes a batch of events in a single call
+	PushEvents(context.Context, *PushEventsRequest) (*PushEventsResponse, error)
 	// GetWebhookStatus gets the status of webhook deliveries
 	GetWebhookStatus(context.Context, *GetWebhookStatusRequest) (*GetWebhookStatusResponse, error)
+	// GetWebhookStatusBatch gets the status of the deliveries of several webhooks and events at once
+	GetWebhookStatusBatch(context.Context, *GetWebhookStatusBatchRequest) (*GetWebhookStatusBatchResponse, error)
+	// WatchWebhookStatus streams deliveries for a webhook or event as their status changes
+	WatchWebhookStatus(*WatchWebhookStatusRequest, grpc.ServerStreamingServer[WebhookDelivery]) error
 	// ListWebhooks lists all registered webhooks for a namespace
 	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	// ListEvents lists stored events for a namespace, newest first
+	ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error)
+	// ReplayEvents delivers stored events again to the webhooks currently registered for them
+	ReplayEvents(context.Context, *ReplayEventsRequest) (*ReplayEventsResponse, error)
+	// ListEventFailures lists events whose processing failed after exhausting its attempts, newest first
+	ListEventFailures(context.Context, *ListEventFailuresRequest) (*ListEventFailuresResponse, error)
+	// ListDeliveries lists deliveries to a namespace's webhooks, newest first
+	ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error)
+	// GetWebhook gets a single webhook registration by ID
+	GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error)
+	// CreateAPIKey creates an API key scoped to a set of namespaces (admin only)
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	// RevokeAPIKey revokes an API key (admin only)
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	// GetDeliveryStats aggregates a namespace's deliveries over a time range
+	GetDeliveryStats(context.Context, *GetDeliveryStatsRequest) (*GetDeliveryStatsResponse, error)
+	// ListEventTypes lists the event names a namespace uses, with counts and when each was last pushed
+	ListEventTypes(context.Context, *ListEventTypesRequest) (*ListEventTypesResponse, error)
+	// SetNamespaceDefaults sets the headers and timeout a namespace's webhooks inherit
+	SetNamespaceDefaults(context.Context, *SetNamespaceDefaultsRequest) (*SetNamespaceDefaultsResponse, error)
+	// GetNamespaceDefaults returns the headers and timeout a namespace's webhooks inherit
+	GetNamespaceDefaults(context.Context, *GetNamespaceDefaultsRequest) (*GetNamespaceDefaultsResponse, error)
+	// CreateNamespace registers a namespace; required before use when STRICT_NAMESPACES is set (admin only)
+	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error)
+	// ExtendDeliveryTTL gives an expired delivery a new expiry and schedules it again (admin only)
+	ExtendDeliveryTTL(context.Context, *ExtendDeliveryTTLRequest) (*ExtendDeliveryTTLResponse, error)
+	// ListAllWebhooks pages through webhooks across all namespaces (admin only)
+	ListAllWebhooks(context.Context, *ListAllWebhooksRequest) (*ListAllWebhooksResponse, error)
+	// GetDelivery gets a single delivery by ID
+	GetDelivery(context.Context, *GetDeliveryRequest) (*GetDeliveryResponse, error)
+	// GetEvent gets a single event by ID, with a summary of its deliveries
+	GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error)
 	mustEmbedUnimplementedWebhookServiceServer()
 }
 
@@ -134,15 +505,87 @@ func (UnimplementedWebhookServiceServer) RegisterWebhook(context.Context, *Regis
 func (UnimplementedWebhookServiceServer) UnregisterWebhook(context.Context, *UnregisterWebhookRequest) (*UnregisterWebhookResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterWebhook not implemented")
 }
+func (UnimplementedWebhookServiceServer) PauseWebhook(context.Context, *PauseWebhookRequest) (*PauseWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) ResumeWebhook(context.Context, *ResumeWebhookRequest) (*ResumeWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) RotateSecret(context.Context, *RotateSecretRequest) (*RotateSecretResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateSecret not implemented")
+}
+func (UnimplementedWebhookServiceServer) DeleteWebhooks(context.Context, *DeleteWebhooksRequest) (*DeleteWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhooks not implemented")
+}
 func (UnimplementedWebhookServiceServer) PushEvent(context.Context, *PushEventRequest) (*PushEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PushEvent not implemented")
 }
+func (UnimplementedWebhookServiceServer) RegisterEventSchema(context.Context, *RegisterEventSchemaRequest) (*RegisterEventSchemaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterEventSchema not implemented")
+}
+func (UnimplementedWebhookServiceServer) PushEvents(context.Context, *PushEventsRequest) (*PushEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PushEvents not implemented")
+}
 func (UnimplementedWebhookServiceServer) GetWebhookStatus(context.Context, *GetWebhookStatusRequest) (*GetWebhookStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookStatus not implemented")
 }
+func (UnimplementedWebhookServiceServer) GetWebhookStatusBatch(context.Context, *GetWebhookStatusBatchRequest) (*GetWebhookStatusBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookStatusBatch not implemented")
+}
+func (UnimplementedWebhookServiceServer) WatchWebhookStatus(*WatchWebhookStatusRequest, grpc.ServerStreamingServer[WebhookDelivery]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchWebhookStatus not implemented")
+}
 func (UnimplementedWebhookServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
 }
+func (UnimplementedWebhookServiceServer) ListEvents(context.Context, *ListEventsRequest) (*ListEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEvents not implemented")
+}
+func (UnimplementedWebhookServiceServer) ReplayEvents(context.Context, *ReplayEventsRequest) (*ReplayEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayEvents not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListEventFailures(context.Context, *ListEventFailuresRequest) (*ListEventFailuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventFailures not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListDeliveries(context.Context, *ListDeliveriesRequest) (*ListDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveries not implemented")
+}
+func (UnimplementedWebhookServiceServer) GetWebhook(context.Context, *GetWebhookRequest) (*GetWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhook not implemented")
+}
+func (UnimplementedWebhookServiceServer) CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKey not implemented")
+}
+func (UnimplementedWebhookServiceServer) RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeAPIKey not implemented")
+}
+func (UnimplementedWebhookServiceServer) GetDeliveryStats(context.Context, *GetDeliveryStatsRequest) (*GetDeliveryStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeliveryStats not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListEventTypes(context.Context, *ListEventTypesRequest) (*ListEventTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEventTypes not implemented")
+}
+func (UnimplementedWebhookServiceServer) SetNamespaceDefaults(context.Context, *SetNamespaceDefaultsRequest) (*SetNamespaceDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceDefaults not implemented")
+}
+func (UnimplementedWebhookServiceServer) GetNamespaceDefaults(context.Context, *GetNamespaceDefaultsRequest) (*GetNamespaceDefaultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceDefaults not implemented")
+}
+func (UnimplementedWebhookServiceServer) CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
+}
+func (UnimplementedWebhookServiceServer) ExtendDeliveryTTL(context.Context, *ExtendDeliveryTTLRequest) (*ExtendDeliveryTTLResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExtendDeliveryTTL not implemented")
+}
+func (UnimplementedWebhookServiceServer) ListAllWebhooks(context.Context, *ListAllWebhooksRequest) (*ListAllWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAllWebhooks not implemented")
+}
+func (UnimplementedWebhookServiceServer) GetDelivery(context.Context, *GetDeliveryRequest) (*GetDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDelivery not implemented")
+}
+func (UnimplementedWebhookServiceServer) GetEvent(context.Context, *GetEventRequest) (*GetEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvent not implemented")
+}
 func (UnimplementedWebhookServiceServer) mustEmbedUnimplementedWebhookServiceServer() {}
 func (UnimplementedWebhookServiceServer) testEmbeddedByValue()                        {}
 
@@ -200,88 +643,611 @@ func _WebhookService_UnregisterWebhook_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WebhookService_PushEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PushEventRequest)
+func _WebhookService_PauseWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WebhookServiceServer).PushEvent(ctx, in)
+		return srv.(WebhookServiceServer).PauseWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WebhookService_PushEvent_FullMethodName,
+		FullMethod: WebhookService_PauseWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WebhookServiceServer).PushEvent(ctx, req.(*PushEventRequest))
+		return srv.(WebhookServiceServer).PauseWebhook(ctx, req.(*PauseWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WebhookService_GetWebhookStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWebhookStatusRequest)
+func _WebhookService_ResumeWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeWebhookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WebhookServiceServer).GetWebhookStatus(ctx, in)
+		return srv.(WebhookServiceServer).ResumeWebhook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WebhookService_GetWebhookStatus_FullMethodName,
+		FullMethod: WebhookService_ResumeWebhook_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WebhookServiceServer).GetWebhookStatus(ctx, req.(*GetWebhookStatusRequest))
+		return srv.(WebhookServiceServer).ResumeWebhook(ctx, req.(*ResumeWebhookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _WebhookService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ListWebhooksRequest)
+func _WebhookService_RotateSecret_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateSecretRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(WebhookServiceServer).ListWebhooks(ctx, in)
+		return srv.(WebhookServiceServer).RotateSecret(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: WebhookService_ListWebhooks_FullMethodName,
+		FullMethod: WebhookService_RotateSecret_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(WebhookServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+		return srv.(WebhookServiceServer).RotateSecret(ctx, req.(*RotateSecretRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-// WebhookService_ServiceDesc is the grpc.ServiceDesc for WebhookService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var WebhookService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "webhook.WebhookService",
-	HandlerType: (*WebhookServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RegisterWebhook",
-			Handler:    _WebhookService_RegisterWebhook_Handler,
-		},
-		{
-			MethodName: "UnregisterWebhook",
-			Handler:    _WebhookService_UnregisterWebhook_Handler,
-		},
-		{
-			MethodName: "PushEvent",
-			Handler:    _WebhookService_PushEvent_Handler,
-		},
-		{
-			MethodName: "GetWebhookStatus",
-			Handler:    _WebhookService_GetWebhookStatus_Handler,
-		},
-		{
-			MethodName: "ListWebhooks",
-			Handler:    _WebhookService_ListWebhooks_Handler,
+func _WebhookService_DeleteWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).DeleteWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_DeleteWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).DeleteWebhooks(ctx, req.(*DeleteWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_PushEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).PushEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_PushEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).PushEvent(ctx, req.(*PushEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_RegisterEventSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterEventSchemaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).RegisterEventSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_RegisterEventSchema_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).RegisterEventSchema(ctx, req.(*RegisterEventSchemaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_PushEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PushEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).PushEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_PushEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).PushEvents(ctx, req.(*PushEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetWebhookStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetWebhookStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetWebhookStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetWebhookStatus(ctx, req.(*GetWebhookStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetWebhookStatusBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookStatusBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetWebhookStatusBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetWebhookStatusBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetWebhookStatusBatch(ctx, req.(*GetWebhookStatusBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_WatchWebhookStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchWebhookStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WebhookServiceServer).WatchWebhookStatus(m, &grpc.GenericServerStream[WatchWebhookStatusRequest, WebhookDelivery]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type WebhookService_WatchWebhookStatusServer = grpc.ServerStreamingServer[WebhookDelivery]
+
+func _WebhookService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListEvents(ctx, req.(*ListEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ReplayEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ReplayEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ReplayEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ReplayEvents(ctx, req.(*ReplayEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListEventFailures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventFailuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListEventFailures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListEventFailures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListEventFailures(ctx, req.(*ListEventFailuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListDeliveries(ctx, req.(*ListDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetWebhook(ctx, req.(*GetWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_CreateAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).CreateAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_CreateAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_RevokeAPIKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeAPIKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).RevokeAPIKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_RevokeAPIKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetDeliveryStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliveryStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetDeliveryStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetDeliveryStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetDeliveryStats(ctx, req.(*GetDeliveryStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListEventTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEventTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListEventTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListEventTypes_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListEventTypes(ctx, req.(*ListEventTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_SetNamespaceDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNamespaceDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).SetNamespaceDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_SetNamespaceDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).SetNamespaceDefaults(ctx, req.(*SetNamespaceDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetNamespaceDefaults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceDefaultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetNamespaceDefaults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetNamespaceDefaults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetNamespaceDefaults(ctx, req.(*GetNamespaceDefaultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_CreateNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).CreateNamespace(ctx, req.(*CreateNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ExtendDeliveryTTL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExtendDeliveryTTLRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ExtendDeliveryTTL(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ExtendDeliveryTTL_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ExtendDeliveryTTL(ctx, req.(*ExtendDeliveryTTLRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_ListAllWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAllWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).ListAllWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_ListAllWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).ListAllWebhooks(ctx, req.(*ListAllWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetDelivery(ctx, req.(*GetDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhookService_GetEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhookServiceServer).GetEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhookService_GetEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhookServiceServer).GetEvent(ctx, req.(*GetEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// WebhookService_ServiceDesc is the grpc.ServiceDesc for WebhookService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var WebhookService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "webhook.WebhookService",
+	HandlerType: (*WebhookServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RegisterWebhook",
+			Handler:    _WebhookService_RegisterWebhook_Handler,
+		},
+		{
+			MethodName: "UnregisterWebhook",
+			Handler:    _WebhookService_UnregisterWebhook_Handler,
+		},
+		{
+			MethodName: "PauseWebhook",
+			Handler:    _WebhookService_PauseWebhook_Handler,
+		},
+		{
+			MethodName: "ResumeWebhook",
+			Handler:    _WebhookService_ResumeWebhook_Handler,
+		},
+		{
+			MethodName: "RotateSecret",
+			Handler:    _WebhookService_RotateSecret_Handler,
+		},
+		{
+			MethodName: "DeleteWebhooks",
+			Handler:    _WebhookService_DeleteWebhooks_Handler,
+		},
+		{
+			MethodName: "PushEvent",
+			Handler:    _WebhookService_PushEvent_Handler,
+		},
+		{
+			MethodName: "RegisterEventSchema",
+			Handler:    _WebhookService_RegisterEventSchema_Handler,
+		},
+		{
+			MethodName: "PushEvents",
+			Handler:    _WebhookService_PushEvents_Handler,
+		},
+		{
+			MethodName: "GetWebhookStatus",
+			Handler:    _WebhookService_GetWebhookStatus_Handler,
+		},
+		{
+			MethodName: "GetWebhookStatusBatch",
+			Handler:    _WebhookService_GetWebhookStatusBatch_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _WebhookService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "ListEvents",
+			Handler:    _WebhookService_ListEvents_Handler,
+		},
+		{
+			MethodName: "ReplayEvents",
+			Handler:    _WebhookService_ReplayEvents_Handler,
+		},
+		{
+			MethodName: "ListEventFailures",
+			Handler:    _WebhookService_ListEventFailures_Handler,
+		},
+		{
+			MethodName: "ListDeliveries",
+			Handler:    _WebhookService_ListDeliveries_Handler,
+		},
+		{
+			MethodName: "GetWebhook",
+			Handler:    _WebhookService_GetWebhook_Handler,
+		},
+		{
+			MethodName: "CreateAPIKey",
+			Handler:    _WebhookService_CreateAPIKey_Handler,
+		},
+		{
+			MethodName: "RevokeAPIKey",
+			Handler:    _WebhookService_RevokeAPIKey_Handler,
+		},
+		{
+			MethodName: "GetDeliveryStats",
+			Handler:    _WebhookService_GetDeliveryStats_Handler,
+		},
+		{
+			MethodName: "ListEventTypes",
+			Handler:    _WebhookService_ListEventTypes_Handler,
+		},
+		{
+			MethodName: "SetNamespaceDefaults",
+			Handler:    _WebhookService_SetNamespaceDefaults_Handler,
+		},
+		{
+			MethodName: "GetNamespaceDefaults",
+			Handler:    _WebhookService_GetNamespaceDefaults_Handler,
+		},
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _WebhookService_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "ExtendDeliveryTTL",
+			Handler:    _WebhookService_ExtendDeliveryTTL_Handler,
+		},
+		{
+			MethodName: "ListAllWebhooks",
+			Handler:    _WebhookService_ListAllWebhooks_Handler,
+		},
+		{
+			MethodName: "GetDelivery",
+			Handler:    _WebhookService_GetDelivery_Handler,
+		},
+		{
+			MethodName: "GetEvent",
+			Handler:    _WebhookService_GetEvent_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchWebhookStatus",
+			Handler:       _WebhookService_WatchWebhookStatus_Handler,
+			ServerStreams: true,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/webhook.proto",
 }